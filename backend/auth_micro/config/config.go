@@ -2,11 +2,23 @@ package auth_config
 
 import (
 	"errors"
+	"os"
 	"time"
 )
 
 var SessionIdLength = 32
-var SessionDuration = 3 * 24 * time.Hour
+
+// SessionDuration is the lifetime shared by the Redis key, the sessions row
+// and the cookie. It can be overridden with SESSION_LIFETIME (e.g. "72h").
+var SessionDuration = sessionDurationFromEnv(3 * 24 * time.Hour)
+
+func sessionDurationFromEnv(fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv("SESSION_LIFETIME"))
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
 
 var (
 	ErrSessionNotFound  = errors.New("session not found")
@@ -14,6 +26,7 @@ var (
 	ErrStoreSession     = errors.New("failed to store session")
 	ErrInvalidSessionId = errors.New("invalid session id")
 	ErrDeleteSession    = errors.New("failed to delete session")
+	ErrListSessions     = errors.New("failed to list sessions")
 )
 
 const (
@@ -21,8 +34,33 @@ const (
 	TimeAttemptsKeyPrefix = "time:"
 	MaxAttempts           = 5
 	AttemptTTL            = 10 * time.Minute
+
+	UserSessionsKeyFormat = "user_sessions:%d"
+	SessionTouchKeyPrefix = "session_touch:"
+	SessionTouchInterval  = time.Minute
+
+	// LegacySessionsRevokedKeyFormat marks a user whose sessions without a
+	// sessions row, created before sessions were tracked, were revoked.
+	LegacySessionsRevokedKeyFormat = "legacy_sessions_revoked:%d"
 )
 
+type SessionMeta struct {
+	UserAgent string
+	IP        string
+	Device    string
+}
+
+type SessionInfo struct {
+	ID           int
+	UserAgent    string
+	IP           string
+	Device       string
+	CreatedAt    time.Time
+	LastActiveAt time.Time
+	ExpiresAt    time.Time
+	Current      bool
+}
+
 type Session struct {
 	SessionId string        `yaml:"sessionId" json:"sessionId"`
 	UserId    int           `yaml:"userId" json:"userId"`
@@ -23,6 +23,9 @@ type SessionServiceClient interface {
 	GetSession(ctx context.Context, in *SessionIdRequest, opts ...grpc.CallOption) (*SessionDataResponse, error)
 	StoreSession(ctx context.Context, in *StoreSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteSession(ctx context.Context, in *SessionIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RotateSession(ctx context.Context, in *RotateSessionRequest, opts ...grpc.CallOption) (*SessionResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CheckAttempts(ctx context.Context, in *IPRequest, opts ...grpc.CallOption) (*CheckAttemptsResponse, error)
 	IncreaseAttempts(ctx context.Context, in *IPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteAttempts(ctx context.Context, in *IPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -72,6 +75,33 @@ func (c *sessionServiceClient) DeleteSession(ctx context.Context, in *SessionIdR
 	return out, nil
 }
 
+func (c *sessionServiceClient) RotateSession(ctx context.Context, in *RotateSessionRequest, opts ...grpc.CallOption) (*SessionResponse, error) {
+	out := new(SessionResponse)
+	err := c.cc.Invoke(ctx, "/session.SessionService/RotateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/session.SessionService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/session.SessionService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionServiceClient) CheckAttempts(ctx context.Context, in *IPRequest, opts ...grpc.CallOption) (*CheckAttemptsResponse, error) {
 	out := new(CheckAttemptsResponse)
 	err := c.cc.Invoke(ctx, "/session.SessionService/CheckAttempts", in, out, opts...)
@@ -107,6 +137,9 @@ type SessionServiceServer interface {
 	GetSession(context.Context, *SessionIdRequest) (*SessionDataResponse, error)
 	StoreSession(context.Context, *StoreSessionRequest) (*emptypb.Empty, error)
 	DeleteSession(context.Context, *SessionIdRequest) (*emptypb.Empty, error)
+	RotateSession(context.Context, *RotateSessionRequest) (*SessionResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
 	CheckAttempts(context.Context, *IPRequest) (*CheckAttemptsResponse, error)
 	IncreaseAttempts(context.Context, *IPRequest) (*emptypb.Empty, error)
 	DeleteAttempts(context.Context, *IPRequest) (*emptypb.Empty, error)
@@ -129,6 +162,15 @@ func (UnimplementedSessionServiceServer) StoreSession(context.Context, *StoreSes
 func (UnimplementedSessionServiceServer) DeleteSession(context.Context, *SessionIdRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
 }
+func (UnimplementedSessionServiceServer) RotateSession(context.Context, *RotateSessionRequest) (*SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSession not implemented")
+}
+func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSessionServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
 func (UnimplementedSessionServiceServer) CheckAttempts(context.Context, *IPRequest) (*CheckAttemptsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAttempts not implemented")
 }
@@ -223,6 +265,60 @@ func _SessionService_DeleteSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_RotateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RotateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/RotateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RotateSession(ctx, req.(*RotateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionService_CheckAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IPRequest)
 	if err := dec(in); err != nil {
@@ -300,6 +396,18 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSession",
 			Handler:    _SessionService_DeleteSession_Handler,
 		},
+		{
+			MethodName: "RotateSession",
+			Handler:    _SessionService_RotateSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _SessionService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _SessionService_RevokeSession_Handler,
+		},
 		{
 			MethodName: "CheckAttempts",
 			Handler:    _SessionService_CheckAttempts_Handler,
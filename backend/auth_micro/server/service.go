@@ -2,14 +2,17 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
+	"strconv"
 
+	auth_config "github.com/go-park-mail-ru/2025_1_ProVVeb/auth_micro/config"
 	sessionpb "github.com/go-park-mail-ru/2025_1_ProVVeb/auth_micro/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SessionServiceServerImpl struct {
@@ -23,19 +26,60 @@ func NewSessionService(repo *SessionRepo) *SessionServiceServerImpl {
 
 func (s *SessionServiceServerImpl) CreateSession(ctx context.Context, req *sessionpb.CreateSessionRequest) (*sessionpb.SessionResponse, error) {
 	session := s.Repo.CreateSession(int(req.GetUserId()))
-	if err := s.Repo.StoreSession(session.UserId, session.SessionId, "session_data", time.Duration(session.Expires)); err != nil {
+	meta := auth_config.SessionMeta{
+		UserAgent: truncate(req.GetUserAgent(), 512),
+		IP:        truncate(req.GetIp(), 64),
+		Device:    DeviceFromUserAgent(req.GetUserAgent()),
+	}
+	if err := s.Repo.StoreUserSession(session, meta); err != nil {
 		return nil, fmt.Errorf("error storing session: %v", err)
 	}
-	expiresDuration := durationpb.New(12 * time.Hour)
 
 	sessionResponse := &sessionpb.SessionResponse{
 		SessionId: session.SessionId,
 		UserId:    req.GetUserId(),
-		Expires:   expiresDuration,
+		Expires:   durationpb.New(session.Expires),
 	}
 	return sessionResponse, nil
 }
 
+// RotateSession replaces an existing session with a fresh identifier for the
+// same user. The old identifier stops working immediately.
+func (s *SessionServiceServerImpl) RotateSession(ctx context.Context, req *sessionpb.RotateSessionRequest) (*sessionpb.SessionResponse, error) {
+	data, err := s.Repo.GetSession(req.GetSessionId())
+	if errors.Is(err, auth_config.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting session: %v", err)
+	}
+
+	userId, err := strconv.Atoi(data)
+	if err != nil {
+		return nil, status.Error(codes.Internal, auth_config.ErrInvalidSessionId.Error())
+	}
+
+	session := s.Repo.CreateSession(userId)
+	meta := auth_config.SessionMeta{
+		UserAgent: truncate(req.GetUserAgent(), 512),
+		IP:        truncate(req.GetIp(), 64),
+		Device:    DeviceFromUserAgent(req.GetUserAgent()),
+	}
+	if err := s.Repo.StoreUserSession(session, meta); err != nil {
+		return nil, status.Errorf(codes.Internal, "error storing session: %v", err)
+	}
+
+	if err := s.Repo.DeleteSession(req.GetSessionId()); err != nil && !errors.Is(err, auth_config.ErrSessionNotFound) {
+		return nil, status.Errorf(codes.Internal, "error deleting session: %v", err)
+	}
+
+	return &sessionpb.SessionResponse{
+		SessionId: session.SessionId,
+		UserId:    int32(userId),
+		Expires:   durationpb.New(session.Expires),
+	}, nil
+}
+
 func (s *SessionServiceServerImpl) GetSession(ctx context.Context, req *sessionpb.SessionIdRequest) (*sessionpb.SessionDataResponse, error) {
 	data, err := s.Repo.GetSession(req.GetSessionId())
 	if err != nil {
@@ -55,6 +99,45 @@ func (s *SessionServiceServerImpl) DeleteSession(ctx context.Context, req *sessi
 	return &emptypb.Empty{}, nil
 }
 
+func (s *SessionServiceServerImpl) ListSessions(ctx context.Context, req *sessionpb.ListSessionsRequest) (*sessionpb.ListSessionsResponse, error) {
+	sessions, err := s.Repo.ListSessions(int(req.GetUserId()), req.GetCurrentSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing sessions: %v", err)
+	}
+
+	resp := &sessionpb.ListSessionsResponse{}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &sessionpb.SessionInfo{
+			Id:           int32(session.ID),
+			UserAgent:    session.UserAgent,
+			Ip:           session.IP,
+			Device:       session.Device,
+			CreatedAt:    timestamppb.New(session.CreatedAt),
+			LastActiveAt: timestamppb.New(session.LastActiveAt),
+			ExpiresAt:    timestamppb.New(session.ExpiresAt),
+			Current:      session.Current,
+		})
+	}
+	return resp, nil
+}
+
+func (s *SessionServiceServerImpl) RevokeSession(ctx context.Context, req *sessionpb.RevokeSessionRequest) (*emptypb.Empty, error) {
+	var err error
+	if req.GetAllOthers() {
+		err = s.Repo.RevokeOtherSessions(int(req.GetUserId()), req.GetCurrentSessionId())
+	} else {
+		err = s.Repo.RevokeSession(int(req.GetUserId()), int(req.GetId()))
+	}
+
+	if errors.Is(err, auth_config.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error revoking session: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *SessionServiceServerImpl) CheckAttempts(ctx context.Context, req *sessionpb.IPRequest) (*sessionpb.CheckAttemptsResponse, error) {
 	blockTime, err := s.Repo.CheckAttempts(req.GetIp())
 	if err != nil {
@@ -2,15 +2,18 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"math/rand"
+	"math/big"
 	"strconv"
 	"time"
 
 	auth_config "github.com/go-park-mail-ru/2025_1_ProVVeb/auth_micro/config"
 	"github.com/go-redis/redis/v8"
-	"github.com/jackc/pgx/v5"
 )
 
 type SessionRepository interface {
@@ -18,6 +21,10 @@ type SessionRepository interface {
 	DeleteSession(sessionId string) error
 	GetSession(sessionId string) (string, error)
 	StoreSession(sessionId string, data string, ttl time.Duration) error
+	StoreUserSession(session auth_config.Session, meta auth_config.SessionMeta) error
+	ListSessions(userId int, currentSessionId string) ([]auth_config.SessionInfo, error)
+	RevokeSession(userId int, id int) error
+	RevokeOtherSessions(userId int, currentSessionId string) error
 	DeleteAllSessions() error
 	CloseRepo() error
 	CheckAttempts(userIP string) (string, error)
@@ -25,11 +32,18 @@ type SessionRepository interface {
 	DeleteAttempts(userIP string) error
 }
 
+// RandStringRunes returns n characters drawn uniformly from [a-zA-Z0-9]
+// using crypto/rand. It panics if the system CSPRNG is unavailable.
 func RandStringRunes(n int) string {
 	const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	limit := big.NewInt(int64(len(letterBytes)))
 	b := make([]byte, n)
 	for i := range b {
-		b[i] = letterBytes[rand.Intn(len(letterBytes))]
+		idx, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			panic(fmt.Sprintf("crypto/rand failed: %v", err))
+		}
+		b[i] = letterBytes[idx.Int64()]
 	}
 	return string(b)
 }
@@ -45,36 +59,53 @@ func (sr *SessionRepo) CreateSession(userId int) auth_config.Session {
 	}
 }
 
-const (
-	FindSessionQuery = `
-SELECT id FROM sessions WHERE user_id = $1;
-`
-	DeleteSessionQuery = `
-DELETE FROM sessions WHERE user_id = $1;
+// HashSessionId is what the sessions table stores instead of the raw id,
+// so a database dump cannot be replayed as a cookie.
+func HashSessionId(sessionId string) string {
+	sum := sha256.Sum256([]byte(sessionId))
+	return hex.EncodeToString(sum[:])
+}
+
+const DeleteSessionQuery = `
+DELETE FROM sessions WHERE token = $1
+RETURNING id, user_id;
 `
-)
 
+// DeleteSession ends a single session, leaving the user's other devices
+// logged in.
 func (sr *SessionRepo) DeleteSession(sessionId string) error {
-	var profileId int
-	userId, err := strconv.Atoi(sessionId)
-	if err != nil {
-		return auth_config.ErrInvalidSessionId
-	}
-	err = sr.DB.QueryRowContext(context.Background(), FindSessionQuery, userId).Scan(&profileId)
-	if err != nil {
-		if err == pgx.ErrNoRows {
+	if err := sr.Client.Get(sr.Ctx, sessionId).Err(); err != nil {
+		if err == redis.Nil {
 			return auth_config.ErrSessionNotFound
 		}
 		return auth_config.ErrDeleteSession
 	}
-	_, err = sr.DB.ExecContext(context.Background(), DeleteSessionQuery, userId)
-	if err != nil {
+
+	var id, userId int
+	err := sr.DB.QueryRowContext(context.Background(), DeleteSessionQuery, HashSessionId(sessionId)).Scan(&id, &userId)
+	if err != nil && err != sql.ErrNoRows {
 		return auth_config.ErrDeleteSession
 	}
+	if err == nil {
+		sr.Client.HDel(sr.Ctx, fmt.Sprintf(auth_config.UserSessionsKeyFormat, userId), strconv.Itoa(id))
+	}
 
-	return sr.Client.Del(sr.Ctx, sessionId).Err()
+	if err := sr.Client.Del(sr.Ctx, sessionId).Err(); err != nil {
+		return auth_config.ErrDeleteSession
+	}
+	sr.Client.Del(sr.Ctx, auth_config.SessionTouchKeyPrefix+HashSessionId(sessionId))
+
+	return nil
 }
 
+const TouchSessionQuery = `
+UPDATE sessions SET last_active_at = CURRENT_TIMESTAMP WHERE token = $1;
+`
+
+const SessionRowExistsQuery = `
+SELECT EXISTS (SELECT 1 FROM sessions WHERE token = $1);
+`
+
 func (sr *SessionRepo) GetSession(sessionId string) (string, error) {
 	data, err := sr.Client.Get(sr.Ctx, sessionId).Result()
 	if err != nil {
@@ -83,12 +114,59 @@ func (sr *SessionRepo) GetSession(sessionId string) (string, error) {
 		}
 		return "", auth_config.ErrGetSession
 	}
+
+	revoked, err := sr.legacySessionRevoked(sessionId, data)
+	if err != nil {
+		return "", auth_config.ErrGetSession
+	}
+	if revoked {
+		sr.Client.Del(sr.Ctx, sessionId)
+		return "", auth_config.ErrSessionNotFound
+	}
+
+	sr.touchSession(sessionId)
 	return data, nil
 }
 
+// legacySessionRevoked reports whether sessionId has no sessions row while
+// its user has revoked such sessions. Rows only exist since sessions were
+// tracked per device, so revoking cannot list the older sessions by id.
+func (sr *SessionRepo) legacySessionRevoked(sessionId string, userId string) (bool, error) {
+	id, err := strconv.Atoi(userId)
+	if err != nil || sr.DB == nil {
+		return false, nil
+	}
+
+	marked, err := sr.Client.Exists(sr.Ctx, fmt.Sprintf(auth_config.LegacySessionsRevokedKeyFormat, id)).Result()
+	if err != nil {
+		return false, err
+	}
+	if marked == 0 {
+		return false, nil
+	}
+
+	var exists bool
+	err = sr.DB.QueryRowContext(context.Background(), SessionRowExistsQuery, HashSessionId(sessionId)).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return !exists, nil
+}
+
+// touchSession records activity at most once per SessionTouchInterval so
+// that authenticated requests do not each write to postgres.
+func (sr *SessionRepo) touchSession(sessionId string) {
+	hash := HashSessionId(sessionId)
+	ok, err := sr.Client.SetNX(sr.Ctx, auth_config.SessionTouchKeyPrefix+hash, 1, auth_config.SessionTouchInterval).Result()
+	if err != nil || !ok || sr.DB == nil {
+		return
+	}
+	_, _ = sr.DB.ExecContext(context.Background(), TouchSessionQuery, hash)
+}
+
 const StoreSessionQuery = `
 INSERT INTO sessions (user_id, token, created_at, expires_at)
-VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '72 hours')
+VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
 RETURNING id;
 `
 
@@ -100,6 +178,7 @@ func (sr *SessionRepo) StoreSession(userID int, session_id string, token string,
 		StoreSessionQuery,
 		userID,
 		token,
+		ttl.Seconds(),
 	).Scan(&sessionId)
 
 	if err != nil {
@@ -115,6 +194,216 @@ func (sr *SessionRepo) StoreSession(userID int, session_id string, token string,
 	return nil
 }
 
+const StoreUserSessionQuery = `
+INSERT INTO sessions (user_id, token, user_agent, ip, device, created_at, last_active_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $6))
+RETURNING id;
+`
+
+func (sr *SessionRepo) StoreUserSession(session auth_config.Session, meta auth_config.SessionMeta) error {
+	var id int
+	err := sr.DB.QueryRowContext(
+		context.Background(),
+		StoreUserSessionQuery,
+		session.UserId,
+		HashSessionId(session.SessionId),
+		meta.UserAgent,
+		meta.IP,
+		meta.Device,
+		session.Expires.Seconds(),
+	).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	err = sr.Client.Set(sr.Ctx, session.SessionId, strconv.Itoa(session.UserId), session.Expires).Err()
+	if err != nil {
+		return auth_config.ErrStoreSession
+	}
+
+	userKey := fmt.Sprintf(auth_config.UserSessionsKeyFormat, session.UserId)
+	pipe := sr.Client.TxPipeline()
+	pipe.HSet(sr.Ctx, userKey, strconv.Itoa(id), session.SessionId)
+	pipe.Expire(sr.Ctx, userKey, session.Expires)
+	if _, err := pipe.Exec(sr.Ctx); err != nil {
+		return auth_config.ErrStoreSession
+	}
+
+	return nil
+}
+
+const ListSessionsQuery = `
+SELECT id, token, COALESCE(user_agent, ''), COALESCE(ip, ''), COALESCE(device, ''),
+       created_at, COALESCE(last_active_at, created_at), expires_at
+FROM sessions
+WHERE user_id = $1 AND expires_at > CURRENT_TIMESTAMP
+ORDER BY last_active_at DESC NULLS LAST, id DESC;
+`
+
+func (sr *SessionRepo) ListSessions(userId int, currentSessionId string) ([]auth_config.SessionInfo, error) {
+	rows, err := sr.DB.QueryContext(context.Background(), ListSessionsQuery, userId)
+	if err != nil {
+		return nil, auth_config.ErrListSessions
+	}
+	defer rows.Close()
+
+	currentHash := HashSessionId(currentSessionId)
+	var sessions []auth_config.SessionInfo
+	for rows.Next() {
+		var info auth_config.SessionInfo
+		var token string
+		if err := rows.Scan(
+			&info.ID,
+			&token,
+			&info.UserAgent,
+			&info.IP,
+			&info.Device,
+			&info.CreatedAt,
+			&info.LastActiveAt,
+			&info.ExpiresAt,
+		); err != nil {
+			return nil, auth_config.ErrListSessions
+		}
+		info.Current = token == currentHash
+		sessions = append(sessions, info)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, auth_config.ErrListSessions
+	}
+	return sessions, nil
+}
+
+const (
+	RevokeSessionQuery = `
+DELETE FROM sessions WHERE id = $1 AND user_id = $2
+RETURNING id;
+`
+	RevokeOtherSessionsQuery = `
+DELETE FROM sessions WHERE user_id = $1 AND token != $2
+RETURNING id;
+`
+	// AdoptSessionQuery gives a session from before sessions were tracked a
+	// row, so revoking the other sessions keeps it.
+	AdoptSessionQuery = `
+INSERT INTO sessions (user_id, token, created_at, last_active_at, expires_at)
+SELECT $1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3)
+WHERE NOT EXISTS (SELECT 1 FROM sessions WHERE token = $2)
+RETURNING id;
+`
+)
+
+func (sr *SessionRepo) RevokeSession(userId int, id int) error {
+	var deleted int
+	err := sr.DB.QueryRowContext(context.Background(), RevokeSessionQuery, id, userId).Scan(&deleted)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return auth_config.ErrSessionNotFound
+		}
+		return auth_config.ErrDeleteSession
+	}
+
+	return sr.dropRedisSessions(userId, []int{deleted})
+}
+
+// RevokeOtherSessions ends every session of userId but the current one,
+// including the untracked sessions older than the sessions table.
+func (sr *SessionRepo) RevokeOtherSessions(userId int, currentSessionId string) error {
+	if err := sr.adoptSession(userId, currentSessionId); err != nil {
+		return err
+	}
+	err := sr.Client.Set(sr.Ctx,
+		fmt.Sprintf(auth_config.LegacySessionsRevokedKeyFormat, userId), 1, auth_config.SessionDuration,
+	).Err()
+	if err != nil {
+		return auth_config.ErrDeleteSession
+	}
+
+	rows, err := sr.DB.QueryContext(context.Background(), RevokeOtherSessionsQuery, userId, HashSessionId(currentSessionId))
+	if err != nil {
+		return auth_config.ErrDeleteSession
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return auth_config.ErrDeleteSession
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return auth_config.ErrDeleteSession
+	}
+
+	return sr.dropRedisSessions(userId, ids)
+}
+
+func (sr *SessionRepo) adoptSession(userId int, sessionId string) error {
+	ttl, err := sr.Client.TTL(sr.Ctx, sessionId).Result()
+	if err != nil {
+		return auth_config.ErrDeleteSession
+	}
+	if ttl <= 0 {
+		ttl = auth_config.SessionDuration
+	}
+
+	var id int
+	err = sr.DB.QueryRowContext(context.Background(), AdoptSessionQuery, userId, HashSessionId(sessionId), ttl.Seconds()).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return auth_config.ErrDeleteSession
+	}
+
+	userKey := fmt.Sprintf(auth_config.UserSessionsKeyFormat, userId)
+	pipe := sr.Client.TxPipeline()
+	pipe.HSet(sr.Ctx, userKey, strconv.Itoa(id), sessionId)
+	pipe.Expire(sr.Ctx, userKey, auth_config.SessionDuration)
+	if _, err := pipe.Exec(sr.Ctx); err != nil {
+		return auth_config.ErrDeleteSession
+	}
+	return nil
+}
+
+// dropRedisSessions removes the live session keys behind the given sessions
+// rows, which is what actually logs those devices out.
+func (sr *SessionRepo) dropRedisSessions(userId int, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	userKey := fmt.Sprintf(auth_config.UserSessionsKeyFormat, userId)
+	fields := make([]string, 0, len(ids))
+	for _, id := range ids {
+		fields = append(fields, strconv.Itoa(id))
+	}
+
+	sessionIds, err := sr.Client.HMGet(sr.Ctx, userKey, fields...).Result()
+	if err != nil {
+		return auth_config.ErrDeleteSession
+	}
+
+	var keys []string
+	for _, sessionId := range sessionIds {
+		if id, ok := sessionId.(string); ok && id != "" {
+			keys = append(keys, id, auth_config.SessionTouchKeyPrefix+HashSessionId(id))
+		}
+	}
+
+	pipe := sr.Client.TxPipeline()
+	if len(keys) > 0 {
+		pipe.Del(sr.Ctx, keys...)
+	}
+	pipe.HDel(sr.Ctx, userKey, fields...)
+	if _, err := pipe.Exec(sr.Ctx); err != nil {
+		return auth_config.ErrDeleteSession
+	}
+	return nil
+}
+
 func (sr *SessionRepo) DeleteAllSessions() error {
 	return sr.Client.FlushAll(sr.Ctx).Err()
 }
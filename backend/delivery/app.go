@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
@@ -86,12 +89,30 @@ func Run() {
 		return
 	}
 
+	attachmentClient, err := repository.NewAttachmentRepo()
+	if err != nil {
+		fmt.Printf("Failed to initialize attachment repo: %v\n", err)
+		return
+	}
+
 	complaintClient, err := repository.NewComplaintRepo()
 	if err != nil {
 		fmt.Printf("Failed to initialize complaint repo: %v\n", err)
 		return
 	}
 
+	blockClient, err := repository.NewBlockRepo()
+	if err != nil {
+		fmt.Printf("Failed to initialize block repo: %v\n", err)
+		return
+	}
+
+	savedSearchClient, err := repository.NewSavedSearchRepo()
+	if err != nil {
+		fmt.Printf("Failed to initialize saved search repo: %v\n", err)
+		return
+	}
+
 	subClient, err := repository.NewSubRepo()
 	if err != nil {
 		fmt.Printf("Failed to initialize complaint repo: %v\n", err)
@@ -124,13 +145,13 @@ func Run() {
 		return
 	}
 
-	messageHandler, err := NewMessageHandler(chatClient, notifClient, chatClient.Client, logger)
+	messageHandler, err := NewMessageHandler(chatClient, notifClient, repository.NewPresenceRepo(chatClient.Client), blockClient, attachmentClient, chatClient.Client, logger)
 	if err != nil {
 		fmt.Println(fmt.Errorf("not able to work with queryHandler: %v", err))
 		return
 	}
 
-	profilesHandler, err := NewProfilesHandler(profilesCon, notifClient, usersCon, notifClient.Client.(*redis.Client), logger)
+	profilesHandler, err := NewProfilesHandler(profilesCon, notifClient, chatClient, blockClient, savedSearchClient, usersCon, notifClient.Client.(*redis.Client), logger)
 	if err != nil {
 		fmt.Println(fmt.Errorf("not able to work with profilesHandler: %v", err))
 		return
@@ -148,7 +169,46 @@ func Run() {
 		return
 	}
 
-	subscripHandler, err := NewSubHandler(subClient, logger)
+	go profilesHandler.SavedSearchesUC.RunAlerts(context.Background(), model.SavedSearchAlertInterval)
+	go messageHandler.SweepAttachmentsUC.Run(context.Background(), model.AttachmentSweepInterval)
+
+	senders := map[string]repository.Sender{}
+	if smtpSender, err := repository.NewSMTPSender(); err == nil {
+		senders[model.OutboundEmail] = smtpSender
+	} else {
+		fmt.Printf("Email notifications are off: %v\n", err)
+	}
+	if webhookSender, err := repository.NewWebhookSender(); err == nil {
+		senders[model.OutboundWebhook] = webhookSender
+	} else {
+		fmt.Printf("Webhook notifications are off: %v\n", err)
+	}
+
+	if len(senders) > 0 {
+		outboxClient, err := repository.NewOutboxRepo()
+		if err != nil {
+			fmt.Printf("Failed to initialize outbox repo: %v\n", err)
+			return
+		}
+
+		outboundUC, err := usecase.NewOutboundUseCase(outboxClient, senders, logger)
+		if err != nil {
+			fmt.Println(fmt.Errorf("not able to work with outbound notifications: %v", err))
+			return
+		}
+		go outboundUC.Run(context.Background(), digestDelay())
+	}
+
+	realtimeHub := NewRealtimeHub(chatClient.Client, logger)
+	go realtimeHub.Run(context.Background())
+
+	realtimeHandler, err := NewRealtimeHandler(messageHandler, notificationHandler, realtimeHub, logger)
+	if err != nil {
+		fmt.Println(fmt.Errorf("not able to work with realtimeHandler: %v", err))
+		return
+	}
+
+	subscripHandler, err := NewSubHandler(subClient, sessionHandler, logger)
 	if err != nil {
 		fmt.Println(fmt.Errorf("not able to work with notificationHandler: %v", err))
 		return
@@ -170,6 +230,9 @@ func Run() {
 	usersSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
 	usersSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizeStr)))
 
+	usersSubrouter.HandleFunc("/sessions", sessionHandler.ListSessions).Methods("GET")
+	usersSubrouter.HandleFunc("/sessions", sessionHandler.RevokeOtherSessions).Methods("DELETE")
+	usersSubrouter.HandleFunc("/sessions/{session_id}", sessionHandler.RevokeSession).Methods("DELETE")
 	usersSubrouter.HandleFunc("/{id}", usersHandler.DeleteUser).Methods("DELETE")
 	usersSubrouter.HandleFunc("/checkSession", sessionHandler.CheckSession).Methods("GET")
 	usersSubrouter.HandleFunc("/getParams", usersHandler.GetUserParams).Methods("GET")
@@ -180,11 +243,25 @@ func Run() {
 
 	profileSubrouter.HandleFunc("", profilesHandler.GetProfiles).Methods("GET")
 	profileSubrouter.HandleFunc("/like", profilesHandler.SetLike).Methods("POST")
+	profileSubrouter.HandleFunc("/rewind", profilesHandler.RewindLike).Methods("POST")
+	profileSubrouter.HandleFunc("/quota", profilesHandler.GetQuota).Methods("GET")
+	profileSubrouter.HandleFunc("/likes", profilesHandler.GetIncomingLikes).Methods("GET")
+	profileSubrouter.HandleFunc("/likes/{id}/likeBack", profilesHandler.LikeBack).Methods("POST")
 	profileSubrouter.HandleFunc("/match/{id}", profilesHandler.GetMatches).Methods("GET")
+	profileSubrouter.HandleFunc("/unmatch/{id}", profilesHandler.Unmatch).Methods("POST")
+	profileSubrouter.HandleFunc("/blocked", profilesHandler.GetBlockedProfiles).Methods("GET")
+	profileSubrouter.HandleFunc("/block/{id}", profilesHandler.BlockProfile).Methods("POST", "DELETE")
 	profileSubrouter.HandleFunc("/update", profilesHandler.UpdateProfile).Methods("POST")
 	profileSubrouter.HandleFunc("/search", profilesHandler.SearchProfiles).Methods("POST")
+	profileSubrouter.HandleFunc("/searches", profilesHandler.GetSavedSearches).Methods("GET")
+	profileSubrouter.HandleFunc("/searches", profilesHandler.SaveSearch).Methods("POST")
+	profileSubrouter.HandleFunc("/searches/{id}", profilesHandler.DeleteSavedSearch).Methods("DELETE")
 	profileSubrouter.HandleFunc("/recommendations", profilesHandler.GetRecommendations).Methods("GET")
+	profileSubrouter.HandleFunc("/recommendations/page", profilesHandler.GetRecommendationsPage).Methods("GET")
 	profileSubrouter.HandleFunc("/getStatistics", profilesHandler.GetStatistics).Methods("GET")
+	profileSubrouter.HandleFunc("/reorderPhotos", profilesHandler.ReorderPhotos).Methods("POST")
+	profileSubrouter.HandleFunc("/setPrimaryPhoto", profilesHandler.SetPrimaryPhoto).Methods("POST")
+	profileSubrouter.HandleFunc("/coordinates", profilesHandler.SetCoordinates).Methods("PUT", "DELETE")
 	profileSubrouter.HandleFunc("/{id}", profilesHandler.GetProfile).Methods("GET")
 
 	photoSubrouter := r.PathPrefix("/profiles").Subrouter()
@@ -212,20 +289,36 @@ func Run() {
 	messageSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizeStr)))
 
 	messageSubrouter.HandleFunc("", messageHandler.GetChats).Methods("GET")
+	messageSubrouter.HandleFunc("/presence", messageHandler.GetPresence).Methods("GET")
 	messageSubrouter.HandleFunc("/create", messageHandler.CreateChat).Methods("POST")
 	messageSubrouter.HandleFunc("/delete", messageHandler.DeleteChat).Methods("DELETE")
 
-	wsRouter := r.PathPrefix("/chats").Subrouter()
+	messagesSubrouter := r.PathPrefix("/messages").Subrouter()
+	messagesSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
+	messagesSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizeStr)))
+
+	messagesSubrouter.HandleFunc("/edit", messageHandler.EditMessage).Methods("POST")
+	messagesSubrouter.HandleFunc("/attachments/{attachment_id}", messageHandler.GetAttachment).Methods("GET")
+	messagesSubrouter.HandleFunc("/{chat_id}", messageHandler.GetMessagesHistory).Methods("GET")
+
+	attachmentSubrouter := r.PathPrefix("/messages").Subrouter()
+	attachmentSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
+	attachmentSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizePhoto)))
+
+	attachmentSubrouter.HandleFunc("/attachments", messageHandler.UploadAttachments).Methods("POST")
+
+	wsRouter := r.PathPrefix("/realtime").Subrouter()
 	wsRouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
 	wsRouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizeStr)))
 
-	wsRouter.HandleFunc("/{chat_id}", messageHandler.HandleChat).Methods("GET")
+	wsRouter.HandleFunc("", realtimeHandler.HandleRealtime).Methods("GET")
 
-	notificationsSubrouter := r.PathPrefix("/notifications").Subrouter()
-	notificationsSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
-	notificationsSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizeStr)))
+	notificationSubrouter := r.PathPrefix("/notifications").Subrouter()
+	notificationSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
+	notificationSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizeStr)))
 
-	notificationsSubrouter.HandleFunc("", notificationHandler.GetNotifications).Methods("GET")
+	notificationSubrouter.HandleFunc("/settings", notificationHandler.GetNotificationSettings).Methods("GET")
+	notificationSubrouter.HandleFunc("/settings", notificationHandler.UpdateNotificationSettings).Methods("PUT")
 
 	ComplaintSubrouter := r.PathPrefix("/complaints").Subrouter()
 	ComplaintSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
@@ -237,6 +330,9 @@ func Run() {
 	ComplaintSubrouter.HandleFunc("/delete", complaintHandler.DeleteComplaint).Methods("DELETE")
 	ComplaintSubrouter.HandleFunc("/handle", complaintHandler.HandleComplaint).Methods("POST")
 	ComplaintSubrouter.HandleFunc("/getStatistics", complaintHandler.GetStatistics).Methods("POST")
+	ComplaintSubrouter.HandleFunc("/assign", complaintHandler.AssignComplaint).Methods("POST")
+	ComplaintSubrouter.HandleFunc("/notes", complaintHandler.AddComplaintNote).Methods("POST")
+	ComplaintSubrouter.HandleFunc("/{id:[0-9]+}/history", complaintHandler.GetComplaintHistory).Methods("GET")
 
 	subscriptionSubrouter := r.PathPrefix("/subscription").Subrouter()
 	subscriptionSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
@@ -326,6 +422,11 @@ func NewComplaintHandler(
 		return nil, err
 	}
 
+	ModerationUC, err := usecase.NewModerationUseCase(complRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ComplaintHandler{
 		GetComplaintsUC:    *GetComplaints,
 		CreateComplateUC:   *CreateComplate,
@@ -333,6 +434,7 @@ func NewComplaintHandler(
 		DeleteComplaintsUC: *DeleteComplaintsUC,
 		HandleComplaintUC:  *HandleComplaintUC,
 		GetStatisticsUC:    *GetStatisticsUC,
+		ModerationUC:       *ModerationUC,
 		GetAdminUC:         *GetAdminUC,
 		Logger:             logger,
 	}, nil
@@ -402,6 +504,9 @@ func NewQueryHandler(
 func NewMessageHandler(
 	messageRepo repository.ChatRepository,
 	notifrepo repository.NotificationsRepository,
+	presenceRepo repository.PresenceRepository,
+	blockRepo repository.BlockRepository,
+	attachmentStorage repository.AttachmentStorage,
 	Subscriber *redis.Client,
 	logger *logger.LogrusLogger,
 ) (*MessageHandler, error) {
@@ -415,17 +520,21 @@ func NewMessageHandler(
 		return nil, err
 	}
 
-	deleteChatsUC, err := usecase.NewDeleteChatUseCase(messageRepo, logger)
+	deleteChatsUC, err := usecase.NewDeleteChatUseCase(messageRepo, attachmentStorage, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	getMessages, err := usecase.NewGetMessagesUseCase(messageRepo, logger)
+	getMessagesPage, err := usecase.NewGetMessagesPageUseCase(messageRepo, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	deleteMessage, err := usecase.NewDeleteMessageUseCase(messageRepo, logger)
+	deleteMessage, err := usecase.NewDeleteMessageUseCase(messageRepo, attachmentStorage, logger)
+	if err != nil {
+		return nil, err
+	}
+	editMessage, err := usecase.NewEditMessageUseCase(messageRepo, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -452,17 +561,56 @@ func NewMessageHandler(
 		return nil, err
 	}
 
+	updatePresenceUC, err := usecase.NewUpdatePresenceUseCase(presenceRepo, messageRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+	getPresenceUC, err := usecase.NewGetPresenceUseCase(presenceRepo, messageRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+	setTypingUC, err := usecase.NewSetTypingUseCase(presenceRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	blocksUC, err := usecase.NewBlocksUseCase(blockRepo, messageRepo, presenceRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadAttachmentUC, err := usecase.NewUploadAttachmentUseCase(messageRepo, attachmentStorage, logger)
+	if err != nil {
+		return nil, err
+	}
+	getAttachmentUC, err := usecase.NewGetAttachmentUseCase(messageRepo, attachmentStorage, logger)
+	if err != nil {
+		return nil, err
+	}
+	sweepAttachmentsUC, err := usecase.NewSweepAttachmentsUseCase(messageRepo, attachmentStorage, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &MessageHandler{
 		GetParticipantsUC:      *getParticipantsUC,
 		GetChatsUC:             *getChatsUC,
 		CreateChatUC:           *createChatsUC,
 		DeleteChatUC:           *deleteChatsUC,
-		GetMessagesUC:          *getMessages,
+		GetMessagesPageUC:      *getMessagesPage,
 		DeleteMessageUC:        *deleteMessage,
+		EditMessageUC:          *editMessage,
 		CreateMessagesUC:       *createMessageUC,
 		GetMessagesFromCacheUC: *getMessagesFromCacheUC,
 		UpdateMessageStatusUC:  *updateMessageStatusUC,
 		AddNotificationUC:      *AddNotification,
+		UpdatePresenceUC:       *updatePresenceUC,
+		GetPresenceUC:          *getPresenceUC,
+		SetTypingUC:            *setTypingUC,
+		BlocksUC:               *blocksUC,
+		UploadAttachmentUC:     *uploadAttachmentUC,
+		GetAttachmentUC:        *getAttachmentUC,
+		SweepAttachmentsUC:     *sweepAttachmentsUC,
 		Subscriber:             Subscriber,
 		Logger:                 logger,
 	}, nil
@@ -471,6 +619,9 @@ func NewMessageHandler(
 func NewProfilesHandler(
 	conn *grpc.ClientConn,
 	notifrepo repository.NotificationsRepository,
+	chatRepo repository.ChatRepository,
+	blockRepo repository.BlockRepository,
+	savedSearchRepo repository.SavedSearchRepository,
 	admin_conn *grpc.ClientConn,
 	Subscriber *redis.Client,
 	logger *logger.LogrusLogger,
@@ -543,6 +694,48 @@ func NewProfilesHandler(
 		return nil, err
 	}
 
+	ManagePhotos, err := usecase.NewManagePhotosUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	RewindLike, err := usecase.NewRewindLikeUseCase(client, notifrepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetPremium, err := usecase.NewGetPremiumUseCase(admin_client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetIncomingLikes, err := usecase.NewGetIncomingLikesUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	presenceRepo := repository.NewPresenceRepo(Subscriber)
+
+	Unmatch, err := usecase.NewUnmatchUseCase(client, chatRepo, presenceRepo, notifrepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	Blocks, err := usecase.NewBlocksUseCase(blockRepo, chatRepo, presenceRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	Quotas, err := usecase.NewQuotasUseCase(repository.NewQuotaRepo(Subscriber), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	SavedSearches, err := usecase.NewSavedSearchesUseCase(client, savedSearchRepo, notifrepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ProfilesHandler{
 		DeleteImageUC:         *DeleteImage,
 		GetProfileImagesUC:    *GetProfileImages,
@@ -550,13 +743,21 @@ func NewProfilesHandler(
 		GetProfileUC:          *GetProfile,
 		GetProfilesUC:         *GetProfiles,
 		SetProfilesLikeUC:     *SetProfilesLike,
+		RewindLikeUC:          *RewindLike,
+		UnmatchUC:             *Unmatch,
+		BlocksUC:              *Blocks,
+		GetIncomingLikesUC:    *GetIncomingLikes,
+		GetPremiumUC:          *GetPremium,
+		QuotaUC:               *Quotas,
 		UpdateProfileUC:       *UpdateProfile,
 		UpdateProfileImagesUC: *UpdateProfileImages,
+		ManagePhotosUC:        *ManagePhotos,
 		Subscriber:            Subscriber,
 		AddNotificationUC:     *AddNotification,
 		GetRecommendationsUC:  *GetRecommendations,
 		GetProfileStatsUC:     *GetProfileStats,
 		SearchProfileUC:       *SearchProfile,
+		SavedSearchesUC:       *SavedSearches,
 		GetAdminUC:            *GetAdmin,
 		Logger:                logger,
 	}, nil
@@ -601,11 +802,20 @@ func NewSessionHandler(
 		return &SessionHandler{}, err
 	}
 
+	manageSessionsUC, err := usecase.NewManageSessionsUseCase(
+		client,
+		logger,
+	)
+	if err != nil {
+		return &SessionHandler{}, err
+	}
+
 	return &SessionHandler{
-		LoginUC:        *loginUC,
-		CheckSessionUC: *checkSessionUC,
-		LogoutUC:       *logoutUC,
-		Logger:         logger,
+		LoginUC:          *loginUC,
+		CheckSessionUC:   *checkSessionUC,
+		LogoutUC:         *logoutUC,
+		ManageSessionsUC: *manageSessionsUC,
+		Logger:           logger,
 	}, nil
 }
 
@@ -670,11 +880,17 @@ func NewNotificationHandler(
 		return nil, err
 	}
 
+	Settings, err := usecase.NewNotificationSettingsUseCase(notifRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &NotificationsHandler{
 		GetNotificationsUC:         *GetNotifications,
 		UpdateNotificationStatusUC: *UpdateNotifications,
 		DeleteNotificationUC:       *DeleteNotifications,
 		GetCurrentNotificationsUC:  *GetCurrentNotification,
+		SettingsUC:                 *Settings,
 		Subscriber:                 Subscriber,
 		Logger:                     logger,
 	}, nil
@@ -683,6 +899,7 @@ func NewNotificationHandler(
 
 func NewSubHandler(
 	subClient repository.SubsriptionRepository,
+	sessions *SessionHandler,
 	logger *logger.LogrusLogger,
 ) (*SubHandler, error) {
 	AddSubscription, err := usecase.NewAddSubscriptionUseCase(subClient, logger)
@@ -696,6 +913,17 @@ func NewSubHandler(
 	return &SubHandler{
 		AddSubUC:       *AddSubscription,
 		UpdateBorderUC: *UpdateBorder,
+		Sessions:       sessions,
 		Logger:         logger,
 	}, nil
 }
+
+// digestDelay reads DIGEST_DELAY_MINUTES, the age of unread notifications
+// that get into a digest.
+func digestDelay() time.Duration {
+	minutes, err := strconv.Atoi(os.Getenv("DIGEST_DELAY_MINUTES"))
+	if err != nil || minutes <= 0 {
+		return model.DigestDelay
+	}
+	return time.Duration(minutes) * time.Minute
+}
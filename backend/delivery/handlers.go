@@ -3,11 +3,13 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
-	"sort"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -25,18 +27,22 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SessionHandler struct {
-	LoginUC        usecase.UserLogIn
-	CheckSessionUC usecase.UserCheckSession
-	LogoutUC       usecase.UserLogOut
-	Logger         *logger.LogrusLogger
+	LoginUC          usecase.UserLogIn
+	CheckSessionUC   usecase.UserCheckSession
+	LogoutUC         usecase.UserLogOut
+	ManageSessionsUC usecase.ManageSessions
+	Logger           *logger.LogrusLogger
 }
 
 type SubHandler struct {
 	AddSubUC       usecase.AddSubscription
 	UpdateBorderUC usecase.UpdateBorder
+	Sessions       *SessionHandler
 
 	Logger *logger.LogrusLogger
 }
@@ -56,6 +62,7 @@ type ComplaintHandler struct {
 	DeleteComplaintsUC usecase.DeleteComplaint
 	HandleComplaintUC  usecase.HandleComplaint
 	GetStatisticsUC    usecase.GetStatisticsCompl
+	ModerationUC       usecase.Moderation
 
 	GetAdminUC usecase.GetAdmin
 
@@ -69,9 +76,17 @@ type ProfilesHandler struct {
 	GetProfileUC          usecase.GetProfile
 	GetProfilesUC         usecase.GetProfilesForUser
 	SetProfilesLikeUC     usecase.ProfileSetLike
+	RewindLikeUC          usecase.RewindLike
+	UnmatchUC             usecase.Unmatch
+	BlocksUC              usecase.Blocks
+	GetIncomingLikesUC    usecase.GetIncomingLikes
+	GetPremiumUC          usecase.GetPremium
+	QuotaUC               usecase.Quotas
 	UpdateProfileUC       usecase.ProfileUpdate
 	UpdateProfileImagesUC usecase.StaticUpload
+	ManagePhotosUC        usecase.ManagePhotos
 	SearchProfileUC       usecase.SearchProfiles
+	SavedSearchesUC       usecase.SavedSearches
 	AddNotificationUC     usecase.AddNotification
 	Subscriber            *redis.Client
 	GetAdminUC            usecase.GetAdmin
@@ -99,14 +114,24 @@ type MessageHandler struct {
 	CreateChatUC      usecase.CreateChat
 	DeleteChatUC      usecase.DeleteChat
 
-	GetMessagesUC          usecase.GetMessages
+	GetMessagesPageUC      usecase.GetMessagesPage
 	DeleteMessageUC        usecase.DeleteMessage
+	EditMessageUC          usecase.EditMessage
 	CreateMessagesUC       usecase.CreateMessages
 	GetMessagesFromCacheUC usecase.GetMessagesFromCache
 	UpdateMessageStatusUC  usecase.UpdateMessageStatus
 
 	AddNotificationUC usecase.AddNotification
 
+	UpdatePresenceUC usecase.UpdatePresence
+	GetPresenceUC    usecase.GetPresence
+	SetTypingUC      usecase.SetTyping
+	BlocksUC         usecase.Blocks
+
+	UploadAttachmentUC usecase.UploadAttachment
+	GetAttachmentUC    usecase.GetAttachment
+	SweepAttachmentsUC usecase.SweepAttachments
+
 	Subscriber *redis.Client
 
 	Logger *logger.LogrusLogger
@@ -118,6 +143,7 @@ type NotificationsHandler struct {
 	DeleteNotificationUC       usecase.DeleteNotification
 
 	GetCurrentNotificationsUC usecase.GetCurrentNotifications
+	SettingsUC                usecase.NotificationSettings
 	Subscriber                *redis.Client
 	Logger                    *logger.LogrusLogger
 }
@@ -128,417 +154,6 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-func (mh *NotificationsHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
-	mh.Logger.WithFields(&logrus.Fields{
-		"method":     r.Method,
-		"path":       r.URL.Path,
-		"request_id": r.Header.Get("request_id"),
-	}).Info("request started")
-
-	userIDRaw := r.Context().Value(userIDKey)
-	profileId, ok := userIDRaw.(uint32)
-	if !ok {
-		mh.Logger.WithFields(&logrus.Fields{
-			"error": "failed to get userID from context",
-		}).Warn("unauthorized access attempt")
-
-		MakeEasyJSONResponse(w, http.StatusUnauthorized,
-			&model.ErrorResponse{Message: "You don't have access"},
-		)
-		return
-	}
-
-	conn, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: "Failed to establish WebSocket connection"},
-		)
-	}
-	defer conn.Close()
-
-	notifications, err := mh.GetNotificationsUC.GetNotifications(int(profileId))
-	if err != nil {
-		mh.Logger.Error("Failed to load initial notifications: ", err)
-		conn.WriteJSON(map[string]interface{}{"error": fmt.Sprintf("Failed to load initial notifications %v", err)})
-		return
-	}
-	conn.WriteJSON(map[string]interface{}{"type": "init_notifications", "notifications": notifications})
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	channelName := fmt.Sprintf("user:%d notifications", profileId)
-	pubsub := mh.Subscriber.Subscribe(ctx, channelName)
-	defer pubsub.Close()
-
-	done := make(chan struct{})
-
-	go func() {
-		for {
-			select {
-			case <-done:
-				return
-			case <-pubsub.Channel():
-				newNotifications, err := mh.GetCurrentNotificationsUC.GetCurrentNotifications(int(profileId))
-				if err != nil {
-					mh.Logger.Error("Failed to get messages from cache (ticker):", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to get messages"})
-					continue
-				}
-				if len(newNotifications) > 0 {
-					conn.WriteJSON(map[string]interface{}{"type": "new_notifications", "notifications": newNotifications})
-				}
-			}
-		}
-	}()
-
-	for {
-		_, msgData, err := conn.ReadMessage()
-		if err != nil {
-			mh.Logger.Error("Error reading message from WebSocket: ", err)
-			conn.WriteJSON(map[string]interface{}{"error": "Error reading message"})
-			close(done)
-			break
-		}
-
-		var wsMessage model.WSMessage
-		if err := easyjson.Unmarshal(msgData, &wsMessage); err != nil {
-			mh.Logger.Error("Failed to unmarshal WSMessage: ", err)
-			conn.WriteJSON(map[string]interface{}{"error": "Invalid message format"})
-			continue
-		}
-
-		switch wsMessage.Type {
-		case "sendFlowers":
-			messageReceived.WithLabelValues().Inc()
-			var payload model.FlowersPayload
-			if err := easyjson.Unmarshal(wsMessage.Payload, &payload); err != nil {
-				mh.Logger.Error("Failed to unmarshal payload: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Invalid payload"})
-				break
-			}
-			go func(payload model.FlowersPayload) {
-				notif := model.NotificationSend{
-					NotifType: "flowers",
-					Content:   fmt.Sprintf("User %d sent you flowers!", profileId),
-					Read:      0,
-				}
-				data, _ := json.Marshal(notif)
-				channel := fmt.Sprintf("user:%d notifications", payload.UserID)
-				err := mh.Subscriber.Publish(context.Background(), channel, data).Err()
-				if err != nil {
-					mh.Logger.Error("Failed to publish flowers notification: ", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to notify"})
-					return
-				}
-				conn.WriteJSON(map[string]interface{}{"type": "SentFlowersTo", "user": payload.UserID})
-
-				redisKey := fmt.Sprintf("CACHE:user:%dnotifications", payload.UserID)
-
-				jsonNotif, err := json.Marshal(notif)
-				if err != nil {
-					return
-				}
-
-				pipe := mh.Subscriber.TxPipeline()
-				pipe.LPush(context.Background(), redisKey, jsonNotif)
-				pipe.Expire(context.Background(), redisKey, 30*time.Minute)
-				_, _ = pipe.Exec(context.Background())
-
-			}(payload)
-
-		case "delete":
-			messageReceived.WithLabelValues().Inc()
-			var payload model.DeleteNotifPayload
-			if err := easyjson.Unmarshal(wsMessage.Payload, &payload); err != nil {
-				mh.Logger.Error("Failed to unmarshal ReadPayload: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Invalid read payload"})
-				break
-			}
-			go func(payload model.DeleteNotifPayload) {
-				err := mh.DeleteNotificationUC.DeleteNotifications(payload.NotifID, int(profileId))
-				if err != nil {
-					mh.Logger.Error("Failed to update message status: ", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to update message status"})
-					return
-				}
-				conn.WriteJSON(map[string]interface{}{"type": "status_updated", "user": profileId})
-			}(payload)
-
-		case "read":
-			var payload model.ReadNotifPayload
-			if err := easyjson.Unmarshal(wsMessage.Payload, &payload); err != nil {
-				mh.Logger.Error("Failed to unmarshal payload: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Invalid payload"})
-				break
-			}
-			messageReceived.WithLabelValues().Inc()
-			go func(payload model.ReadNotifPayload) {
-				err := mh.UpdateNotificationStatusUC.UpdateNotificatons(int(profileId), payload.NotifType)
-				if err != nil {
-					mh.Logger.Error("Failed to update message status: ", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to update message status"})
-					return
-				}
-				conn.WriteJSON(map[string]interface{}{"type": "status_updated", "user": profileId})
-			}(payload)
-
-		default:
-			mh.Logger.Warn("Unknown action: ", wsMessage.Type)
-			conn.WriteJSON(map[string]interface{}{"error": "Unknown action type"})
-		}
-	}
-}
-
-func (mh *MessageHandler) HandleChat(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	chatIDStr, ok := vars["chat_id"]
-	if !ok {
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Missing chat_id in URL"},
-		)
-		return
-	}
-
-	chatID, err := strconv.Atoi(chatIDStr)
-	if err != nil {
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Invalid chat_id format"},
-		)
-		return
-	}
-
-	userIDRaw := r.Context().Value(userIDKey)
-	profileId, ok := userIDRaw.(uint32)
-	if !ok {
-		mh.Logger.WithFields(&logrus.Fields{
-			"error": "failed to get userID from context",
-		}).Warn("unauthorized access attempt")
-
-		MakeEasyJSONResponse(w, http.StatusUnauthorized,
-			&model.ErrorResponse{Message: "You don't have access"},
-		)
-
-		return
-	}
-
-	conn, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: "Failed to establish WebSocket connection"},
-		)
-		return
-	}
-	defer conn.Close()
-
-	first, second, err := mh.GetParticipantsUC.GetChatParticipants(chatID)
-	if err != nil {
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: "Failed to get chat participants"},
-		)
-		return
-	}
-
-	if profileId != uint32(first) && profileId != uint32(second) {
-		MakeEasyJSONResponse(w, http.StatusUnauthorized,
-			&model.ErrorResponse{Message: "You don't have access"},
-		)
-		return
-	}
-
-	messages, err := mh.GetMessagesUC.GetMessages(chatID)
-	if err != nil {
-		mh.Logger.Error("Failed to load initial messages: ", err)
-		conn.WriteJSON(map[string]interface{}{"error": "Failed to load initial messages"})
-		return
-	}
-	new_messages_first, err := mh.GetMessagesFromCacheUC.GetMessages(chatID, first)
-	if err != nil {
-		mh.Logger.Error("Failed to load initial messages: ", err)
-		conn.WriteJSON(map[string]interface{}{"error": "Failed to load initial messages"})
-		return
-	}
-	new_messages_second, err := mh.GetMessagesFromCacheUC.GetMessages(chatID, second)
-	if err != nil {
-		mh.Logger.Error("Failed to load initial messages: ", err)
-		conn.WriteJSON(map[string]interface{}{"error": "Failed to load initial messages"})
-		return
-	}
-
-	msgMap := make(map[int]model.Message)
-
-	for _, m := range messages {
-		msgMap[m.MessageID] = m
-	}
-	for _, m := range append(new_messages_first, new_messages_second...) {
-		if _, exists := msgMap[m.MessageID]; !exists {
-			msgMap[m.MessageID] = m
-		}
-	}
-	var allMessages []model.Message
-	for _, m := range msgMap {
-		allMessages = append(allMessages, m)
-	}
-
-	sort.Slice(allMessages, func(i, j int) bool {
-		return allMessages[i].CreatedAt.Before(allMessages[j].CreatedAt)
-	})
-
-	conn.WriteJSON(map[string]interface{}{"type": "init_messages", "messages": allMessages})
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	channelName := fmt.Sprintf("user:%d chat:%d messages", profileId, chatID)
-	pubsub := mh.Subscriber.Subscribe(ctx, channelName)
-	defer pubsub.Close()
-
-	done := make(chan struct{})
-
-	go func() {
-		for {
-			select {
-			case <-done:
-				return
-			case <-pubsub.Channel():
-				newMessages, err := mh.GetMessagesFromCacheUC.GetMessages(chatID, int(profileId))
-				if err != nil {
-					mh.Logger.Error("Failed to get messages from cache (ticker): ", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to get messages"})
-					continue
-				}
-				if len(newMessages) > 0 {
-					conn.WriteJSON(map[string]interface{}{"type": "new_messages", "messages": newMessages})
-				}
-			}
-		}
-	}()
-
-	for {
-		_, msgData, err := conn.ReadMessage()
-		if err != nil {
-			mh.Logger.Error("Error reading message from WebSocket: ", err)
-			conn.WriteJSON(map[string]interface{}{"error": "Error reading message"})
-			close(done)
-			break
-		}
-
-		var wsMessage model.WSMessage
-		if err := easyjson.Unmarshal(msgData, &wsMessage); err != nil {
-			mh.Logger.Error("Failed to unmarshal WSMessage: ", err)
-			conn.WriteJSON(map[string]interface{}{"error": "Invalid message format"})
-			continue
-		}
-
-		switch wsMessage.Type {
-		case "create":
-			messageSent.WithLabelValues().Inc()
-			var payload model.CreatePayload
-			if err := easyjson.Unmarshal(wsMessage.Payload, &payload); err != nil {
-				mh.Logger.Error("Failed to unmarshal CreatePayload: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Invalid create payload"})
-				break
-			}
-			if ((payload.UserID != first) && (payload.UserID != second)) || (payload.ChatID != chatID) {
-				MakeEasyJSONResponse(w, http.StatusUnauthorized,
-					&model.ErrorResponse{Message: "You don't have access"},
-				)
-				break
-			}
-			recieverID := first
-			if payload.UserID == first {
-				recieverID = second
-			}
-
-			notif := model.NotificationSend{
-				NotifType: "message",
-				Content:   fmt.Sprintf("User %d sent you a message!", payload.UserID),
-				Read:      0,
-			}
-
-			err := mh.AddNotificationUC.AddNotification(recieverID, notif)
-			if err != nil {
-				fmt.Println(err)
-				mh.Logger.Error("Failed to save notification: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Failed to notify"})
-				return
-			}
-
-			go func(payload model.CreatePayload) {
-				messageID, err := mh.CreateMessagesUC.CreateMessages(payload.ChatID, payload.UserID, payload.Content)
-				if err != nil {
-					mh.Logger.Error("Failed to create message: ", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to create message"})
-					return
-				}
-				conn.WriteJSON(map[string]interface{}{"type": "created", "message_id": messageID})
-			}(payload)
-
-		case "delete":
-			messageSent.WithLabelValues().Inc()
-			var payload model.DeletePayload
-			if err := easyjson.Unmarshal(wsMessage.Payload, &payload); err != nil {
-				mh.Logger.Error("Failed to unmarshal DeletePayload: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Invalid delete payload"})
-				break
-			}
-			if payload.ChatID != chatID {
-				MakeEasyJSONResponse(w, http.StatusUnauthorized,
-					&model.ErrorResponse{Message: "You don't have access"},
-				)
-				break
-			}
-			go func(payload model.DeletePayload) {
-				err := mh.DeleteMessageUC.DeleteMessage(payload.MessageID, payload.ChatID)
-				if err != nil {
-					mh.Logger.Error("Failed to delete message: ", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to delete message"})
-					return
-				}
-				conn.WriteJSON(map[string]interface{}{"type": "deleted", "message_id": payload.MessageID})
-			}(payload)
-
-		case "get":
-			messageReceived.WithLabelValues().Inc()
-			newMessages, err := mh.GetMessagesFromCacheUC.GetMessages(chatID, int(profileId))
-			if err != nil {
-				mh.Logger.Error("Failed to get messages from cache: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Failed to get messages"})
-				break
-			}
-			conn.WriteJSON(map[string]interface{}{"type": "new_messages", "messages": newMessages})
-
-		case "read":
-			messageReceived.WithLabelValues().Inc()
-			var payload model.ReadPayload
-			if err := easyjson.Unmarshal(wsMessage.Payload, &payload); err != nil {
-				mh.Logger.Error("Failed to unmarshal ReadPayload: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Invalid read payload"})
-				break
-			}
-			if payload.ChatID != chatID {
-				MakeEasyJSONResponse(w, http.StatusUnauthorized,
-					&model.ErrorResponse{Message: "You don't have access"},
-				)
-				break
-			}
-			go func(payload model.ReadPayload) {
-				err := mh.UpdateMessageStatusUC.UpdateMessageStatus(payload.ChatID, int(profileId))
-				if err != nil {
-					mh.Logger.Error("Failed to update message status: ", err)
-					conn.WriteJSON(map[string]interface{}{"error": "Failed to update message status"})
-					return
-				}
-				conn.WriteJSON(map[string]interface{}{"type": "status_updated", "chat": payload.ChatID})
-			}(payload)
-
-		default:
-			mh.Logger.Warn("Unknown action: ", wsMessage.Type)
-			conn.WriteJSON(map[string]interface{}{"error": "Unknown action type"})
-		}
-	}
-}
-
 func (mh *MessageHandler) CreateChat(w http.ResponseWriter, r *http.Request) {
 	mh.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
@@ -650,12 +265,13 @@ func (mh *MessageHandler) GetChats(w http.ResponseWriter, r *http.Request) {
 	MakeEasyJSONResponse(w, http.StatusOK, model.ChatsResponse{Chats: chats})
 }
 
-func (mh *MessageHandler) DeleteChat(w http.ResponseWriter, r *http.Request) {
+func (mh *MessageHandler) GetMessagesHistory(w http.ResponseWriter, r *http.Request) {
 	mh.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
 		"path":       r.URL.Path,
 		"request_id": r.Header.Get("request_id"),
-	}).Info("start processing DeleteChat request")
+	}).Info("start processing GetMessagesHistory request")
+	messageReceived.WithLabelValues().Inc()
 
 	userIDRaw := r.Context().Value(userIDKey)
 	profileId, ok := userIDRaw.(uint32)
@@ -670,64 +286,91 @@ func (mh *MessageHandler) DeleteChat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	body, err := io.ReadAll(r.Body)
+	chatID, err := strconv.Atoi(mux.Vars(r)["chat_id"])
 	if err != nil {
 		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Invalid request body"},
+			&model.ErrorResponse{Message: "Invalid chat_id format"},
 		)
 		return
 	}
 
-	var req model.DeleteChatRequest
-	if err := req.UnmarshalJSON(body); err != nil {
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Invalid JSON"},
+	beforeMessageID := 0
+	if raw := r.URL.Query().Get("before_message_id"); raw != "" {
+		beforeMessageID, err = strconv.Atoi(raw)
+		if err != nil || beforeMessageID < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid before_message_id"},
+			)
+			return
+		}
+	}
+
+	limit := model.DefaultMessagesPageSize
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit"},
+			)
+			return
+		}
+	}
+
+	first, second, err := mh.GetParticipantsUC.GetChatParticipants(chatID)
+	if err != nil {
+		mh.Logger.WithFields(&logrus.Fields{
+			"chat_id": chatID,
+			"error":   err.Error(),
+		}).Error("failed to get chat participants")
+
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Chat not found"},
 		)
 		return
 	}
 
-	if (req.FristID != int(profileId)) && (req.SecondID != int(profileId)) {
-		MakeEasyJSONResponse(w, http.StatusUnauthorized,
-			&model.ErrorResponse{Message: "Unauthorized"},
+	if profileId != uint32(first) && profileId != uint32(second) {
+		MakeEasyJSONResponse(w, http.StatusForbidden,
+			&model.ErrorResponse{Message: "You don't have access"},
 		)
 		return
 	}
 
-	err = mh.DeleteChatUC.DeleteChat(req.FristID, req.SecondID)
+	page, err := mh.GetMessagesPageUC.GetMessagesPage(chatID, beforeMessageID, limit)
 	if err != nil {
 		mh.Logger.WithFields(&logrus.Fields{
-			"FirstID":  req.FristID,
-			"SecondID": req.SecondID,
-			"error":    err.Error(),
-		}).Error("failed to delete chat")
+			"chat_id": chatID,
+			"error":   err.Error(),
+		}).Error("failed to get messages history")
 
 		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: fmt.Sprintf("Error creating chat: %v", err)},
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting messages: %v", err)},
 		)
 		return
 	}
 
 	mh.Logger.WithFields(&logrus.Fields{
-		"profile_id": profileId,
-	}).Info("successfully deleted chat")
+		"profile_id":     profileId,
+		"chat_id":        chatID,
+		"messages_count": len(page.Messages),
+		"next_cursor":    page.NextCursor,
+	}).Info("successfully retrieved messages history")
 
-	MakeEasyJSONResponse(w, http.StatusCreated,
-		&model.ErrorResponse{Message: "Chat deleted"},
-	)
+	MakeEasyJSONResponse(w, http.StatusOK, &page)
 }
 
-func (ph *ProfilesHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
-	ph.Logger.WithFields(&logrus.Fields{
+func (mh *MessageHandler) EditMessage(w http.ResponseWriter, r *http.Request) {
+	mh.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
 		"path":       r.URL.Path,
 		"request_id": r.Header.Get("request_id"),
-	}).Info("start processing UpdateProfile request")
+	}).Info("start processing EditMessage request")
+	messageSent.WithLabelValues().Inc()
 
-	profileUpdated.WithLabelValues("update profile").Inc()
 	userIDRaw := r.Context().Value(userIDKey)
 	profileId, ok := userIDRaw.(uint32)
 	if !ok {
-		ph.Logger.WithFields(&logrus.Fields{
+		mh.Logger.WithFields(&logrus.Fields{
 			"error": "failed to get userID from context",
 		}).Warn("unauthorized access attempt")
 
@@ -739,74 +382,1236 @@ func (ph *ProfilesHandler) UpdateProfile(w http.ResponseWriter, r *http.Request)
 
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"error":      err.Error(),
-			"profile_id": profileId,
-		}).Warn("failed to read request body")
-
 		MakeEasyJSONResponse(w, http.StatusBadRequest,
 			&model.ErrorResponse{Message: "Invalid request body"},
 		)
 		return
 	}
 
-	var profile model.Profile
-	if err := profile.UnmarshalJSON(body); err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"error":      err.Error(),
-			"profile_id": profileId,
-		}).Warn("failed to unmarshal profile")
-
+	var req model.EditPayload
+	if err := req.UnmarshalJSON(body); err != nil {
 		MakeEasyJSONResponse(w, http.StatusBadRequest,
 			&model.ErrorResponse{Message: "Invalid JSON"},
 		)
 		return
 	}
 
-	profile.ProfileId = int(profileId)
-
-	table_profile, err := ph.GetProfileUC.GetProfile(int(profileId))
+	message, err := mh.EditMessageUC.EditMessage(req.MessageID, req.ChatID, int(profileId), req.Content)
 	if err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id": profileId,
+		mh.Logger.WithFields(&logrus.Fields{
+			"chat_id":    req.ChatID,
+			"message_id": req.MessageID,
 			"error":      err.Error(),
-		}).Error("failed to get profile from database")
-
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: fmt.Sprintf("Error getting profile: %v", err)},
-		)
-		return
-	}
-
-	err = ph.UpdateProfileUC.UpdateProfile(profile, table_profile, int(profileId))
-	if err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id":   profileId,
-			"error":        err.Error(),
-			"profile_data": profile,
-		}).Error("failed to update profile")
+		}).Error("failed to edit message")
 
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: fmt.Sprintf("Error updating profile: %v", err)},
-		)
+		switch err {
+		case model.ErrEmptyMessage:
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Message content is empty"},
+			)
+		case model.ErrMessageNotFound:
+			MakeEasyJSONResponse(w, http.StatusNotFound,
+				&model.ErrorResponse{Message: "Message not found"},
+			)
+		case model.ErrMessageNotOwned:
+			MakeEasyJSONResponse(w, http.StatusForbidden,
+				&model.ErrorResponse{Message: "You don't have access"},
+			)
+		case model.ErrChatClosed:
+			MakeEasyJSONResponse(w, http.StatusForbidden,
+				&model.ErrorResponse{Message: "Chat is closed"},
+			)
+		default:
+			MakeEasyJSONResponse(w, http.StatusInternalServerError,
+				&model.ErrorResponse{Message: fmt.Sprintf("Error editing message: %v", err)},
+			)
+		}
 		return
 	}
 
-	ph.Logger.WithFields(&logrus.Fields{
+	mh.Logger.WithFields(&logrus.Fields{
 		"profile_id": profileId,
-	}).Info("profile updated successfully")
+		"chat_id":    req.ChatID,
+		"message_id": message.MessageID,
+	}).Info("successfully edited message")
 
-	MakeEasyJSONResponse(w, http.StatusOK,
-		&model.ErrorResponse{Message: "Updated"},
-	)
+	MakeEasyJSONResponse(w, http.StatusOK, &message)
 }
 
-func (ph *ProfilesHandler) GetMatches(w http.ResponseWriter, r *http.Request) {
-	ph.Logger.WithFields(&logrus.Fields{
+func (mh *MessageHandler) GetPresence(w http.ResponseWriter, r *http.Request) {
+	mh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("start processing GetPresence request")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		mh.Logger.WithFields(&logrus.Fields{
+			"error": "failed to get userID from context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	presence, err := mh.GetPresenceUC.GetPresence(int(profileId))
+	if err != nil {
+		mh.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get presence")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting presence: %v", err)},
+		)
+		return
+	}
+
+	mh.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"count":      len(presence),
+	}).Info("successfully retrieved presence")
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.PresenceResponse{Presence: presence})
+}
+
+func (mh *MessageHandler) UploadAttachments(w http.ResponseWriter, r *http.Request) {
+	mh.Logger.WithFields(&logrus.Fields{
+		"method":       r.Method,
+		"path":         r.URL.Path,
+		"request_id":   r.Header.Get("request_id"),
+		"content_type": r.Header.Get("Content-Type"),
+	}).Info("start processing UploadAttachments request")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		mh.Logger.WithFields(&logrus.Fields{
+			"error": "failed to get userID from context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	if err := r.ParseMultipartForm(model.MaxFileSize); err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: fmt.Sprintf("Invalid multipart form: %v", err)},
+		)
+		return
+	}
+
+	chatID, err := strconv.Atoi(r.FormValue("chat_id"))
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid chat_id format"},
+		)
+		return
+	}
+
+	first, second, err := mh.GetParticipantsUC.GetChatParticipants(chatID)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Chat not found"},
+		)
+		return
+	}
+	if profileId != uint32(first) && profileId != uint32(second) {
+		MakeEasyJSONResponse(w, http.StatusForbidden,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	files := r.MultipartForm.File["images"]
+	if len(files) == 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "No files in 'images' field"},
+		)
+		return
+	}
+
+	attachments := make([]model.Attachment, 0, len(files))
+	for _, fileHeader := range files {
+		file, err := fileHeader.Open()
+		if err != nil {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: fmt.Sprintf("Failed to open file: %s", fileHeader.Filename)},
+			)
+			return
+		}
+		buf, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: fmt.Sprintf("Failed to read file: %s", fileHeader.Filename)},
+			)
+			return
+		}
+
+		// the declared Content-Type is the client's word; the stored type
+		// is what the bytes actually are, as in UploadPhoto
+		sanitizedType, allowed := SanitizeImageType(http.DetectContentType(buf))
+		if !allowed {
+			mh.Logger.WithFields(&logrus.Fields{
+				"file_name":    fileHeader.Filename,
+				"content_type": fileHeader.Header.Get("Content-Type"),
+				"sniffed_type": sanitizedType,
+			}).Warn("unsupported file type")
+
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: fmt.Sprintf("Unsupported file type: %s", fileHeader.Filename)},
+			)
+			return
+		}
+
+		attachment, err := mh.UploadAttachmentUC.UploadAttachment(chatID, int(profileId), buf, fileHeader.Filename, sanitizedType)
+		if err != nil {
+			mh.Logger.WithFields(&logrus.Fields{
+				"chat_id":   chatID,
+				"file_name": fileHeader.Filename,
+				"error":     err.Error(),
+			}).Error("failed to upload attachment")
+
+			MakeEasyJSONResponse(w, http.StatusInternalServerError,
+				&model.ErrorResponse{Message: fmt.Sprintf("Error uploading attachment: %v", err)},
+			)
+			return
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	mh.Logger.WithFields(&logrus.Fields{
+		"profile_id":  profileId,
+		"chat_id":     chatID,
+		"attachments": len(attachments),
+	}).Info("successfully uploaded attachments")
+
+	MakeEasyJSONResponse(w, http.StatusCreated, &model.AttachmentsResponse{Attachments: attachments})
+}
+
+func (mh *MessageHandler) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	mh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("start processing GetAttachment request")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		mh.Logger.WithFields(&logrus.Fields{
+			"error": "failed to get userID from context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	attachmentID, err := strconv.Atoi(mux.Vars(r)["attachment_id"])
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid attachment_id format"},
+		)
+		return
+	}
+
+	data, contentType, err := mh.GetAttachmentUC.GetAttachment(attachmentID, int(profileId))
+	if err != nil {
+		mh.Logger.WithFields(&logrus.Fields{
+			"attachment_id": attachmentID,
+			"error":         err.Error(),
+		}).Warn("failed to get attachment")
+
+		switch err {
+		case model.ErrAttachmentNotFound:
+			MakeEasyJSONResponse(w, http.StatusNotFound,
+				&model.ErrorResponse{Message: "Attachment not found"},
+			)
+		case model.ErrAttachmentForbidden:
+			MakeEasyJSONResponse(w, http.StatusForbidden,
+				&model.ErrorResponse{Message: "You don't have access"},
+			)
+		default:
+			MakeEasyJSONResponse(w, http.StatusInternalServerError,
+				&model.ErrorResponse{Message: fmt.Sprintf("Error getting attachment: %v", err)},
+			)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func (mh *MessageHandler) DeleteChat(w http.ResponseWriter, r *http.Request) {
+	mh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("start processing DeleteChat request")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		mh.Logger.WithFields(&logrus.Fields{
+			"error": "failed to get userID from context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var req model.DeleteChatRequest
+	if err := req.UnmarshalJSON(body); err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	if (req.FristID != int(profileId)) && (req.SecondID != int(profileId)) {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "Unauthorized"},
+		)
+		return
+	}
+
+	err = mh.DeleteChatUC.DeleteChat(req.FristID, req.SecondID)
+	if err != nil {
+		mh.Logger.WithFields(&logrus.Fields{
+			"FirstID":  req.FristID,
+			"SecondID": req.SecondID,
+			"error":    err.Error(),
+		}).Error("failed to delete chat")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error creating chat: %v", err)},
+		)
+		return
+	}
+
+	mh.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+	}).Info("successfully deleted chat")
+
+	MakeEasyJSONResponse(w, http.StatusCreated,
+		&model.ErrorResponse{Message: "Chat deleted"},
+	)
+}
+
+func (ph *ProfilesHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("start processing UpdateProfile request")
+
+	profileUpdated.WithLabelValues("update profile").Inc()
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "failed to get userID from context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error":      err.Error(),
+			"profile_id": profileId,
+		}).Warn("failed to read request body")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var profile model.Profile
+	if err := profile.UnmarshalJSON(body); err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error":      err.Error(),
+			"profile_id": profileId,
+		}).Warn("failed to unmarshal profile")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	profile.ProfileId = int(profileId)
+
+	table_profile, err := ph.GetProfileUC.GetProfile(int(profileId))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get profile from database")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting profile: %v", err)},
+		)
+		return
+	}
+
+	err = ph.UpdateProfileUC.UpdateProfile(profile, table_profile, int(profileId))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id":   profileId,
+			"error":        err.Error(),
+			"profile_data": profile,
+		}).Error("failed to update profile")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error updating profile: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+	}).Info("profile updated successfully")
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Updated"},
+	)
+}
+
+// SetCoordinates stores the position reported by the client on PUT and
+// drops it on DELETE, after which the city's coordinates are used again.
+func (ph *ProfilesHandler) SetCoordinates(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("SetCoordinates request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	var pos *model.CoordinatesRequest
+	if r.Method != http.MethodDelete {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid request body"},
+			)
+			return
+		}
+		pos = &model.CoordinatesRequest{}
+		if err := pos.UnmarshalJSON(body); err != nil {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid JSON"},
+			)
+			return
+		}
+	}
+
+	err := ph.UpdateProfileUC.SetCoordinates(int(profileId), pos)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid coordinates"},
+		)
+		return
+	case codes.NotFound:
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Profile not found"},
+		)
+		return
+	default:
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to set coordinates")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to set coordinates"},
+		)
+		return
+	}
+
+	// distances in the cached feed page are stale now
+	_ = ph.Subscriber.Del(context.Background(), fmt.Sprintf("cached_profiles:%d", profileId)).Err()
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Coordinates updated"})
+}
+func (ph *ProfilesHandler) GetMatches(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("GetMatches request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+	}).Debug("attempting to get matches")
+
+	profiles, err := ph.GetProfileMatchesUC.GetMatches(int(profileId))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get matches")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting profile: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id":    profileId,
+		"matches_count": len(profiles),
+	}).Info("successfully retrieved matches")
+
+	MakeEasyJSONResponse(w, http.StatusOK, model.ProfileResponse{Profiles: profiles})
+}
+
+// Unmatch breaks the match with profile {id}, closes the chat with it and
+// keeps the pair out of each other's feed for good.
+func (ph *ProfilesHandler) Unmatch(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("Unmatch request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	peerId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || peerId <= 0 || peerId == int(profileId) {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile id"},
+		)
+		return
+	}
+
+	chatId, err := ph.UnmatchUC.Unmatch(int(profileId), peerId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			MakeEasyJSONResponse(w, http.StatusNotFound,
+				&model.ErrorResponse{Message: "You are not matched with this user"},
+			)
+			return
+		}
+
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"peer_id":    peerId,
+			"error":      err.Error(),
+		}).Error("failed to unmatch")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to unmatch"},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"peer_id":    peerId,
+		"chat_id":    chatId,
+	}).Info("successfully unmatched")
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.UnmatchResponse{ProfileId: peerId, ChatId: chatId})
+}
+
+// checkNotBlocked answers 404 when either profile has blocked the other, the
+// same as for a profile that does not exist.
+func (ph *ProfilesHandler) checkNotBlocked(w http.ResponseWriter, profileId int, peerId int) bool {
+	blocked, err := ph.BlocksUC.IsBlocked(profileId, peerId)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to check blocks"},
+		)
+		return false
+	}
+	if blocked {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Profile not found"},
+		)
+		return false
+	}
+	return true
+}
+
+// BlockProfile adds profile {id} to the block list on POST and removes it
+// on DELETE.
+func (ph *ProfilesHandler) BlockProfile(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("BlockProfile request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	peerId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || peerId <= 0 || peerId == int(profileId) {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile id"},
+		)
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		err = ph.BlocksUC.Unblock(int(profileId), peerId)
+	} else {
+		err = ph.BlocksUC.Block(int(profileId), peerId)
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"peer_id":    peerId,
+			"method":     r.Method,
+			"error":      err.Error(),
+		}).Error("failed to update block list")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to update block list"},
+		)
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Unblocked"})
+		return
+	}
+	// the cached feed pages may still hold the other profile
+	_ = ph.Subscriber.Del(context.Background(),
+		fmt.Sprintf("cached_profiles:%d", profileId),
+		fmt.Sprintf("cached_profiles:%d", peerId),
+	).Err()
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Blocked"})
+}
+
+func (ph *ProfilesHandler) GetBlockedProfiles(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("GetBlockedProfiles request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	blocked, err := ph.BlocksUC.GetBlocked(int(profileId))
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get block list"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.BlockedProfilesResponse{Blocked: blocked})
+}
+
+func (ph *ProfilesHandler) SearchProfiles(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("SearchProfiles request started")
+
+	searchPerformed.WithLabelValues("search profiles").Inc()
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized profiles access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"requester_id": profileId,
+	}).Debug("attempting to get profiles list")
+
+	var input model.SearchProfileRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to read request body")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	if err := input.UnmarshalJSON(body); err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to unmarshal search profile request")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+	if msg := validateSearchRequest(input); msg != "" {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: msg},
+		)
+		return
+	}
+	page, err := ph.SearchProfileUC.GetSearchProfiles(int(profileId), input)
+	if status.Code(err) == codes.InvalidArgument {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid cursor"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"requester_id": profileId,
+			"error":        err.Error(),
+		}).Error("failed to get profiles list")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting profile: %v", err)},
+		)
+		return
+	}
+
+	if len(page.Profiles) == 0 {
+		MakeEasyJSONResponse(w, http.StatusAccepted,
+			&model.ErrorResponse{Message: "There are no profiles"},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"requester_id":   profileId,
+		"profiles_count": len(page.Profiles),
+		"total":          page.Total,
+	}).Info("profiles list retrieved successfully")
+
+	MakeEasyJSONResponse(w, http.StatusOK, &page)
+}
+
+// validateSearchRequest returns the error message for a bad search, or "".
+func validateSearchRequest(input model.SearchProfileRequest) string {
+	if input.MaxDistanceKm < 0 || input.MaxDistanceKm > model.MaxDiscoveryRadiusKm {
+		return "Invalid maxDistanceKm"
+	}
+	if !slices.Contains(model.SearchSortModes, input.SortBy) {
+		return "Invalid sortBy"
+	}
+	if input.Limit < 0 || input.Limit > model.MaxSearchPageSize {
+		return "Invalid limit"
+	}
+	return ""
+}
+
+func (ph *ProfilesHandler) GetSavedSearches(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("GetSavedSearches request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	searches, err := ph.SavedSearchesUC.List(int(profileId))
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get saved searches"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.SavedSearchesResponse{Searches: searches})
+}
+
+func (ph *ProfilesHandler) SaveSearch(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("SaveSearch request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.SaveSearchRequest
+	if err := input.UnmarshalJSON(body); err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" || len([]rune(input.Name)) > model.MaxSavedSearchNameLength {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid name"},
+		)
+		return
+	}
+	if msg := validateSearchRequest(input.Params); msg != "" {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: msg},
+		)
+		return
+	}
+
+	search, err := ph.SavedSearchesUC.Save(int(profileId), input.Name, input.Params)
+	if errors.Is(err, model.ErrTooManySavedSearches) {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: fmt.Sprintf("You can save up to %d searches", model.MaxSavedSearches)},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to save search")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to save search"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &search)
+}
+
+func (ph *ProfilesHandler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("DeleteSavedSearch request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	searchId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || searchId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid search id"},
+		)
+		return
+	}
+
+	err = ph.SavedSearchesUC.Delete(int(profileId), searchId)
+	if errors.Is(err, model.ErrSavedSearchNotFound) {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Saved search not found"},
+		)
+		return
+	}
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to delete saved search"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Deleted"})
+}
+
+func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("SetLike request started")
+
+	likeSet.WithLabelValues("set like").Inc()
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to read like request body")
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid request body"})
+		return
+	}
+
+	var input model.SetLike
+	if err := input.UnmarshalJSON(body); err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to unmarshal like request body")
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid JSON"})
+		return
+	}
+
+	likeFrom := input.LikeFrom
+	likeTo := input.LikeTo
+	likeStatus := input.Status
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"like_from":  likeFrom,
+		"like_to":    likeTo,
+		"status":     likeStatus,
+	}).Debug("processing like action")
+
+	if likeTo == likeFrom {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+		}).Warn("attempt to like oneself")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Please don't like yourself"},
+		)
+		return
+	}
+
+	if int(profileId) != likeFrom {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"like_from":  likeFrom,
+		}).Warn("unauthorized like attempt")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "You are unauthorized to like this user"},
+		)
+		return
+	}
+
+	if !ph.checkNotBlocked(w, likeFrom, likeTo) {
+		return
+	}
+
+	quotaKind := ""
+	switch likeStatus {
+	case 1:
+		quotaKind = model.QuotaLikes
+	case 3:
+		quotaKind = model.QuotaSuperlikes
+	}
+	if quotaKind != "" {
+		if _, ok := ph.consumeQuota(w, int(profileId), IsPremium, quotaKind); !ok {
+			return
+		}
+	}
+
+	like_id, err := ph.SetProfilesLikeUC.SetLike(likeFrom, likeTo, likeStatus)
+	if quotaKind != "" && (err != nil || like_id == 0) {
+		ph.QuotaUC.Refund(int(profileId), quotaKind)
+	}
+	if status.Code(err) == codes.FailedPrecondition {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Profile not found"},
+		)
+		return
+	}
+	if (like_id == 0) && (err == nil) {
+		ph.Logger.WithFields(&logrus.Fields{
+			"like_from": likeFrom,
+			"like_to":   likeTo,
+		}).Info("duplicate like detected")
+
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Already liked"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"like_from": likeFrom,
+			"like_to":   likeTo,
+			"error":     err.Error(),
+		}).Error("failed to set like")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting like: %v", err)},
+		)
+		return
+	}
+
+	if like_id == -1 {
+		ph.notifyMatch(likeFrom, likeTo)
+	}
+
+	ph.removeFromFeed(int(profileId), likeTo)
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"like_id":   like_id,
+		"like_from": likeFrom,
+		"like_to":   likeTo,
+		"status":    likeStatus,
+	}).Info("like successfully processed")
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Liked"},
+	)
+}
+
+// notifyMatch tells both profiles about a new match.
+func (ph *ProfilesHandler) notifyMatch(first int, second int) {
+	for _, pair := range [][2]int{{second, first}, {first, second}} {
+		notif := model.NotificationSend{
+			NotifType: model.NotificationMatch,
+			Content:   fmt.Sprintf(model.MatchNotificationFormat, pair[1]),
+			Read:      0,
+			Payload: &model.NotificationPayload{
+				Kind:    model.NotificationMatch,
+				ActorID: pair[1],
+				Link:    fmt.Sprintf(model.ProfileLinkFormat, pair[1]),
+			},
+		}
+		if err := ph.AddNotificationUC.AddNotification(pair[0], notif); err != nil {
+			ph.Logger.Error("Failed to save notification: ", err)
+		}
+	}
+}
+
+// removeFromFeed drops a swiped profile from the cached feed.
+func (ph *ProfilesHandler) removeFromFeed(profileId int, swipedId int) {
+	redisKey := fmt.Sprintf("cached_profiles:%d", profileId)
+
+	cachedData, err := ph.Subscriber.Get(context.Background(), redisKey).Result()
+	if err != nil {
+		if err != redis.Nil {
+			ph.Logger.WithError(err).Error("failed to get cached profiles")
+		}
+		return
+	}
+
+	var profiles []model.Profile
+	if err := json.Unmarshal([]byte(cachedData), &profiles); err != nil {
+		ph.Logger.WithError(err).Error("failed to unmarshal cached profiles")
+		return
+	}
+
+	filtered := make([]model.Profile, 0, len(profiles))
+	for _, p := range profiles {
+		if p.ProfileId != swipedId {
+			filtered = append(filtered, p)
+		}
+	}
+	if len(filtered) == 0 {
+		err = ph.Subscriber.Del(context.Background(), redisKey).Err()
+		if err != nil {
+			ph.Logger.WithError(err).Error("failed to update cached profiles in redis")
+		}
+		return
+	}
+
+	newData, err := json.Marshal(filtered)
+	if err != nil {
+		ph.Logger.WithError(err).Error("failed to marshal updated profiles")
+		return
+	}
+
+	err = ph.Subscriber.Set(context.Background(), redisKey, newData, 30*time.Minute).Err()
+	if err != nil {
+		ph.Logger.WithError(err).Error("failed to update cached profiles in redis")
+	}
+}
+
+func (ph *ProfilesHandler) GetIncomingLikes(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("GetIncomingLikes request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	var err error
+	cursor := 0
+	if raw := r.URL.Query().Get("cursor"); raw != "" {
+		cursor, err = strconv.Atoi(raw)
+		if err != nil || cursor < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid cursor"},
+			)
+			return
+		}
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit"},
+			)
+			return
+		}
+	}
+
+	page, err := ph.GetIncomingLikesUC.GetIncomingLikes(int(profileId), cursor, limit, !IsPremium)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get incoming likes")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get incoming likes"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &page)
+}
+
+// LikeBack answers a pending like from the inbox. The other profile already
+// liked us, so SetLike forms the match right away.
+func (ph *ProfilesHandler) LikeBack(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
 		"path":       r.URL.Path,
 		"request_id": r.Header.Get("request_id"),
-	}).Info("GetMatches request started")
+	}).Info("LikeBack request started")
 
 	userIDRaw := r.Context().Value(userIDKey)
 	profileId, ok := userIDRaw.(uint32)
@@ -821,46 +1626,71 @@ func (ph *ProfilesHandler) GetMatches(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ph.Logger.WithFields(&logrus.Fields{
-		"profile_id": profileId,
-	}).Debug("attempting to get matches")
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+	if !IsPremium {
+		MakeEasyJSONResponse(w, http.StatusForbidden,
+			&model.ErrorResponse{Message: "You cannot see who liked you with no subscription"},
+		)
+		return
+	}
 
-	profiles, err := ph.GetProfileMatchesUC.GetMatches(int(profileId))
+	likerId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || likerId <= 0 || likerId == int(profileId) {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile id"},
+		)
+		return
+	}
+
+	if !ph.checkNotBlocked(w, int(profileId), likerId) {
+		return
+	}
+
+	if _, ok := ph.consumeQuota(w, int(profileId), IsPremium, model.QuotaLikes); !ok {
+		return
+	}
+
+	likeID, err := ph.SetProfilesLikeUC.SetLike(int(profileId), likerId, 1)
 	if err != nil {
+		ph.QuotaUC.Refund(int(profileId), model.QuotaLikes)
 		ph.Logger.WithFields(&logrus.Fields{
 			"profile_id": profileId,
+			"liker_id":   likerId,
 			"error":      err.Error(),
-		}).Error("failed to get matches")
+		}).Error("failed to like back")
 
 		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: fmt.Sprintf("Error getting profile: %v", err)},
+			&model.ErrorResponse{Message: "Failed to like back"},
 		)
 		return
 	}
 
-	ph.Logger.WithFields(&logrus.Fields{
-		"profile_id":    profileId,
-		"matches_count": len(profiles),
-	}).Info("successfully retrieved matches")
+	matched := likeID == -1
+	if matched {
+		ph.notifyMatch(int(profileId), likerId)
+	}
+	ph.removeFromFeed(int(profileId), likerId)
 
-	MakeEasyJSONResponse(w, http.StatusOK, model.ProfileResponse{Profiles: profiles})
+	MakeEasyJSONResponse(w, http.StatusOK, &model.LikeBackResponse{
+		Message: "Liked",
+		Matched: matched,
+	})
 }
 
-func (ph *ProfilesHandler) SearchProfiles(w http.ResponseWriter, r *http.Request) {
+func (ph *ProfilesHandler) RewindLike(w http.ResponseWriter, r *http.Request) {
 	ph.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
 		"path":       r.URL.Path,
 		"request_id": r.Header.Get("request_id"),
-		"ip":         r.RemoteAddr,
-	}).Info("SearchProfiles request started")
+	}).Info("RewindLike request started")
 
-	searchPerformed.WithLabelValues("search profiles").Inc()
 	userIDRaw := r.Context().Value(userIDKey)
 	profileId, ok := userIDRaw.(uint32)
 	if !ok {
 		ph.Logger.WithFields(&logrus.Fields{
 			"error": "missing or invalid userID in context",
-		}).Warn("unauthorized profiles access attempt")
+		}).Warn("unauthorized access attempt")
 
 		MakeEasyJSONResponse(w, http.StatusUnauthorized,
 			&model.ErrorResponse{Message: "You don't have access"},
@@ -868,79 +1698,120 @@ func (ph *ProfilesHandler) SearchProfiles(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	ph.Logger.WithFields(&logrus.Fields{
-		"requester_id": profileId,
-	}).Debug("attempting to get profiles list")
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
 
-	var input model.SearchProfileRequest
+	quota, ok := ph.consumeQuota(w, int(profileId), IsPremium, model.QuotaRewinds)
+	if !ok {
+		return
+	}
 
-	body, err := io.ReadAll(r.Body)
+	likedID, swipeStatus, matchRemoved, err := ph.RewindLikeUC.Rewind(int(profileId), model.RewindWindow)
 	if err != nil {
+		ph.QuotaUC.Refund(int(profileId), model.QuotaRewinds)
+		if status.Code(err) == codes.NotFound {
+			MakeEasyJSONResponse(w, http.StatusNotFound,
+				&model.ErrorResponse{Message: "Nothing to rewind"},
+			)
+			return
+		}
+		if status.Code(err) == codes.FailedPrecondition {
+			MakeEasyJSONResponse(w, http.StatusConflict,
+				&model.ErrorResponse{Message: "This swipe ended a match and cannot be rewound"},
+			)
+			return
+		}
+
 		ph.Logger.WithFields(&logrus.Fields{
 			"profile_id": profileId,
 			"error":      err.Error(),
-		}).Warn("failed to read request body")
+		}).Error("failed to rewind like")
 
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Invalid request body"},
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to rewind like"},
 		)
 		return
 	}
 
-	if err := input.UnmarshalJSON(body); err != nil {
+	profile, err := ph.GetProfileUC.GetProfile(likedID)
+	if err != nil {
 		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id": profileId,
+			"profile_id": likedID,
 			"error":      err.Error(),
-		}).Warn("failed to unmarshal search profile request")
+		}).Error("failed to get rewound profile")
+	} else {
+		ph.restoreToFeed(int(profileId), profile)
+	}
 
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Invalid JSON"},
-		)
-		return
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id":    profileId,
+		"liked_id":      likedID,
+		"match_removed": matchRemoved,
+	}).Info("like successfully rewound")
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.RewindResponse{
+		Profile:      profile,
+		Status:       swipeStatus,
+		MatchRemoved: matchRemoved,
+		RewindsLeft:  quota.Remaining,
+	})
+}
+
+// subscriptionTier is the subscription type of a premium user and
+// model.FreeTier for everybody else.
+func (ph *ProfilesHandler) subscriptionTier(profileId int, isPremium bool) (int, error) {
+	if !isPremium {
+		return model.FreeTier, nil
 	}
-	profiles, err := ph.SearchProfileUC.GetSearchProfiles(int(profileId), input)
+	_, subType, err := ph.GetPremiumUC.GetPremium(profileId)
 	if err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"requester_id": profileId,
-			"error":        err.Error(),
-		}).Error("failed to get profiles list")
+		return 0, err
+	}
+	return subType, nil
+}
 
+// consumeQuota takes one unit of a daily quota. When that is not possible it
+// writes the error response itself and reports false.
+func (ph *ProfilesHandler) consumeQuota(w http.ResponseWriter, profileId int, isPremium bool, kind string) (model.QuotaStatus, bool) {
+	tier, err := ph.subscriptionTier(profileId, isPremium)
+	if err != nil {
 		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: fmt.Sprintf("Error getting profile: %v", err)},
+			&model.ErrorResponse{Message: "Failed to get subscription"},
 		)
-		return
+		return model.QuotaStatus{}, false
 	}
 
-	if len(profiles) == 0 {
-		MakeEasyJSONResponse(w, http.StatusAccepted,
-			&model.ErrorResponse{Message: "There are no profiles"},
+	quota, err := ph.QuotaUC.Consume(profileId, tier, kind)
+	switch {
+	case err == nil:
+		return quota, true
+	case errors.Is(err, model.ErrQuotaExceeded) && quota.Limit == 0:
+		MakeEasyJSONResponse(w, http.StatusForbidden,
+			&model.ErrorResponse{Message: fmt.Sprintf("Your subscription does not include %s", kind)},
+		)
+	case errors.Is(err, model.ErrQuotaExceeded):
+		MakeEasyJSONResponse(w, http.StatusTooManyRequests,
+			&model.ErrorResponse{Message: fmt.Sprintf("Daily %s limit reached", kind)},
+		)
+	default:
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Redis error"},
 		)
-		return
 	}
-
-	ph.Logger.WithFields(&logrus.Fields{
-		"requester_id":   profileId,
-		"profiles_count": len(profiles),
-	}).Info("profiles list retrieved successfully")
-
-	MakeEasyJSONResponse(w, http.StatusOK, model.FoundProfileResponse{Profiles: profiles})
+	return quota, false
 }
 
-func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
+// GetQuota reports the remaining daily quotas and when they reset.
+func (ph *ProfilesHandler) GetQuota(w http.ResponseWriter, r *http.Request) {
 	ph.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
 		"path":       r.URL.Path,
 		"request_id": r.Header.Get("request_id"),
-	}).Info("SetLike request started")
+	}).Info("GetQuota request started")
 
-	likeSet.WithLabelValues("set like").Inc()
 	userIDRaw := r.Context().Value(userIDKey)
 	profileId, ok := userIDRaw.(uint32)
 	if !ok {
-		ph.Logger.WithFields(&logrus.Fields{
-			"error": "missing or invalid userID in context",
-		}).Warn("unauthorized access attempt")
-
 		MakeEasyJSONResponse(w, http.StatusUnauthorized,
 			&model.ErrorResponse{Message: "You don't have access"},
 		)
@@ -950,191 +1821,252 @@ func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
 	IsPremiumRaw := r.Context().Value(isPremiumKey)
 	IsPremium, _ := IsPremiumRaw.(bool)
 
-	body, err := io.ReadAll(r.Body)
+	tier, err := ph.subscriptionTier(int(profileId), IsPremium)
 	if err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id": profileId,
-			"error":      err.Error(),
-		}).Warn("failed to read like request body")
-		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid request body"})
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get subscription"},
+		)
 		return
 	}
 
-	var input model.SetLike
-	if err := input.UnmarshalJSON(body); err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id": profileId,
-			"error":      err.Error(),
-		}).Warn("failed to unmarshal like request body")
-		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid JSON"})
+	quota, err := ph.QuotaUC.Status(int(profileId), tier)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get quota"},
+		)
 		return
 	}
 
-	likeFrom := input.LikeFrom
-	likeTo := input.LikeTo
-	status := input.Status
+	MakeEasyJSONResponse(w, http.StatusOK, &quota)
+}
 
-	ph.Logger.WithFields(&logrus.Fields{
-		"profile_id": profileId,
-		"like_from":  likeFrom,
-		"like_to":    likeTo,
-		"status":     status,
-	}).Debug("processing like action")
+// restoreToFeed puts a rewound profile back at the head of the cached feed.
+// Without a cached feed there is nothing to do: the profile is no longer
+// liked, so the next feed query returns it again.
+func (ph *ProfilesHandler) restoreToFeed(profileId int, profile model.Profile) {
+	redisKey := fmt.Sprintf("cached_profiles:%d", profileId)
 
-	if likeTo == likeFrom {
-		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id": profileId,
-		}).Warn("attempt to like oneself")
+	cachedData, err := ph.Subscriber.Get(context.Background(), redisKey).Result()
+	if err != nil {
+		if err != redis.Nil {
+			ph.Logger.WithError(err).Error("failed to get cached profiles")
+		}
+		return
+	}
 
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Please don't like yourself"},
-		)
+	var profiles []model.Profile
+	if err := json.Unmarshal([]byte(cachedData), &profiles); err != nil {
+		ph.Logger.WithError(err).Error("failed to unmarshal cached profiles")
 		return
 	}
 
-	if (!IsPremium) && (status == 3) {
-		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id": profileId,
-			"like_from":  likeFrom,
-		}).Warn("no premium")
+	restored := make([]model.Profile, 0, len(profiles)+1)
+	restored = append(restored, profile)
+	for _, p := range profiles {
+		if p.ProfileId != profile.ProfileId {
+			restored = append(restored, p)
+		}
+	}
 
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "You cannot use superlike with no subscription"},
-		)
+	newData, err := json.Marshal(restored)
+	if err != nil {
+		ph.Logger.WithError(err).Error("failed to marshal updated profiles")
 		return
 	}
 
-	if int(profileId) != likeFrom {
-		ph.Logger.WithFields(&logrus.Fields{
-			"profile_id": profileId,
-			"like_from":  likeFrom,
-		}).Warn("unauthorized like attempt")
+	err = ph.Subscriber.Set(context.Background(), redisKey, newData, 30*time.Minute).Err()
+	if err != nil {
+		ph.Logger.WithError(err).Error("failed to update cached profiles in redis")
+	}
+}
 
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "You are unauthorized to like this user"},
-		)
-		return
+func CreateCookies(session model.Session) (*model.Cookie, error) {
+	cookie := &model.Cookie{
+		Name:     "session_id",
+		Value:    session.SessionId,
+		HttpOnly: true,
+		Secure:   false,
+		Expires:  time.Now().Add(sessionLifetime(session)),
+		Path:     "/",
 	}
+	return cookie, nil
+}
 
-	like_id, err := ph.SetProfilesLikeUC.SetLike(likeFrom, likeTo, status)
-	if (like_id == 0) && (err == nil) {
-		ph.Logger.WithFields(&logrus.Fields{
-			"like_from": likeFrom,
-			"like_to":   likeTo,
-		}).Info("duplicate like detected")
+func sessionLifetime(session model.Session) time.Duration {
+	if session.Expires <= 0 {
+		return model.DefaultSessionDuration
+	}
+	return session.Expires
+}
 
-		MakeEasyJSONResponse(w, http.StatusConflict,
-			&model.ErrorResponse{Message: "Already liked"},
-		)
-		return
+// setSessionCookies writes the session cookie together with a CSRF token
+// bound to it; both expire with the session.
+func (sh *SessionHandler) setSessionCookies(w http.ResponseWriter, session model.Session) error {
+	cookie, err := CreateCookies(session)
+	if err != nil {
+		return err
 	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookie.Name,
+		Value:    cookie.Value,
+		HttpOnly: cookie.HttpOnly,
+		Secure:   cookie.Secure,
+		Expires:  cookie.Expires,
+		Path:     cookie.Path,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	token, err := sh.LoginUC.CreateJwtToken(&repository.Session{
+		ID:     session.SessionId,
+		UserID: uint32(session.UserId),
+	}, cookie.Expires.Unix())
 	if err != nil {
-		ph.Logger.WithFields(&logrus.Fields{
-			"like_from": likeFrom,
-			"like_to":   likeTo,
-			"error":     err.Error(),
-		}).Error("failed to set like")
+		return err
+	}
 
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: fmt.Sprintf("Error getting like: %v", err)},
-		)
+	http.SetCookie(w, &http.Cookie{
+		Name:     "csrf_token",
+		Value:    token,
+		HttpOnly: false,
+		Secure:   false,
+		Path:     "/",
+		Expires:  cookie.Expires,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// RotateSession replaces the session of the current request with a new one
+// and reissues the session and CSRF cookies. Subscription changes are the only
+// privilege change served here; call it from any handler that adds another.
+func (sh *SessionHandler) RotateSession(w http.ResponseWriter, r *http.Request) error {
+	sessionCookie, err := r.Cookie("session_id")
+	if err != nil {
+		return err
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	session, err := sh.LoginUC.RotateSession(r.Context(), sessionCookie.Value, r.UserAgent(), ip)
+	if err != nil {
+		return err
+	}
+
+	if err := sh.setSessionCookies(w, session); err != nil {
+		return err
+	}
+
+	sh.Logger.WithFields(&logrus.Fields{
+		"user_id": session.UserId,
+	}).Info("session rotated")
+	return nil
+}
+
+func (sh *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	sh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("ListSessions request started")
+
+	userID, ok := r.Context().Value(userIDKey).(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized, &model.ErrorResponse{Message: "You don't have access"})
 		return
 	}
 
-	if like_id == -1 {
-		recieverID := likeTo
+	sessionCookie, err := r.Cookie("session_id")
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "No cookies got"})
+		return
+	}
 
-		notif := model.NotificationSend{
-			NotifType: "match",
-			Content:   fmt.Sprintf("You have matched with user %d!", likeFrom),
-			Read:      0,
-		}
+	sessions, err := sh.ManageSessionsUC.ListSessions(int(userID), sessionCookie.Value)
+	if err != nil {
+		sh.Logger.WithFields(&logrus.Fields{
+			"user_id": userID,
+			"error":   err.Error(),
+		}).Error("failed to list sessions")
 
-		err := ph.AddNotificationUC.AddNotification(recieverID, notif)
-		if err != nil {
-			ph.Logger.Error("Failed to save notification: ", err)
-			return
-		}
+		MakeEasyJSONResponse(w, http.StatusInternalServerError, &model.ErrorResponse{Message: "Failed to list sessions"})
+		return
+	}
 
-		recieverID = likeFrom
+	MakeEasyJSONResponse(w, http.StatusOK, &model.SessionsResponse{Sessions: sessions})
+}
 
-		notif = model.NotificationSend{
-			NotifType: "match",
-			Content:   fmt.Sprintf("You have natched with user %d!", likeTo),
-			Read:      0,
-		}
+func (sh *SessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	sh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("RevokeSession request started")
 
-		err = ph.AddNotificationUC.AddNotification(recieverID, notif)
-		if err != nil {
-			ph.Logger.Error("Failed to save notification: ", err)
-			return
-		}
+	userID, ok := r.Context().Value(userIDKey).(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized, &model.ErrorResponse{Message: "You don't have access"})
+		return
 	}
 
-	redisKey := fmt.Sprintf("cached_profiles:%d", profileId)
-
-	cachedData, err := ph.Subscriber.Get(context.Background(), redisKey).Result()
-	if err != nil {
-		if err == redis.Nil {
-			return
-		}
-		ph.Logger.WithError(err).Error("failed to get cached profiles")
+	sessionID, err := strconv.Atoi(mux.Vars(r)["session_id"])
+	if err != nil || sessionID <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid session id"})
 		return
 	}
 
-	var profiles []model.Profile
-	if err := json.Unmarshal([]byte(cachedData), &profiles); err != nil {
-		ph.Logger.WithError(err).Error("failed to unmarshal cached profiles")
+	err = sh.ManageSessionsUC.RevokeSession(int(userID), sessionID)
+	if status.Code(err) == codes.NotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound, &model.ErrorResponse{Message: "session not found"})
 		return
 	}
+	if err != nil {
+		sh.Logger.WithFields(&logrus.Fields{
+			"user_id":    userID,
+			"session_id": sessionID,
+			"error":      err.Error(),
+		}).Error("failed to revoke session")
 
-	filtered := make([]model.Profile, 0, len(profiles))
-	for _, p := range profiles {
-		if p.ProfileId != likeTo {
-			filtered = append(filtered, p)
-		}
-	}
-	if len(filtered) == 0 {
-		err = ph.Subscriber.Del(context.Background(), redisKey).Err()
-		if err != nil {
-			ph.Logger.WithError(err).Error("failed to update cached profiles in redis")
-		}
+		MakeEasyJSONResponse(w, http.StatusInternalServerError, &model.ErrorResponse{Message: "Failed to revoke session"})
 		return
 	}
 
-	newData, err := json.Marshal(filtered)
-	if err != nil {
-		ph.Logger.WithError(err).Error("failed to marshal updated profiles")
+	MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Session revoked"})
+}
+
+func (sh *SessionHandler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	sh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("RevokeOtherSessions request started")
+
+	userID, ok := r.Context().Value(userIDKey).(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized, &model.ErrorResponse{Message: "You don't have access"})
 		return
 	}
 
-	err = ph.Subscriber.Set(context.Background(), redisKey, newData, 30*time.Minute).Err()
+	sessionCookie, err := r.Cookie("session_id")
 	if err != nil {
-		ph.Logger.WithError(err).Error("failed to update cached profiles in redis")
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "No cookies got"})
+		return
 	}
 
-	ph.Logger.WithFields(&logrus.Fields{
-		"like_id":   like_id,
-		"like_from": likeFrom,
-		"like_to":   likeTo,
-		"status":    status,
-	}).Info("like successfully processed")
-
-	MakeEasyJSONResponse(w, http.StatusOK,
-		&model.ErrorResponse{Message: "Liked"},
-	)
-}
+	if err := sh.ManageSessionsUC.RevokeOtherSessions(int(userID), sessionCookie.Value); err != nil {
+		sh.Logger.WithFields(&logrus.Fields{
+			"user_id": userID,
+			"error":   err.Error(),
+		}).Error("failed to revoke other sessions")
 
-func CreateCookies(session model.Session) (*model.Cookie, error) {
-	cookie := &model.Cookie{
-		Name:     "session_id",
-		Value:    session.SessionId,
-		HttpOnly: true,
-		Secure:   false,
-		Expires:  time.Now().Add(12 * time.Hour),
-		Path:     "/",
+		MakeEasyJSONResponse(w, http.StatusInternalServerError, &model.ErrorResponse{Message: "Failed to revoke sessions"})
+		return
 	}
-	return cookie, nil
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Other sessions revoked"})
 }
 
 func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
@@ -1146,13 +2078,7 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 	}).Info("UploadPhoto request started")
 
 	photoUploaded.WithLabelValues("upload photo").Inc()
-	sanitizer := bluemonday.UGCPolicy()
 	var maxMemory int64 = model.MaxFileSize
-	allowedTypes := map[string]bool{
-		"image/jpeg": true,
-		"image/png":  true,
-		"image/webp": true,
-	}
 
 	userIDRaw := r.Context().Value(userIDKey)
 	user_id, ok := userIDRaw.(uint32)
@@ -1202,7 +2128,7 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 	ph.Logger.WithFields(&logrus.Fields{
 		"user_id":       user_id,
 		"files_count":   len(files),
-		"allowed_types": allowedTypes,
+		"allowed_types": allowedImageTypes,
 	}).Info("starting files processing")
 
 	var (
@@ -1233,17 +2159,6 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 		}
 		defer file.Close()
 
-		sanitizedType := sanitizer.Sanitize(contentType)
-		if !allowedTypes[sanitizedType] {
-			ph.Logger.WithFields(&logrus.Fields{
-				"file_name":    fileName,
-				"content_type": sanitizedType,
-			}).Warn("unsupported file type")
-
-			failedUploads = append(failedUploads, fileName+" (unsupported type)")
-			continue
-		}
-
 		buf, err := io.ReadAll(file)
 		if err != nil {
 			ph.Logger.WithFields(&logrus.Fields{
@@ -1255,7 +2170,21 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
-		filename := fmt.Sprintf("/%d_%d_%s", user_id, time.Now().UnixNano(), fileName)
+		sanitizedType, allowed := SanitizeImageType(http.DetectContentType(buf))
+		if !allowed {
+			ph.Logger.WithFields(&logrus.Fields{
+				"file_name":    fileName,
+				"content_type": contentType,
+				"sniffed_type": sanitizedType,
+			}).Warn("unsupported file type")
+
+			failedUploads = append(failedUploads, fileName+" (unsupported type)")
+			continue
+		}
+
+		// every photo is stored re-encoded as JPEG, so the name says so
+		baseName := strings.TrimSuffix(fileName, path.Ext(fileName))
+		filename := fmt.Sprintf("/%d_%d_%s.jpg", user_id, time.Now().UnixNano(), baseName)
 
 		ph.Logger.WithFields(&logrus.Fields{
 			"user_id":   user_id,
@@ -1271,7 +2200,14 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 				"error":     err.Error(),
 			}).Error("failed to upload file")
 
-			failedUploads = append(failedUploads, fileName+" (upload error)")
+			switch status.Code(err) {
+			case codes.ResourceExhausted:
+				failedUploads = append(failedUploads, fileName+" (photo limit reached)")
+			case codes.InvalidArgument:
+				failedUploads = append(failedUploads, fileName+" (invalid image)")
+			default:
+				failedUploads = append(failedUploads, fileName+" (upload error)")
+			}
 			continue
 		}
 
@@ -1386,8 +2322,10 @@ func (sh *SessionHandler) LoginUser(w http.ResponseWriter, r *http.Request) {
 	}).Debug("attempting to create session")
 
 	session, err := sh.LoginUC.CreateSession(r.Context(), usecase.LogInInput{
-		Login:    input.Login,
-		Password: input.Password,
+		Login:     input.Login,
+		Password:  input.Password,
+		UserAgent: r.UserAgent(),
+		IP:        ip,
 	})
 	// if strings.Contains(err.Error(), pgx.ErrNoRows.Error()) {
 	if err == pgx.ErrNoRows {
@@ -1418,8 +2356,13 @@ func (sh *SessionHandler) LoginUser(w http.ResponseWriter, r *http.Request) {
 		"session_id": session.SessionId,
 	}).Info("user authenticated successfully")
 
-	cookie, err := CreateCookies(session)
-	if err != nil {
+	// a session id the client arrived with must not survive the login,
+	// otherwise a planted cookie could be used to hijack the new session
+	if previous, err := r.Cookie("session_id"); err == nil && previous.Value != session.SessionId {
+		_ = sh.LogoutUC.Logout(previous.Value)
+	}
+
+	if err := sh.setSessionCookies(w, session); err != nil {
 		sh.Logger.WithFields(&logrus.Fields{
 			"user_id": session.UserId,
 			"error":   err.Error(),
@@ -1431,35 +2374,10 @@ func (sh *SessionHandler) LoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     cookie.Name,
-		Value:    cookie.Value,
-		HttpOnly: cookie.HttpOnly,
-		Secure:   cookie.Secure,
-		Expires:  cookie.Expires,
-		Path:     cookie.Path,
-		SameSite: http.SameSiteLaxMode,
-	})
-
-	token, _ := sh.LoginUC.CreateJwtToken(&repository.Session{
-		ID:     session.SessionId,
-		UserID: uint32(session.UserId),
-	}, time.Now().Add(12*time.Hour).Unix())
-
 	sh.Logger.WithFields(&logrus.Fields{
 		"user_id": session.UserId,
 	}).Debug("JWT token created")
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "csrf_token",
-		Value:    token,
-		HttpOnly: false,
-		Secure:   false,
-		Path:     "/",
-		Expires:  time.Now().Add(12 * time.Hour),
-		SameSite: http.SameSiteLaxMode,
-	})
-
 	_ = sh.LoginUC.DeleteAttempts(r.Context(), ip)
 
 	sh.Logger.WithFields(&logrus.Fields{
@@ -1930,6 +2848,18 @@ func (ph *ProfilesHandler) GetProfiles(w http.ResponseWriter, r *http.Request) {
 		"requester_id": profileId,
 	}).Debug("attempting to get profiles list")
 
+	radius := 0
+	if raw := r.URL.Query().Get("radius"); raw != "" {
+		var err error
+		radius, err = strconv.Atoi(raw)
+		if err != nil || radius < 0 || radius > model.MaxDiscoveryRadiusKm {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid radius"},
+			)
+			return
+		}
+	}
+
 	redisKey := fmt.Sprintf("cached_profiles:%d", profileId)
 
 	cached, err := ph.Subscriber.Exists(context.Background(), redisKey).Result()
@@ -1939,7 +2869,9 @@ func (ph *ProfilesHandler) GetProfiles(w http.ResponseWriter, r *http.Request) {
 		)
 		return
 	}
-	if cached > 0 {
+	// the cached page was built without a radius, so it only serves the
+	// unfiltered feed
+	if cached > 0 && radius == 0 {
 		cachedData, err := ph.Subscriber.Get(context.Background(), redisKey).Result()
 		if err == nil {
 			var cachedProfiles []model.Profile
@@ -1950,38 +2882,29 @@ func (ph *ProfilesHandler) GetProfiles(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if !IsPremium {
-		viewKey := fmt.Sprintf("profile_view_limit:%d", profileId)
-		countStr, err := ph.Subscriber.Get(context.Background(), viewKey).Result()
-		if err != nil && err != redis.Nil {
-			MakeEasyJSONResponse(w, http.StatusInternalServerError,
-				&model.ErrorResponse{Message: "Redis error"},
-			)
-			return
-		}
-
-		viewCount := 0
-		if countStr != "" {
-			viewCount, _ = strconv.Atoi(countStr)
-		}
-
-		if viewCount >= model.MaxProfileViewsWithoutSub {
+	tier, err := ph.subscriptionTier(int(profileId), IsPremium)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get subscription"},
+		)
+		return
+	}
+	if _, err := ph.QuotaUC.Consume(int(profileId), tier, model.QuotaViews); err != nil {
+		if errors.Is(err, model.ErrQuotaExceeded) {
 			MakeEasyJSONResponse(w, http.StatusAccepted,
 				&model.ErrorResponse{Message: "Go touch grass"},
 			)
 			return
 		}
-
-		pipe := ph.Subscriber.TxPipeline()
-		pipe.Incr(context.Background(), viewKey)
-		if viewCount == 0 {
-			pipe.Expire(context.Background(), viewKey, 12*time.Hour)
-		}
-		_, _ = pipe.Exec(context.Background())
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Redis error"},
+		)
+		return
 	}
 
-	profiles, err := ph.GetProfilesUC.GetProfiles(int(profileId))
+	profiles, err := ph.GetProfilesUC.GetProfiles(int(profileId), radius)
 	if err != nil {
+		ph.QuotaUC.Refund(int(profileId), model.QuotaViews)
 		ph.Logger.WithFields(&logrus.Fields{
 			"requester_id": profileId,
 			"error":        err.Error(),
@@ -1993,9 +2916,11 @@ func (ph *ProfilesHandler) GetProfiles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := json.Marshal(profiles)
-	if err == nil {
-		_ = ph.Subscriber.Set(context.Background(), redisKey, data, 30*time.Minute).Err()
+	if radius == 0 {
+		data, err := json.Marshal(profiles)
+		if err == nil {
+			_ = ph.Subscriber.Set(context.Background(), redisKey, data, 30*time.Minute).Err()
+		}
 	}
 
 	ph.Logger.WithFields(&logrus.Fields{
@@ -2042,28 +2967,148 @@ func (ph *ProfilesHandler) DeletePhoto(w http.ResponseWriter, r *http.Request) {
 		"file_url": fileURL,
 	}).Info("attempting to delete photo")
 
-	err := ph.DeleteImageUC.DeleteImage(int(user_id), fileURL)
+	err := ph.DeleteImageUC.DeleteImage(int(user_id), fileURL)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id":  user_id,
+			"file_url": fileURL,
+			"error":    err.Error(),
+		}).Error("failed to delete photo")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error deleting photo: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"user_id":  user_id,
+		"file_url": fileURL,
+	}).Info("photo deleted successfully")
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: fmt.Sprintf("Deleted photo %s for user %d", fileURL, user_id)},
+	)
+}
+
+func photoErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.ResourceExhausted:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (ph *ProfilesHandler) ReorderPhotos(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("ReorderPhotos request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized photo reorder attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid request body"})
+		return
+	}
+
+	var input model.PhotoOrderRequest
+	if err := input.UnmarshalJSON(body); err != nil || len(input.Photos) == 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid JSON"})
+		return
+	}
+
+	err = ph.ManagePhotosUC.ReorderPhotos(int(user_id), input.Photos)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to reorder photos")
+
+		MakeEasyJSONResponse(w, photoErrorStatus(err),
+			&model.ErrorResponse{Message: status.Convert(err).Message()},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"user_id": user_id,
+		"photos":  input.Photos,
+	}).Info("photos reordered successfully")
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Photos reordered"})
+}
+
+func (ph *ProfilesHandler) SetPrimaryPhoto(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("SetPrimaryPhoto request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized primary photo attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid request body"})
+		return
+	}
+
+	var input model.PrimaryPhotoRequest
+	if err := input.UnmarshalJSON(body); err != nil || input.Photo == "" {
+		MakeEasyJSONResponse(w, http.StatusBadRequest, &model.ErrorResponse{Message: "Invalid JSON"})
+		return
+	}
+
+	err = ph.ManagePhotosUC.SetPrimaryPhoto(int(user_id), input.Photo)
 	if err != nil {
 		ph.Logger.WithFields(&logrus.Fields{
-			"user_id":  user_id,
-			"file_url": fileURL,
-			"error":    err.Error(),
-		}).Error("failed to delete photo")
+			"user_id": user_id,
+			"photo":   input.Photo,
+			"error":   err.Error(),
+		}).Error("failed to set primary photo")
 
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: fmt.Sprintf("Error deleting photo: %v", err)},
+		MakeEasyJSONResponse(w, photoErrorStatus(err),
+			&model.ErrorResponse{Message: status.Convert(err).Message()},
 		)
 		return
 	}
 
 	ph.Logger.WithFields(&logrus.Fields{
-		"user_id":  user_id,
-		"file_url": fileURL,
-	}).Info("photo deleted successfully")
+		"user_id": user_id,
+		"photo":   input.Photo,
+	}).Info("primary photo set successfully")
 
-	MakeEasyJSONResponse(w, http.StatusOK,
-		&model.ErrorResponse{Message: fmt.Sprintf("Deleted photo %s for user %d", fileURL, user_id)},
-	)
+	MakeEasyJSONResponse(w, http.StatusOK, &model.ErrorResponse{Message: "Primary photo set"})
 }
 
 func (qh *QueryHandler) GetActiveQueries(w http.ResponseWriter, r *http.Request) {
@@ -2706,6 +3751,15 @@ func (sh *SubHandler) AddSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// the subscription is already stored, so a failed rotation must not
+	// report the purchase as failed and invite a second one
+	if err := sh.Sessions.RotateSession(w, r); err != nil {
+		sh.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to rotate session after subscription")
+	}
+
 	MakeEasyJSONResponse(w, http.StatusCreated,
 		&model.ErrorResponse{Message: "Subsr created"},
 	)
@@ -3040,7 +4094,11 @@ func (ch *ComplaintHandler) HandleComplaint(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	err = ch.HandleComplaintUC.HandleComplaint(input.Complaint_id, input.NewStatus)
+	err = ch.HandleComplaintUC.HandleComplaint(input.Complaint_id, int(user_id), input.NewStatus, input.Reason, input.Note)
+	if status, ok := moderationErrorStatus(err); ok {
+		MakeEasyJSONResponse(w, status, &model.ErrorResponse{Message: err.Error()})
+		return
+	}
 	if err != nil {
 		ch.Logger.WithFields(&logrus.Fields{
 			"user_id": user_id,
@@ -3251,6 +4309,65 @@ func (ph *ProfilesHandler) GetRecommendations(w http.ResponseWriter, r *http.Req
 	MakeEasyJSONResponse(w, http.StatusOK, profile)
 }
 
+// GetRecommendationsPage returns ranked candidates with their compatibility
+// score. Unlike GetRecommendations it is not limited to once a day.
+func (ph *ProfilesHandler) GetRecommendationsPage(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetRecommendationsPage request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.Warn("unauthorized profile access attempt")
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	var err error
+	offset := 0
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid offset"},
+			)
+			return
+		}
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit"},
+			)
+			return
+		}
+	}
+
+	page, err := ph.GetRecommendationsUC.GetRecommendationsPage(int(profileId), offset, limit)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get recommendations page")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get recommendations"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &page)
+}
+
 func (ph *ProfilesHandler) GetStatistics(w http.ResponseWriter, r *http.Request) {
 	ph.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
@@ -3296,3 +4413,262 @@ func (ph *ProfilesHandler) GetStatistics(w http.ResponseWriter, r *http.Request)
 
 	MakeEasyJSONResponse(w, http.StatusOK, stats)
 }
+
+func (nh *NotificationsHandler) GetNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	nh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("GetNotificationSettings request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	settings, err := nh.SettingsUC.Get(int(profileId))
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get notification settings"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &settings)
+}
+
+func (nh *NotificationsHandler) UpdateNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	nh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("UpdateNotificationSettings request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.NotificationSettings
+	if err := input.UnmarshalJSON(body); err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	settings, err := nh.SettingsUC.Update(int(profileId), input)
+	if errors.Is(err, model.ErrInvalidNotifSettings) {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: err.Error()},
+		)
+		return
+	}
+	if err != nil {
+		nh.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to update notification settings")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to update notification settings"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &settings)
+}
+
+// moderationErrorStatus maps the errors a moderator can cause to a status.
+func moderationErrorStatus(err error) (int, bool) {
+	switch {
+	case errors.Is(err, model.ErrInvalidModeration):
+		return http.StatusBadRequest, true
+	case errors.Is(err, model.ErrComplaintNotFound):
+		return http.StatusNotFound, true
+	case errors.Is(err, model.ErrComplaintTransition):
+		return http.StatusConflict, true
+	}
+	return 0, false
+}
+
+// requireAdmin answers the request itself unless userID is an admin.
+func (ch *ComplaintHandler) requireAdmin(w http.ResponseWriter, userID int) bool {
+	isAdmin, err := ch.GetAdminUC.GetAdmin(userID)
+	if err != nil {
+		ch.Logger.WithFields(&logrus.Fields{
+			"user_id": userID,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return false
+	}
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return false
+	}
+	return true
+}
+
+func (ch *ComplaintHandler) AssignComplaint(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(userIDKey).(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "failed to read request body"},
+		)
+		return
+	}
+
+	var input model.AssignComplaint
+	if err := input.UnmarshalJSON(body); err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	if !ch.requireAdmin(w, int(userID)) {
+		return
+	}
+
+	if input.AssigneeID != 0 {
+		isAdmin, err := ch.GetAdminUC.GetAdmin(input.AssigneeID)
+		if err != nil {
+			MakeEasyJSONResponse(w, http.StatusInternalServerError,
+				&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+			)
+			return
+		}
+		if !isAdmin {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Assignee is not an admin"},
+			)
+			return
+		}
+	}
+
+	err = ch.ModerationUC.Assign(input.Complaint_id, int(userID), input.AssigneeID)
+	if status, ok := moderationErrorStatus(err); ok {
+		MakeEasyJSONResponse(w, status, &model.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to assign complaint"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Assigned successful"},
+	)
+}
+
+func (ch *ComplaintHandler) AddComplaintNote(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(userIDKey).(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "failed to read request body"},
+		)
+		return
+	}
+
+	var input model.AddComplaintNote
+	if err := input.UnmarshalJSON(body); err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	if !ch.requireAdmin(w, int(userID)) {
+		return
+	}
+
+	note, err := ch.ModerationUC.AddNote(input.Complaint_id, int(userID), input.Note)
+	if status, ok := moderationErrorStatus(err); ok {
+		MakeEasyJSONResponse(w, status, &model.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to add note"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusCreated, &note)
+}
+
+func (ch *ComplaintHandler) GetComplaintHistory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(userIDKey).(uint32)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	complaintID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid complaint id"},
+		)
+		return
+	}
+
+	if !ch.requireAdmin(w, int(userID)) {
+		return
+	}
+
+	history, err := ch.ModerationUC.History(complaintID)
+	if status, ok := moderationErrorStatus(err); ok {
+		MakeEasyJSONResponse(w, status, &model.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Failed to get complaint history"},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &history)
+}
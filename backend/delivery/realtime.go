@@ -0,0 +1,791 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/mailru/easyjson"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	realtimeChannelPattern = "user:*"
+	realtimeEventsBuffer   = 64
+)
+
+const (
+	RealtimeEventMessages      = "messages"
+	RealtimeEventNotifications = "notifications"
+	RealtimeEventDirect        = "events"
+)
+
+// RealtimeEvent is a published event on its way to one connection.
+type RealtimeEvent struct {
+	Kind    string
+	ChatID  int
+	Payload string
+}
+
+type RealtimeClient struct {
+	userID int
+	connID string
+	conn   *websocket.Conn
+
+	writeMu sync.Mutex
+
+	chatsMu sync.RWMutex
+	chats   map[int]int // chat_id -> peer profile_id
+
+	events chan RealtimeEvent
+
+	overflowOnce sync.Once
+	overflow     chan struct{}
+}
+
+func NewRealtimeClient(userID int, conn *websocket.Conn) *RealtimeClient {
+	return &RealtimeClient{
+		userID:   userID,
+		connID:   uuid.New().String(),
+		conn:     conn,
+		chats:    make(map[int]int),
+		events:   make(chan RealtimeEvent, realtimeEventsBuffer),
+		overflow: make(chan struct{}),
+	}
+}
+
+// Events delivers the events the hub dispatched to the connection.
+func (c *RealtimeClient) Events() <-chan RealtimeEvent {
+	return c.events
+}
+
+// Overflowed is closed once the connection fell so far behind that the hub
+// had to drop an event for it.
+func (c *RealtimeClient) Overflowed() <-chan struct{} {
+	return c.overflow
+}
+
+// Send writes one frame. A peer that does not take it within
+// model.RealtimeWriteWait gets its connection closed, which stops the read
+// loop and with it the connection.
+func (c *RealtimeClient) Send(frame map[string]interface{}) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(model.RealtimeWriteWait))
+	if err := c.conn.WriteJSON(frame); err != nil {
+		c.conn.Close()
+	}
+}
+
+// close ends the connection with code and reason, which stops the read loop.
+// It does not take writeMu: gorilla allows WriteControl and Close alongside
+// a write, and closing is what gets a write stuck on a stalled peer out.
+func (c *RealtimeClient) close(code int, reason string) {
+	c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	c.conn.Close()
+}
+
+// Serve hands the connection's events to handle and calls heartbeat every
+// model.PresenceHeartbeat until done is closed. Heartbeats and the overflow
+// watch run apart from handle, which writes frames and can be held up by a
+// stalled peer for up to model.RealtimeWriteWait.
+func (c *RealtimeClient) Serve(done <-chan struct{}, handle func(RealtimeEvent), heartbeat func()) {
+	go func() {
+		ticker := time.NewTicker(model.PresenceHeartbeat)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				heartbeat()
+			case <-c.overflow:
+				c.close(websocket.CloseTryAgainLater, "missed events, reconnect to resync")
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		case event := <-c.events:
+			handle(event)
+		}
+	}
+}
+
+func (c *RealtimeClient) sendError(chatID int, message string) {
+	frame := map[string]interface{}{"type": "error", "error": message}
+	if chatID != 0 {
+		frame["chat_id"] = chatID
+	}
+	c.Send(frame)
+}
+
+func (c *RealtimeClient) Subscribe(chatID, peerID int) {
+	c.chatsMu.Lock()
+	defer c.chatsMu.Unlock()
+	c.chats[chatID] = peerID
+}
+
+func (c *RealtimeClient) unsubscribe(chatID int) {
+	c.chatsMu.Lock()
+	defer c.chatsMu.Unlock()
+	delete(c.chats, chatID)
+}
+
+func (c *RealtimeClient) peer(chatID int) (int, bool) {
+	c.chatsMu.RLock()
+	defer c.chatsMu.RUnlock()
+	peerID, ok := c.chats[chatID]
+	return peerID, ok
+}
+
+// RealtimeHub keeps one Redis pattern subscription for the whole process
+// and fans published events out to the sockets of the addressed user.
+type RealtimeHub struct {
+	Subscriber *redis.Client
+	Logger     *logger.LogrusLogger
+
+	mu      sync.RWMutex
+	clients map[int]map[*RealtimeClient]struct{}
+}
+
+func NewRealtimeHub(subscriber *redis.Client, logger *logger.LogrusLogger) *RealtimeHub {
+	return &RealtimeHub{
+		Subscriber: subscriber,
+		Logger:     logger,
+		clients:    make(map[int]map[*RealtimeClient]struct{}),
+	}
+}
+
+func (h *RealtimeHub) Run(ctx context.Context) {
+	pubsub := h.Subscriber.PSubscribe(ctx, realtimeChannelPattern)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			h.dispatch(msg.Channel, msg.Payload)
+		}
+	}
+}
+
+// Register returns true when c is the first local connection of its user.
+func (h *RealtimeHub) Register(c *RealtimeClient) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	first := len(h.clients[c.userID]) == 0
+	if first {
+		h.clients[c.userID] = make(map[*RealtimeClient]struct{})
+	}
+	h.clients[c.userID][c] = struct{}{}
+	return first
+}
+
+// Unregister returns true when c was the last local connection of its user.
+func (h *RealtimeHub) Unregister(c *RealtimeClient) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients[c.userID], c)
+	if len(h.clients[c.userID]) == 0 {
+		delete(h.clients, c.userID)
+		return true
+	}
+	return false
+}
+
+func parseRealtimeChannel(channel string) (RealtimeEvent, int, bool) {
+	var userID, chatID int
+	if _, err := fmt.Sscanf(channel, "user:%d chat:%d messages", &userID, &chatID); err == nil {
+		return RealtimeEvent{Kind: RealtimeEventMessages, ChatID: chatID}, userID, true
+	}
+	if _, err := fmt.Sscanf(channel, "user:%d notifications", &userID); err == nil {
+		return RealtimeEvent{Kind: RealtimeEventNotifications}, userID, true
+	}
+	if _, err := fmt.Sscanf(channel, "user:%d events", &userID); err == nil {
+		return RealtimeEvent{Kind: RealtimeEventDirect}, userID, true
+	}
+	return RealtimeEvent{}, 0, false
+}
+
+func (h *RealtimeHub) dispatch(channel, payload string) {
+	event, userID, ok := parseRealtimeChannel(channel)
+	if !ok {
+		return
+	}
+	event.Payload = payload
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.clients[userID] {
+		if event.Kind == RealtimeEventMessages {
+			if _, ok := c.peer(event.ChatID); !ok {
+				continue
+			}
+		}
+		select {
+		case c.events <- event:
+		default:
+			// ready frames such as "edited" or "unmatched" can't be read back
+			// later, so the connection is closed and the client resyncs
+			c.overflowOnce.Do(func() {
+				h.Logger.Warn("Realtime client fell behind, closing: ", userID)
+				close(c.overflow)
+			})
+		}
+	}
+}
+
+type RealtimeHandler struct {
+	Messages      *MessageHandler
+	Notifications *NotificationsHandler
+	Hub           *RealtimeHub
+
+	Logger *logger.LogrusLogger
+}
+
+func NewRealtimeHandler(
+	messages *MessageHandler,
+	notifications *NotificationsHandler,
+	hub *RealtimeHub,
+	logger *logger.LogrusLogger,
+) (*RealtimeHandler, error) {
+	return &RealtimeHandler{
+		Messages:      messages,
+		Notifications: notifications,
+		Hub:           hub,
+		Logger:        logger,
+	}, nil
+}
+
+func (rh *RealtimeHandler) HandleRealtime(w http.ResponseWriter, r *http.Request) {
+	rh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+	}).Info("start processing HandleRealtime request")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		rh.Logger.WithFields(&logrus.Fields{
+			"error": "failed to get userID from context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		rh.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to establish WebSocket connection")
+		return
+	}
+	defer conn.Close()
+
+	// presence tracks every connection in Redis, not the local hub entries,
+	// since the user may also be connected to another instance
+	client := NewRealtimeClient(int(profileId), conn)
+	rh.Hub.Register(client)
+	rh.Messages.UpdatePresenceUC.SetOnline(client.userID, client.connID)
+	defer func() {
+		rh.Hub.Unregister(client)
+		rh.Messages.UpdatePresenceUC.SetOffline(client.userID, client.connID)
+	}()
+
+	notifications, err := rh.Notifications.GetNotificationsUC.GetNotifications(client.userID)
+	if err != nil {
+		rh.Logger.Error("Failed to load initial notifications: ", err)
+		client.sendError(0, "Failed to load initial notifications")
+		return
+	}
+	client.Send(map[string]interface{}{"type": "init_notifications", "notifications": notifications})
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go client.Serve(done,
+		func(event RealtimeEvent) { rh.handleEvent(client, event) },
+		func() { rh.Messages.UpdatePresenceUC.Heartbeat(client.userID, client.connID) },
+	)
+
+	for {
+		_, msgData, err := conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				rh.Logger.Error("Error reading message from WebSocket: ", err)
+			}
+			return
+		}
+
+		var wsMessage model.WSMessage
+		if err := easyjson.Unmarshal(msgData, &wsMessage); err != nil {
+			rh.Logger.Error("Failed to unmarshal WSMessage: ", err)
+			client.sendError(0, "Invalid message format")
+			continue
+		}
+
+		switch wsMessage.Type {
+		case "subscribe":
+			rh.subscribe(client, wsMessage.Payload)
+		case "unsubscribe":
+			rh.unsubscribe(client, wsMessage.Payload)
+		case "create":
+			rh.createMessage(client, wsMessage.Payload)
+		case "delete":
+			rh.deleteMessage(client, wsMessage.Payload)
+		case "edit":
+			rh.editMessage(client, wsMessage.Payload)
+		case "get":
+			rh.getMessages(client, wsMessage.Payload)
+		case "read":
+			rh.readMessages(client, wsMessage.Payload)
+		case "history":
+			rh.history(client, wsMessage.Payload)
+		case "typing":
+			rh.typing(client, wsMessage.Payload)
+		case "sendFlowers":
+			rh.sendFlowers(client, wsMessage.Payload)
+		case "deleteNotification":
+			rh.deleteNotification(client, wsMessage.Payload)
+		case "readNotifications":
+			rh.readNotifications(client, wsMessage.Payload)
+		default:
+			rh.Logger.Warn("Unknown action: ", wsMessage.Type)
+			client.sendError(0, "Unknown action type")
+		}
+	}
+}
+
+func (rh *RealtimeHandler) handleEvent(client *RealtimeClient, event RealtimeEvent) {
+	if event.Kind == RealtimeEventDirect {
+		var frame map[string]interface{}
+		if err := json.Unmarshal([]byte(event.Payload), &frame); err != nil {
+			rh.Logger.Error("Failed to unmarshal realtime event: ", err)
+			return
+		}
+		// a closed chat takes no more actions from this connection
+		if frame["type"] == "unmatched" || frame["type"] == "chat_closed" {
+			if chatID, ok := frame["chat_id"].(float64); ok {
+				client.unsubscribe(int(chatID))
+			}
+		}
+		client.Send(frame)
+		return
+	}
+
+	if event.Kind == RealtimeEventNotifications {
+		// a folded notification comes as a ready "notification_updated" frame
+		var frame map[string]interface{}
+		if err := json.Unmarshal([]byte(event.Payload), &frame); err == nil {
+			client.Send(frame)
+			return
+		}
+		newNotifications, err := rh.Notifications.GetCurrentNotificationsUC.GetCurrentNotifications(client.userID)
+		if err != nil {
+			rh.Logger.Error("Failed to get notifications from cache: ", err)
+			client.sendError(0, "Failed to get notifications")
+			return
+		}
+		if len(newNotifications) > 0 {
+			client.Send(map[string]interface{}{"type": "new_notifications", "notifications": newNotifications})
+		}
+		return
+	}
+
+	// chat events other than "new" carry a ready frame, e.g. "edited" or "typing"
+	var frame map[string]interface{}
+	if err := json.Unmarshal([]byte(event.Payload), &frame); err == nil {
+		client.Send(frame)
+		return
+	}
+
+	newMessages, err := rh.Messages.GetMessagesFromCacheUC.GetMessages(event.ChatID, client.userID)
+	if err != nil {
+		rh.Logger.Error("Failed to get messages from cache: ", err)
+		client.sendError(event.ChatID, "Failed to get messages")
+		return
+	}
+	if len(newMessages) > 0 {
+		client.Send(map[string]interface{}{"type": "new_messages", "chat_id": event.ChatID, "messages": newMessages})
+	}
+}
+
+// subscribedChat decodes the chat_id of a chat action and checks that the
+// client has subscribed to that chat (membership is verified on subscribe).
+func (rh *RealtimeHandler) subscribedChat(client *RealtimeClient, raw json.RawMessage) (int, int, bool) {
+	var payload model.ChatSubscriptionPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		client.sendError(0, "Invalid payload")
+		return 0, 0, false
+	}
+	peerID, ok := client.peer(payload.ChatID)
+	if !ok {
+		client.sendError(payload.ChatID, "Not subscribed to chat")
+		return 0, 0, false
+	}
+	return payload.ChatID, peerID, true
+}
+
+// rejectBlocked sends message and reports true when the client and peerID
+// have blocked each other. A failed check rejects the action too.
+func (rh *RealtimeHandler) rejectBlocked(client *RealtimeClient, chatID, peerID int, message string) bool {
+	blocked, err := rh.Messages.BlocksUC.IsBlocked(client.userID, peerID)
+	if err != nil {
+		rh.Logger.Error("Failed to check blocks: ", err)
+		client.sendError(chatID, "Failed to check blocks")
+		return true
+	}
+	if blocked {
+		client.sendError(chatID, message)
+	}
+	return blocked
+}
+
+// rejectClosed sends "Chat is closed" and reports true when the chat was
+// closed by an unmatch or a block.
+func (rh *RealtimeHandler) rejectClosed(client *RealtimeClient, chatID int) bool {
+	closed, err := rh.Messages.GetParticipantsUC.IsChatClosed(chatID)
+	if err != nil {
+		rh.Logger.Error("Failed to check chat: ", err)
+		client.sendError(chatID, "Failed to check chat")
+		return true
+	}
+	if closed {
+		client.sendError(chatID, "Chat is closed")
+	}
+	return closed
+}
+
+func (rh *RealtimeHandler) subscribe(client *RealtimeClient, raw json.RawMessage) {
+	var payload model.ChatSubscriptionPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		client.sendError(0, "Invalid subscribe payload")
+		return
+	}
+
+	first, second, err := rh.Messages.GetParticipantsUC.GetChatParticipants(payload.ChatID)
+	if err != nil {
+		rh.Logger.Error("Failed to get chat participants: ", err)
+		client.sendError(payload.ChatID, "Failed to get chat participants")
+		return
+	}
+	if client.userID != first && client.userID != second {
+		client.sendError(payload.ChatID, "You don't have access")
+		return
+	}
+
+	peerID := first
+	if client.userID == first {
+		peerID = second
+	}
+	if rh.rejectBlocked(client, payload.ChatID, peerID, "Chat is closed") || rh.rejectClosed(client, payload.ChatID) {
+		return
+	}
+	client.Subscribe(payload.ChatID, peerID)
+
+	page, fresh, err := rh.Messages.GetMessagesPageUC.GetFirstPage(payload.ChatID, client.userID)
+	if err != nil {
+		rh.Logger.Error("Failed to load initial messages: ", err)
+		client.sendError(payload.ChatID, "Failed to load initial messages")
+		return
+	}
+
+	client.Send(map[string]interface{}{
+		"type":        "init_messages",
+		"chat_id":     payload.ChatID,
+		"messages":    page.Messages,
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
+	})
+	if len(fresh) > 0 {
+		client.Send(map[string]interface{}{"type": "new_messages", "chat_id": payload.ChatID, "messages": fresh})
+	}
+}
+
+func (rh *RealtimeHandler) unsubscribe(client *RealtimeClient, raw json.RawMessage) {
+	var payload model.ChatSubscriptionPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		client.sendError(0, "Invalid unsubscribe payload")
+		return
+	}
+	client.unsubscribe(payload.ChatID)
+	client.Send(map[string]interface{}{"type": "unsubscribed", "chat_id": payload.ChatID})
+}
+
+func (rh *RealtimeHandler) createMessage(client *RealtimeClient, raw json.RawMessage) {
+	messageSent.WithLabelValues().Inc()
+	chatID, peerID, ok := rh.subscribedChat(client, raw)
+	if !ok {
+		return
+	}
+	var payload model.CreatePayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		rh.Logger.Error("Failed to unmarshal CreatePayload: ", err)
+		client.sendError(chatID, "Invalid create payload")
+		return
+	}
+	if rh.rejectBlocked(client, chatID, peerID, "Chat is closed") || rh.rejectClosed(client, chatID) {
+		return
+	}
+
+	notif := model.NotificationSend{
+		NotifType: model.NotificationMessage,
+		Content:   fmt.Sprintf("User %d sent you a message!", client.userID),
+		Read:      0,
+		Payload: &model.NotificationPayload{
+			Kind:    model.NotificationMessage,
+			ActorID: client.userID,
+			ChatID:  chatID,
+			Link:    fmt.Sprintf(model.ChatLinkFormat, chatID),
+		},
+	}
+
+	go func() {
+		messageID, err := rh.Messages.CreateMessagesUC.CreateMessages(chatID, client.userID, payload.Content, payload.AttachmentIDs)
+		if err != nil {
+			rh.Logger.Error("Failed to create message: ", err)
+			if errors.Is(err, model.ErrChatClosed) {
+				client.sendError(chatID, "Chat is closed")
+				return
+			}
+			client.sendError(chatID, "Failed to create message")
+			return
+		}
+		client.Send(map[string]interface{}{"type": "created", "chat_id": chatID, "message_id": messageID})
+
+		// the peer is only notified of a message that was stored
+		if err := rh.Messages.AddNotificationUC.AddNotification(peerID, notif); err != nil {
+			rh.Logger.Error("Failed to save notification: ", err)
+			client.sendError(chatID, "Failed to notify")
+		}
+	}()
+}
+
+func (rh *RealtimeHandler) deleteMessage(client *RealtimeClient, raw json.RawMessage) {
+	messageSent.WithLabelValues().Inc()
+	chatID, _, ok := rh.subscribedChat(client, raw)
+	if !ok {
+		return
+	}
+	var payload model.DeletePayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		rh.Logger.Error("Failed to unmarshal DeletePayload: ", err)
+		client.sendError(chatID, "Invalid delete payload")
+		return
+	}
+
+	go func() {
+		err := rh.Messages.DeleteMessageUC.DeleteMessage(payload.MessageID, chatID)
+		if err != nil {
+			rh.Logger.Error("Failed to delete message: ", err)
+			client.sendError(chatID, "Failed to delete message")
+			return
+		}
+		client.Send(map[string]interface{}{"type": "deleted", "chat_id": chatID, "message_id": payload.MessageID})
+	}()
+}
+
+func (rh *RealtimeHandler) editMessage(client *RealtimeClient, raw json.RawMessage) {
+	messageSent.WithLabelValues().Inc()
+	chatID, peerID, ok := rh.subscribedChat(client, raw)
+	if !ok {
+		return
+	}
+	var payload model.EditPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		rh.Logger.Error("Failed to unmarshal EditPayload: ", err)
+		client.sendError(chatID, "Invalid edit payload")
+		return
+	}
+	if rh.rejectBlocked(client, chatID, peerID, "Chat is closed") {
+		return
+	}
+
+	go func() {
+		message, err := rh.Messages.EditMessageUC.EditMessage(payload.MessageID, chatID, client.userID, payload.Content)
+		if err != nil {
+			rh.Logger.Error("Failed to edit message: ", err)
+			if errors.Is(err, model.ErrChatClosed) {
+				client.sendError(chatID, "Chat is closed")
+				return
+			}
+			client.sendError(chatID, "Failed to edit message")
+			return
+		}
+		client.Send(map[string]interface{}{"type": "edited", "chat_id": chatID, "message": message})
+	}()
+}
+
+func (rh *RealtimeHandler) getMessages(client *RealtimeClient, raw json.RawMessage) {
+	messageReceived.WithLabelValues().Inc()
+	chatID, _, ok := rh.subscribedChat(client, raw)
+	if !ok {
+		return
+	}
+
+	newMessages, err := rh.Messages.GetMessagesFromCacheUC.GetMessages(chatID, client.userID)
+	if err != nil {
+		rh.Logger.Error("Failed to get messages from cache: ", err)
+		client.sendError(chatID, "Failed to get messages")
+		return
+	}
+	client.Send(map[string]interface{}{"type": "new_messages", "chat_id": chatID, "messages": newMessages})
+}
+
+func (rh *RealtimeHandler) readMessages(client *RealtimeClient, raw json.RawMessage) {
+	messageReceived.WithLabelValues().Inc()
+	chatID, _, ok := rh.subscribedChat(client, raw)
+	if !ok {
+		return
+	}
+
+	go func() {
+		err := rh.Messages.UpdateMessageStatusUC.UpdateMessageStatus(chatID, client.userID)
+		if err != nil {
+			rh.Logger.Error("Failed to update message status: ", err)
+			client.sendError(chatID, "Failed to update message status")
+			return
+		}
+		client.Send(map[string]interface{}{"type": "status_updated", "chat_id": chatID})
+	}()
+}
+
+func (rh *RealtimeHandler) history(client *RealtimeClient, raw json.RawMessage) {
+	messageReceived.WithLabelValues().Inc()
+	chatID, _, ok := rh.subscribedChat(client, raw)
+	if !ok {
+		return
+	}
+	var payload model.HistoryPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		rh.Logger.Error("Failed to unmarshal HistoryPayload: ", err)
+		client.sendError(chatID, "Invalid history payload")
+		return
+	}
+
+	page, err := rh.Messages.GetMessagesPageUC.GetMessagesPage(chatID, payload.BeforeMessageID, payload.Limit)
+	if err != nil {
+		rh.Logger.Error("Failed to get messages history: ", err)
+		client.sendError(chatID, "Failed to get messages history")
+		return
+	}
+	client.Send(map[string]interface{}{
+		"type":        "history",
+		"chat_id":     chatID,
+		"messages":    page.Messages,
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
+	})
+}
+
+func (rh *RealtimeHandler) typing(client *RealtimeClient, raw json.RawMessage) {
+	chatID, peerID, ok := rh.subscribedChat(client, raw)
+	if !ok {
+		return
+	}
+	if rh.rejectBlocked(client, chatID, peerID, "Chat is closed") || rh.rejectClosed(client, chatID) {
+		return
+	}
+
+	if _, err := rh.Messages.SetTypingUC.SetTyping(chatID, client.userID, peerID); err != nil {
+		rh.Logger.Error("Failed to send typing event: ", err)
+		client.sendError(chatID, "Failed to send typing event")
+	}
+}
+
+func (rh *RealtimeHandler) sendFlowers(client *RealtimeClient, raw json.RawMessage) {
+	messageReceived.WithLabelValues().Inc()
+	var payload model.FlowersPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		rh.Logger.Error("Failed to unmarshal payload: ", err)
+		client.sendError(0, "Invalid payload")
+		return
+	}
+	if rh.rejectBlocked(client, 0, payload.UserID, "You cannot send flowers to this user") {
+		return
+	}
+
+	go func() {
+		notif := model.NotificationSend{
+			NotifType: model.NotificationFlowers,
+			Content:   fmt.Sprintf("User %d sent you flowers!", client.userID),
+			Read:      0,
+			Payload: &model.NotificationPayload{
+				Kind:    model.NotificationFlowers,
+				ActorID: client.userID,
+				Link:    fmt.Sprintf(model.ProfileLinkFormat, client.userID),
+			},
+		}
+		if err := rh.Messages.AddNotificationUC.AddNotification(payload.UserID, notif); err != nil {
+			rh.Logger.Error("Failed to publish flowers notification: ", err)
+			client.sendError(0, "Failed to notify")
+			return
+		}
+		client.Send(map[string]interface{}{"type": "SentFlowersTo", "user": payload.UserID})
+	}()
+}
+
+func (rh *RealtimeHandler) deleteNotification(client *RealtimeClient, raw json.RawMessage) {
+	messageReceived.WithLabelValues().Inc()
+	var payload model.DeleteNotifPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		rh.Logger.Error("Failed to unmarshal DeleteNotifPayload: ", err)
+		client.sendError(0, "Invalid delete payload")
+		return
+	}
+
+	go func() {
+		err := rh.Notifications.DeleteNotificationUC.DeleteNotifications(payload.NotifID, client.userID)
+		if err != nil {
+			rh.Logger.Error("Failed to delete notification: ", err)
+			client.sendError(0, "Failed to delete notification")
+			return
+		}
+		client.Send(map[string]interface{}{"type": "notification_deleted", "notif_id": payload.NotifID})
+	}()
+}
+
+func (rh *RealtimeHandler) readNotifications(client *RealtimeClient, raw json.RawMessage) {
+	messageReceived.WithLabelValues().Inc()
+	var payload model.ReadNotifPayload
+	if err := easyjson.Unmarshal(raw, &payload); err != nil {
+		rh.Logger.Error("Failed to unmarshal ReadNotifPayload: ", err)
+		client.sendError(0, "Invalid read payload")
+		return
+	}
+
+	go func() {
+		err := rh.Notifications.UpdateNotificationStatusUC.UpdateNotificatons(client.userID, payload.NotifType)
+		if err != nil {
+			rh.Logger.Error("Failed to update notification status: ", err)
+			client.sendError(0, "Failed to update notification status")
+			return
+		}
+		client.Send(map[string]interface{}{"type": "notifications_read", "notif_type": payload.NotifType})
+	}()
+}
@@ -8,8 +8,22 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	"github.com/mailru/easyjson"
 	"github.com/mailru/easyjson/jwriter"
+	"github.com/microcosm-cc/bluemonday"
 )
 
+var allowedImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// SanitizeImageType cleans a client supplied Content-Type and reports
+// whether it is one of the image types we accept for uploads.
+func SanitizeImageType(contentType string) (string, bool) {
+	sanitizedType := bluemonday.UGCPolicy().Sanitize(contentType)
+	return sanitizedType, allowedImageTypes[sanitizedType]
+}
+
 func MakeEasyJSONResponse(w http.ResponseWriter, statusCode int, v easyjson.Marshaler) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -149,6 +149,49 @@ func (mr *MockSessionRepositoryMockRecorder) IncreaseAttempts(userIP interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseAttempts", reflect.TypeOf((*MockSessionRepository)(nil).IncreaseAttempts), userIP)
 }
 
+// ListSessions mocks base method.
+func (m *MockSessionRepository) ListSessions(userId int, currentSessionId string) ([]auth_config.SessionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", userId, currentSessionId)
+	ret0, _ := ret[0].([]auth_config.SessionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionRepositoryMockRecorder) ListSessions(userId, currentSessionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionRepository)(nil).ListSessions), userId, currentSessionId)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockSessionRepository) RevokeOtherSessions(userId int, currentSessionId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", userId, currentSessionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockSessionRepositoryMockRecorder) RevokeOtherSessions(userId, currentSessionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockSessionRepository)(nil).RevokeOtherSessions), userId, currentSessionId)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionRepository) RevokeSession(userId, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionRepositoryMockRecorder) RevokeSession(userId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionRepository)(nil).RevokeSession), userId, id)
+}
+
 // StoreSession mocks base method.
 func (m *MockSessionRepository) StoreSession(sessionId, data string, ttl time.Duration) error {
 	m.ctrl.T.Helper()
@@ -162,3 +205,17 @@ func (mr *MockSessionRepositoryMockRecorder) StoreSession(sessionId, data, ttl i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreSession", reflect.TypeOf((*MockSessionRepository)(nil).StoreSession), sessionId, data, ttl)
 }
+
+// StoreUserSession mocks base method.
+func (m *MockSessionRepository) StoreUserSession(session auth_config.Session, meta auth_config.SessionMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreUserSession", session, meta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreUserSession indicates an expected call of StoreUserSession.
+func (mr *MockSessionRepositoryMockRecorder) StoreUserSession(session, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreUserSession", reflect.TypeOf((*MockSessionRepository)(nil).StoreUserSession), session, meta)
+}
@@ -19,7 +19,182 @@ const Megabyte int = 1 << 23
 const MaxQuerySizeStr int = 5
 const MaxQuerySizePhoto int = 15 * 6
 
-var MaxProfileViewsWithoutSub = 5
+const DefaultMessagesPageSize int = 50
+const MaxMessagesPageSize int = 100
+
+const PresenceTTL = 60 * time.Second
+const PresenceHeartbeat = 30 * time.Second
+
+// RealtimeWriteWait bounds a write to a realtime connection; a peer that stops
+// reading is dropped after it.
+var RealtimeWriteWait = 10 * time.Second
+
+const LastSeenTTL = 30 * 24 * time.Hour
+const TypingInterval = 2 * time.Second
+
+// DefaultSessionDuration is used for cookies when the auth service does not
+// report a session lifetime.
+const DefaultSessionDuration = 72 * time.Hour
+
+// Notification kinds; each one is a notification_types description.
+const (
+	NotificationMessage     = "message"
+	NotificationMatch       = "match"
+	NotificationFlowers     = "flowers"
+	NotificationSavedSearch = "saved_search"
+)
+
+var NotificationKinds = []string{NotificationMessage, NotificationMatch, NotificationFlowers, NotificationSavedSearch}
+
+const MaxMutedChats = 500
+
+const QuietHoursLayout = "15:04"
+
+const MatchNotificationFormat = "You have matched with user %d!"
+
+// Deep links carried by notification payloads.
+const (
+	ChatLinkFormat        = "/chats/%d"
+	ProfileLinkFormat     = "/profiles/%d"
+	SavedSearchLinkFormat = "/profiles/searches/%d"
+)
+
+// MaxDiscoveryRadiusKm caps the "within N km" filter of feed and search.
+const MaxDiscoveryRadiusKm = 1000
+
+// Sort modes of SearchProfileRequest.SortBy; the empty mode keeps the
+// default order.
+const (
+	SortByDistance  = "distance"
+	SortByRelevance = "relevance"
+	SortByYoungest  = "age"
+	SortByOldest    = "age_desc"
+	SortByNewest    = "newest"
+)
+
+var SearchSortModes = []string{"", SortByDistance, SortByRelevance, SortByYoungest, SortByOldest, SortByNewest}
+
+const MaxSearchPageSize = 50
+
+const (
+	MaxSavedSearches          = 10
+	MaxSavedSearchNameLength  = 100
+	SavedSearchAlertFormat    = "%d new profiles match your saved search \"%s\""
+	SavedSearchAlertInterval  = 10 * time.Minute
+	SavedSearchAlertBatchSize = 100
+)
+
+// Outbound channels; each one is a notification_outbox channel.
+const (
+	OutboundEmail   = "email"
+	OutboundWebhook = "webhook"
+)
+
+var OutboundChannels = []string{OutboundEmail, OutboundWebhook}
+
+// Unread notifications of DigestKinds older than DigestDelay are summed up
+// in one digest per user.
+var DigestKinds = []string{NotificationMatch, NotificationMessage}
+
+const (
+	DigestDelay            = 30 * time.Minute
+	DigestInterval         = 5 * time.Minute
+	DigestSubjectFormat    = "You have %d unread notifications"
+	DigestMatchesFormat    = "New matches: %d"
+	DigestMessagesFormat   = "New messages: %d"
+	OutboxDispatchInterval = 30 * time.Second
+	OutboxBatchSize        = 100
+	OutboxMaxAttempts      = 6
+	OutboxBaseBackoff      = time.Minute
+	OutboxMaxBackoff       = 2 * time.Hour
+	// OutboxLease keeps a claimed message from other backends while it is
+	// sent; it must outlast OutboundSendTimeout.
+	OutboxLease         = 5 * time.Minute
+	OutboundSendTimeout = 30 * time.Second
+)
+
+// Complaint statuses. A pending case is resolved into one of the others and
+// only a rejected or closed one can be reopened; an approved case blacklists
+// the reported user.
+const (
+	ComplaintRejected = -1
+	ComplaintPending  = 1
+	ComplaintApproved = 2
+	ComplaintClosed   = 3
+)
+
+var ComplaintStatuses = []int{ComplaintRejected, ComplaintPending, ComplaintApproved, ComplaintClosed}
+
+var ComplaintTransitions = map[int][]int{
+	ComplaintPending:  {ComplaintApproved, ComplaintRejected, ComplaintClosed},
+	ComplaintRejected: {ComplaintPending},
+	ComplaintClosed:   {ComplaintPending},
+}
+
+// Actions of complaint_audit_log.
+const (
+	ComplaintCreated       = "created"
+	ComplaintStatusChanged = "status_changed"
+	ComplaintAssigned      = "assigned"
+	ComplaintUnassigned    = "unassigned"
+	ComplaintNoteAdded     = "note_added"
+)
+
+// ModerationReasons are the reason codes a case is resolved with.
+var ModerationReasons = []string{
+	"spam",
+	"harassment",
+	"fake_profile",
+	"inappropriate_content",
+	"scam",
+	"underage",
+	"no_violation",
+	"insufficient_evidence",
+	"duplicate",
+	"other",
+}
+
+const MaxComplaintNoteLength = 2000
+
+// RewindWindow bounds how old a swipe may be to still be rewound.
+const RewindWindow = 10 * time.Minute
+
+const (
+	QuotaLikes      = "likes"
+	QuotaSuperlikes = "superlikes"
+	QuotaRewinds    = "rewinds"
+	// QuotaViews counts feed pages fetched from the profiles service.
+	QuotaViews = "views"
+
+	// Unlimited as a daily limit switches the quota off.
+	Unlimited = -1
+
+	// FreeTier is the tier of users without a subscription; tiers 1-4 are
+	// the subscription_types.
+	FreeTier = 0
+)
+
+var QuotaKinds = []string{QuotaLikes, QuotaSuperlikes, QuotaRewinds, QuotaViews}
+
+// DailyQuotas are the per-day limits of every tier. They reset at midnight
+// UTC. Subscribers of an unknown type get the limits of tier 1.
+var DailyQuotas = map[int]map[string]int{
+	FreeTier: {QuotaLikes: 50, QuotaSuperlikes: 0, QuotaRewinds: 0, QuotaViews: 5},
+	1:        {QuotaLikes: 100, QuotaSuperlikes: 1, QuotaRewinds: 3, QuotaViews: 30},
+	2:        {QuotaLikes: 200, QuotaSuperlikes: 3, QuotaRewinds: 5, QuotaViews: 60},
+	3:        {QuotaLikes: 500, QuotaSuperlikes: 5, QuotaRewinds: 10, QuotaViews: 150},
+	4:        {QuotaLikes: Unlimited, QuotaSuperlikes: 10, QuotaRewinds: 20, QuotaViews: Unlimited},
+}
+
+const AttachmentPlaceholder = "[photo]"
+const AttachmentURLFormat = "/messages/attachments/%d"
+
+// Uploads not sent in a message within UnboundAttachmentTTL are deleted by a
+// sweep every AttachmentSweepInterval.
+const (
+	UnboundAttachmentTTL    = 24 * time.Hour
+	AttachmentSweepInterval = time.Hour
+)
 
 var Key string = "Hello"
 
@@ -37,35 +212,61 @@ var (
 
 // errors
 var (
-	ErrInvalidLogin          = errors.New("invalid login")
-	ErrInvalidLoginSize      = errors.New("invalid login size")
-	ErrInvalidPassword       = errors.New("invalid password")
-	ErrInvalidPasswordSize   = errors.New("invalid password size")
-	ErrSessionNotFound       = errors.New("session not found")
-	ErrInvalidSession        = errors.New("invalid session")
-	ErrInvalidUserRepoConfig = errors.New("invalid user repository config")
-	ErrGetSession            = errors.New("failed to get session")
-	ErrStoreSession          = errors.New("failed to store session")
-	ErrInvalidSessionId      = errors.New("invalid session id")
-	ErrDeleteSession         = errors.New("failed to delete session")
-	ErrProfileNotFound       = errors.New("profile not found")
-	ErrDeleteUser            = errors.New("failed to delete user")
-	ErrDeleteProfile         = errors.New("failed to delete profile")
-	ErrUserCheckSessionUC    = errors.New("failed user check session")
-	ErrDeleteStaticUC        = errors.New("failed to delete static")
-	ErrUserDeleteUC          = errors.New("failed to delete user")
-	ErrGetProfileMatchesUC   = errors.New("failed to get profile matches")
-	ErrGetProfileUC          = errors.New("failed to get profile")
-	ErrGetUserPhotoUC        = errors.New("failed to get user photo")
-	ErrGetProfilesForUserUC  = errors.New("failed to get profiles for user")
-	ErrProfileSetLikeUC      = errors.New("failed to set like")
-	ErrUserLogInUC           = errors.New("failed to log in user")
-	ErrUserLogOutUC          = errors.New("failed to log out user")
-	ErrUserSignUpUC          = errors.New("failed to sign up user")
-	ErrProfileUpdateUC       = errors.New("failed to update profile")
-	ErrStaticUploadUC        = errors.New("failed to upload static")
-	ErrGetActiveQueriesUC    = errors.New("failed to get active queries")
-	ErrUserGetParamsUC       = errors.New("failed to get user params")
+	ErrInvalidLogin           = errors.New("invalid login")
+	ErrInvalidLoginSize       = errors.New("invalid login size")
+	ErrInvalidPassword        = errors.New("invalid password")
+	ErrInvalidPasswordSize    = errors.New("invalid password size")
+	ErrSessionNotFound        = errors.New("session not found")
+	ErrInvalidSession         = errors.New("invalid session")
+	ErrInvalidUserRepoConfig  = errors.New("invalid user repository config")
+	ErrGetSession             = errors.New("failed to get session")
+	ErrStoreSession           = errors.New("failed to store session")
+	ErrInvalidSessionId       = errors.New("invalid session id")
+	ErrDeleteSession          = errors.New("failed to delete session")
+	ErrProfileNotFound        = errors.New("profile not found")
+	ErrDeleteUser             = errors.New("failed to delete user")
+	ErrDeleteProfile          = errors.New("failed to delete profile")
+	ErrUserCheckSessionUC     = errors.New("failed user check session")
+	ErrDeleteStaticUC         = errors.New("failed to delete static")
+	ErrUserDeleteUC           = errors.New("failed to delete user")
+	ErrGetProfileMatchesUC    = errors.New("failed to get profile matches")
+	ErrGetProfileUC           = errors.New("failed to get profile")
+	ErrGetUserPhotoUC         = errors.New("failed to get user photo")
+	ErrGetProfilesForUserUC   = errors.New("failed to get profiles for user")
+	ErrProfileSetLikeUC       = errors.New("failed to set like")
+	ErrUserLogInUC            = errors.New("failed to log in user")
+	ErrUserLogOutUC           = errors.New("failed to log out user")
+	ErrManageSessionsUC       = errors.New("failed to manage sessions")
+	ErrUserSignUpUC           = errors.New("failed to sign up user")
+	ErrProfileUpdateUC        = errors.New("failed to update profile")
+	ErrStaticUploadUC         = errors.New("failed to upload static")
+	ErrManagePhotosUC         = errors.New("failed to manage photos")
+	ErrRewindLikeUC           = errors.New("failed to rewind like")
+	ErrGetIncomingLikesUC     = errors.New("failed to get incoming likes")
+	ErrQuotaUC                = errors.New("failed to manage quotas")
+	ErrQuotaExceeded          = errors.New("daily quota exceeded")
+	ErrGetActiveQueriesUC     = errors.New("failed to get active queries")
+	ErrUserGetParamsUC        = errors.New("failed to get user params")
+	ErrMessageNotFound        = errors.New("message not found")
+	ErrMessageNotOwned        = errors.New("message belongs to another user")
+	ErrEmptyMessage           = errors.New("message content is empty")
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentForbidden    = errors.New("attachment belongs to another chat")
+	ErrChatClosed             = errors.New("chat is closed")
+	ErrUnmatchUC              = errors.New("failed to unmatch")
+	ErrBlocksUC               = errors.New("failed to manage blocks")
+	ErrBlocked                = errors.New("one of the users has blocked the other")
+	ErrSavedSearchesUC        = errors.New("failed to manage saved searches")
+	ErrSavedSearchNotFound    = errors.New("saved search not found")
+	ErrTooManySavedSearches   = errors.New("too many saved searches")
+	ErrNotificationSettingsUC = errors.New("failed to manage notification settings")
+	ErrInvalidNotifSettings   = errors.New("invalid notification settings")
+	ErrOutboundUC             = errors.New("failed to deliver outbound notifications")
+	ErrSenderNotConfigured    = errors.New("outbound sender is not configured")
+	ErrModerationUC           = errors.New("failed to moderate complaints")
+	ErrComplaintNotFound      = errors.New("complaint not found")
+	ErrComplaintTransition    = errors.New("complaint status cannot change this way")
+	ErrInvalidModeration      = errors.New("invalid moderation request")
 )
 
 //easyjson:json
@@ -91,6 +292,8 @@ type Profile struct {
 	Parameters  []Preference `yaml:"parameters" json:"parameters"`
 	Photos      []string     `yaml:"photos" json:"photos"`
 	Premium     Premium      `yaml:"Premium" json:"Premium"`
+	// Distance is the approximate distance to the viewer in km, 0 if unknown.
+	Distance int `yaml:"distance" json:"distance,omitempty"`
 }
 
 //easyjson:json
@@ -179,23 +382,57 @@ type AnswersForQuery struct {
 
 //easyjson:json
 type Chat struct {
-	ProfileId          int    `yaml:"profileId" json:"profileId"`
-	ChatId             int    `yaml:"chatId" json:"chatId"`
-	ProfileName        string `yaml:"profileName" json:"profileName"`
-	ProfilePicture     string `yaml:"profilePicture" json:"profilePicture"`
-	ProfileDescription string `yaml:"profileDescription" json:"profileDescription"`
-	LastMessage        string `yaml:"lastMessage" json:"lastMessage"`
-	IsRead             bool   `yaml:"isRead" json:"isRead"`
-	IsSelf             bool   `yaml:"isSelf" json:"isSelf"`
+	ProfileId          int       `yaml:"profileId" json:"profileId"`
+	ChatId             int       `yaml:"chatId" json:"chatId"`
+	ProfileName        string    `yaml:"profileName" json:"profileName"`
+	ProfilePicture     string    `yaml:"profilePicture" json:"profilePicture"`
+	ProfileDescription string    `yaml:"profileDescription" json:"profileDescription"`
+	LastMessage        string    `yaml:"lastMessage" json:"lastMessage"`
+	IsRead             bool      `yaml:"isRead" json:"isRead"`
+	IsSelf             bool      `yaml:"isSelf" json:"isSelf"`
+	IsOnline           bool      `yaml:"isOnline" json:"isOnline"`
+	LastSeen           time.Time `yaml:"lastSeen" json:"lastSeen"`
+}
+
+//easyjson:json
+type Presence struct {
+	ProfileId int       `yaml:"profileId" json:"profileId"`
+	IsOnline  bool      `yaml:"isOnline" json:"isOnline"`
+	LastSeen  time.Time `yaml:"lastSeen" json:"lastSeen"`
+}
+
+//easyjson:json
+type PresenceResponse struct {
+	Presence []Presence `json:"presence"`
 }
 
 //easyjson:json
 type Message struct {
-	MessageID int       `yaml:"messageid" json:"messageid"`
-	SenderID  int       `yaml:"senderid" json:"senderid"`
-	Text      string    `yaml:"text" json:"text"`
-	Status    int       `yaml:"status" json:"status"`
-	CreatedAt time.Time `yaml:"createdAt" json:"createdAt"`
+	MessageID   int          `yaml:"messageid" json:"messageid"`
+	SenderID    int          `yaml:"senderid" json:"senderid"`
+	Text        string       `yaml:"text" json:"text"`
+	Status      int          `yaml:"status" json:"status"`
+	CreatedAt   time.Time    `yaml:"createdAt" json:"createdAt"`
+	Edited      bool         `yaml:"edited" json:"edited"`
+	Attachments []Attachment `yaml:"attachments" json:"attachments"`
+}
+
+//easyjson:json
+type Attachment struct {
+	AttachmentID int    `yaml:"attachmentId" json:"attachmentId"`
+	ContentType  string `yaml:"contentType" json:"contentType"`
+	URL          string `yaml:"url" json:"url"`
+}
+
+type AttachmentFile struct {
+	ChatID      int
+	Path        string
+	ContentType string
+}
+
+//easyjson:json
+type AttachmentsResponse struct {
+	Attachments []Attachment `json:"attachments"`
 }
 
 //easyjson:json
@@ -206,9 +443,10 @@ type WSMessage struct {
 
 //easyjson:json
 type CreatePayload struct {
-	ChatID  int    `json:"chat_id"`
-	UserID  int    `json:"user_id"`
-	Content string `json:"content"`
+	ChatID        int    `json:"chat_id"`
+	UserID        int    `json:"user_id"`
+	Content       string `json:"content"`
+	AttachmentIDs []int  `json:"attachment_ids"`
 }
 
 //easyjson:json
@@ -217,11 +455,37 @@ type DeletePayload struct {
 	MessageID int `json:"message_id"`
 }
 
+//easyjson:json
+type EditPayload struct {
+	ChatID    int    `json:"chat_id"`
+	MessageID int    `json:"message_id"`
+	Content   string `json:"content"`
+}
+
 //easyjson:json
 type ReadPayload struct {
 	ChatID int `json:"chat_id"`
 }
 
+//easyjson:json
+type HistoryPayload struct {
+	ChatID          int `json:"chat_id"`
+	BeforeMessageID int `json:"before_message_id"`
+	Limit           int `json:"limit"`
+}
+
+//easyjson:json
+type MessagesPage struct {
+	Messages   []Message `json:"messages"`
+	NextCursor int       `json:"next_cursor"`
+	HasMore    bool      `json:"has_more"`
+}
+
+//easyjson:json
+type ChatSubscriptionPayload struct {
+	ChatID int `json:"chat_id"`
+}
+
 //easyjson:json
 type ChatNotificationsPayload struct {
 	ChatID int `json:"chat_id"`
@@ -280,6 +544,20 @@ type SearchProfileRequest struct {
 	Preferences []Preference `yaml:"preferences" json:"preferences"`
 	Country     string       `json:"country"`
 	City        string       `json:"city"`
+	Mutual      bool         `json:"mutual"`
+	// MaxDistanceKm of 0 means any distance; SortBy "distance" puts the
+	// nearest profiles first.
+	MaxDistanceKm int    `json:"maxDistanceKm"`
+	SortBy        string `json:"sortBy"`
+	// InterestsAll must all be present, at least one of InterestsAny.
+	InterestsAll []string `json:"interestsAll"`
+	InterestsAny []string `json:"interestsAny"`
+	// Cursor is the nextCursor of the previous page; Limit of 0 means the
+	// default page size.
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+	// CreatedAfter is set by the saved search alerts, never by clients.
+	CreatedAfter time.Time `json:"-"`
 }
 
 //easyjson:json
@@ -289,6 +567,7 @@ type FoundProfile struct {
 	Fullname string `json:"fullname"`
 	Age      int    `json:"age"`
 	Goal     int    `yaml:"goal" json:"goal"`
+	Distance int    `json:"distance,omitempty"`
 }
 
 //easyjson:json
@@ -302,7 +581,43 @@ type NotificationSend struct {
 	NotificationID int    `yaml:"notificationID" json:"notificationID"`
 	Read           int    `yaml:"read" json:"read"`
 	NotifType      string `yaml:"type" json:"type"`
-	Content        string `yaml:"content" json:"content"`
+	// Content is the English fallback for clients that ignore Payload.
+	Content string               `yaml:"content" json:"content"`
+	Payload *NotificationPayload `yaml:"payload" json:"payload,omitempty"`
+	// Count is how many unread events of one chat or actor were folded into
+	// this entry; UpdatedAt is the latest of them.
+	Count     int       `yaml:"count" json:"count"`
+	UpdatedAt time.Time `yaml:"updatedAt" json:"updatedAt"`
+}
+
+// NotificationPayload describes the event behind a notification. ActorID is
+// the profile that caused it; for a match it is also the matched profile.
+//
+//easyjson:json
+type NotificationPayload struct {
+	Kind     string `yaml:"kind" json:"kind"`
+	ActorID  int    `yaml:"actorId" json:"actorId,omitempty"`
+	ChatID   int    `yaml:"chatId" json:"chatId,omitempty"`
+	SearchID int    `yaml:"searchId" json:"searchId,omitempty"`
+	Count    int    `yaml:"count" json:"count,omitempty"`
+	Link     string `yaml:"link" json:"link,omitempty"`
+}
+
+//easyjson:json
+type NotificationSettings struct {
+	MutedTypes []string    `json:"mutedTypes"`
+	MutedChats []int       `json:"mutedChats"`
+	QuietHours *QuietHours `json:"quietHours,omitempty"`
+}
+
+// QuietHours are "HH:MM" times in Timezone; a Start after End spans midnight.
+// Notifications in this window are stored but not pushed.
+//
+//easyjson:json
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"`
 }
 
 //easyjson:json
@@ -346,8 +661,55 @@ type SignUpRequest struct {
 
 //easyjson:json
 type HandleComplaint struct {
+	Complaint_id int    `json:"complaint_id"`
+	NewStatus    int    `json:"new_status"`
+	Reason       string `json:"reason"`
+	Note         string `json:"note"`
+}
+
+//easyjson:json
+type AssignComplaint struct {
 	Complaint_id int `json:"complaint_id"`
-	NewStatus    int `json:"new_status"`
+	// AssigneeID is an admin's user id; 0 drops the assignment.
+	AssigneeID int `json:"assignee_id"`
+}
+
+//easyjson:json
+type AddComplaintNote struct {
+	Complaint_id int    `json:"complaint_id"`
+	Note         string `json:"note"`
+}
+
+//easyjson:json
+type ComplaintNote struct {
+	NoteID    int       `json:"note_id"`
+	AuthorID  *int      `json:"author_id"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+//easyjson:json
+type ComplaintAuditEntry struct {
+	AuditID    int       `json:"audit_id"`
+	ActorID    int       `json:"actor_id"`
+	Action     string    `json:"action"`
+	FromStatus *int      `json:"from_status,omitempty"`
+	ToStatus   *int      `json:"to_status,omitempty"`
+	AssigneeID *int      `json:"assignee_id,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	Note       string    `json:"note,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+//easyjson:json
+type ComplaintHistory struct {
+	ComplaintID      int                   `json:"complaint_id"`
+	Status           int                   `json:"status"`
+	AssignedTo       *int                  `json:"assigned_to"`
+	ResolvedBy       *int                  `json:"resolved_by"`
+	ResolutionReason string                `json:"resolution_reason,omitempty"`
+	Notes            []ComplaintNote       `json:"notes"`
+	Events           []ComplaintAuditEntry `json:"events"`
 }
 
 //easyjson:json
@@ -440,12 +802,182 @@ type LoginResponse struct {
 	UserID  int    `json:"user_id"`
 }
 
+//easyjson:json
+type SessionInfo struct {
+	ID           int       `json:"id"`
+	Device       string    `json:"device"`
+	UserAgent    string    `json:"user_agent"`
+	IP           string    `json:"ip"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastActiveAt time.Time `json:"last_active_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	Current      bool      `json:"current"`
+}
+
+//easyjson:json
+type SessionsResponse struct {
+	Sessions []SessionInfo `json:"sessions"`
+}
+
 //easyjson:json
 type UploadResponse struct {
 	Message          string   `json:"message"`
 	SucessfulUploads []string `json:"sucessful_uploads"`
 }
 
+//easyjson:json
+type IncomingLike struct {
+	Profile   Profile   `json:"profile"`
+	Status    int       `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// IncomingLikesResponse lists pending likes. For free users Blurred is set
+// and only Total is filled in.
+//
+//easyjson:json
+type IncomingLikesResponse struct {
+	Likes      []IncomingLike `json:"likes"`
+	Total      int            `json:"total"`
+	NextCursor int            `json:"nextCursor"`
+	HasMore    bool           `json:"hasMore"`
+	Blurred    bool           `json:"blurred"`
+}
+
+// Recommendation is a ranked candidate. Score is in 0..1 and Reasons name
+// the strongest matching signals, best first.
+//
+//easyjson:json
+type Recommendation struct {
+	Profile Profile  `json:"profile"`
+	Score   float64  `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+//easyjson:json
+type RecommendationsPageResponse struct {
+	Items      []Recommendation `json:"items"`
+	NextOffset int              `json:"nextOffset"`
+	HasMore    bool             `json:"hasMore"`
+}
+
+//easyjson:json
+type CoordinatesRequest struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// QuotaStatus is the state of one daily quota. Limit and Remaining are
+// Unlimited when the tier has no cap.
+//
+//easyjson:json
+type QuotaStatus struct {
+	Kind      string `json:"kind"`
+	Limit     int    `json:"limit"`
+	Used      int    `json:"used"`
+	Remaining int    `json:"remaining"`
+}
+
+//easyjson:json
+type QuotaResponse struct {
+	Tier    int           `json:"tier"`
+	ResetAt time.Time     `json:"resetAt"`
+	Quotas  []QuotaStatus `json:"quotas"`
+}
+
+//easyjson:json
+type LikeBackResponse struct {
+	Message string `json:"message"`
+	Matched bool   `json:"matched"`
+}
+
+//easyjson:json
+type RewindResponse struct {
+	Profile      Profile `json:"profile"`
+	Status       int     `json:"status"`
+	MatchRemoved bool    `json:"matchRemoved"`
+	RewindsLeft  int     `json:"rewindsLeft"`
+}
+
+//easyjson:json
+type UnmatchResponse struct {
+	ProfileId int `json:"profileId"`
+	ChatId    int `json:"chatId"`
+}
+
+//easyjson:json
+type BlockedProfile struct {
+	ProfileId int       `json:"profileId"`
+	Name      string    `json:"name"`
+	Avatar    string    `json:"avatar"`
+	BlockedAt time.Time `json:"blockedAt"`
+}
+
+//easyjson:json
+type BlockedProfilesResponse struct {
+	Blocked []BlockedProfile `json:"blocked"`
+}
+
+//easyjson:json
+type SavedSearch struct {
+	ID        int                  `json:"id"`
+	Name      string               `json:"name"`
+	Params    SearchProfileRequest `json:"params"`
+	CreatedAt time.Time            `json:"createdAt"`
+}
+
+//easyjson:json
+type SaveSearchRequest struct {
+	Name   string               `json:"name"`
+	Params SearchProfileRequest `json:"params"`
+}
+
+//easyjson:json
+type SavedSearchesResponse struct {
+	Searches []SavedSearch `json:"searches"`
+}
+
+// SavedSearchAlert is a saved search claimed by the alerts worker; Since is
+// the previous check.
+type SavedSearchAlert struct {
+	SearchID  int
+	ProfileID int
+	Name      string
+	Params    SearchProfileRequest
+	Since     time.Time
+}
+
+// OutboundMessage is a notification_outbox row; webhooks receive it as JSON.
+//
+//easyjson:json
+type OutboundMessage struct {
+	ID        int    `json:"id"`
+	UserID    int    `json:"userId"`
+	Channel   string `json:"channel"`
+	Recipient string `json:"recipient,omitempty"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+	Attempts  int    `json:"attempts"`
+}
+
+// Digest counts the unread notifications of one user claimed for a digest.
+type Digest struct {
+	UserID   int
+	Email    string
+	Matches  int
+	Messages int
+}
+
+//easyjson:json
+type PhotoOrderRequest struct {
+	Photos []string `json:"photos"`
+}
+
+//easyjson:json
+type PrimaryPhotoRequest struct {
+	Photo string `json:"photo"`
+}
+
 //easyjson:json
 type ProfileResponse struct {
 	Profiles []Profile `json:"profiles"`
@@ -453,7 +985,9 @@ type ProfileResponse struct {
 
 //easyjson:json
 type FoundProfileResponse struct {
-	Profiles []FoundProfile `json:"profiles"`
+	Profiles   []FoundProfile `json:"profiles"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+	Total      int            `json:"total"`
 }
 
 //easyjson:json
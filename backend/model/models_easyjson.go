@@ -487,7 +487,7 @@ func (v *UploadResponse) UnmarshalJSON(data []byte) error {
 func (v *UploadResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Lexer, out *TimeConstraints) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Lexer, out *UnmatchResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -506,14 +506,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "time_from":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.TimeFrom).UnmarshalJSON(data))
-			}
-		case "time_to":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.TimeTo).UnmarshalJSON(data))
-			}
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "chatId":
+			out.ChatId = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -524,47 +520,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(out *jwriter.Writer, in TimeConstraints) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(out *jwriter.Writer, in UnmatchResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"time_from\":"
+		const prefix string = ",\"profileId\":"
 		out.RawString(prefix[1:])
-		out.Raw((in.TimeFrom).MarshalJSON())
+		out.Int(int(in.ProfileId))
 	}
 	{
-		const prefix string = ",\"time_to\":"
+		const prefix string = ",\"chatId\":"
 		out.RawString(prefix)
-		out.Raw((in.TimeTo).MarshalJSON())
+		out.Int(int(in.ChatId))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TimeConstraints) MarshalJSON() ([]byte, error) {
+func (v UnmatchResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UnmatchResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TimeConstraints) UnmarshalJSON(data []byte) error {
+func (v *UnmatchResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UnmatchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(in *jlexer.Lexer, out *SignUpRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(in *jlexer.Lexer, out *TimeConstraints) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -583,10 +579,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "user":
-			(out.User).UnmarshalEasyJSON(in)
-		case "profile":
-			(out.Profile).UnmarshalEasyJSON(in)
+		case "time_from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TimeFrom).UnmarshalJSON(data))
+			}
+		case "time_to":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TimeTo).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -597,47 +597,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(out *jwriter.Writer, in SignUpRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(out *jwriter.Writer, in TimeConstraints) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"time_from\":"
 		out.RawString(prefix[1:])
-		(in.User).MarshalEasyJSON(out)
+		out.Raw((in.TimeFrom).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"profile\":"
+		const prefix string = ",\"time_to\":"
 		out.RawString(prefix)
-		(in.Profile).MarshalEasyJSON(out)
+		out.Raw((in.TimeTo).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SignUpRequest) MarshalJSON() ([]byte, error) {
+func (v TimeConstraints) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SignUpRequest) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SignUpRequest) UnmarshalJSON(data []byte) error {
+func (v *TimeConstraints) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SignUpRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Lexer, out *SetLike) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Lexer, out *SignUpRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -656,12 +656,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "likeFrom":
-			out.LikeFrom = int(in.Int())
-		case "likeTo":
-			out.LikeTo = int(in.Int())
-		case "status":
-			out.Status = int(in.Int())
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -672,52 +670,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(out *jwriter.Writer, in SetLike) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(out *jwriter.Writer, in SignUpRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"likeFrom\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.LikeFrom))
-	}
-	{
-		const prefix string = ",\"likeTo\":"
-		out.RawString(prefix)
-		out.Int(int(in.LikeTo))
+		(in.User).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"profile\":"
 		out.RawString(prefix)
-		out.Int(int(in.Status))
+		(in.Profile).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetLike) MarshalJSON() ([]byte, error) {
+func (v SignUpRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetLike) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SignUpRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetLike) UnmarshalJSON(data []byte) error {
+func (v *SignUpRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SignUpRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Lexer, out *SessionCheckSuccessResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Lexer, out *SetLike) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -736,12 +729,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
-		case "inSession":
-			out.InSession = bool(in.Bool())
-		case "id":
-			out.UserId = int(in.Int())
+		case "likeFrom":
+			out.LikeFrom = int(in.Int())
+		case "likeTo":
+			out.LikeTo = int(in.Int())
+		case "status":
+			out.Status = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -752,52 +745,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(out *jwriter.Writer, in SessionCheckSuccessResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(out *jwriter.Writer, in SetLike) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"likeFrom\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.Int(int(in.LikeFrom))
 	}
 	{
-		const prefix string = ",\"inSession\":"
+		const prefix string = ",\"likeTo\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.InSession))
+		out.Int(int(in.LikeTo))
 	}
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Int(int(in.UserId))
+		out.Int(int(in.Status))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SessionCheckSuccessResponse) MarshalJSON() ([]byte, error) {
+func (v SetLike) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SessionCheckSuccessResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SetLike) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SessionCheckSuccessResponse) UnmarshalJSON(data []byte) error {
+func (v *SetLike) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SessionCheckSuccessResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SetLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Lexer, out *SessionCheckResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Lexer, out *SessionsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -816,10 +809,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
-		case "inSession":
-			out.InSession = bool(in.Bool())
+		case "sessions":
+			if in.IsNull() {
+				in.Skip()
+				out.Sessions = nil
+			} else {
+				in.Delim('[')
+				if out.Sessions == nil {
+					if !in.IsDelim(']') {
+						out.Sessions = make([]SessionInfo, 0, 0)
+					} else {
+						out.Sessions = []SessionInfo{}
+					}
+				} else {
+					out.Sessions = (out.Sessions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 SessionInfo
+					(v4).UnmarshalEasyJSON(in)
+					out.Sessions = append(out.Sessions, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -830,47 +842,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(out *jwriter.Writer, in SessionCheckResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(out *jwriter.Writer, in SessionsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"sessions\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
-	}
-	{
-		const prefix string = ",\"inSession\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.InSession))
+		if in.Sessions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Sessions {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SessionCheckResponse) MarshalJSON() ([]byte, error) {
+func (v SessionsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SessionCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SessionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SessionCheckResponse) UnmarshalJSON(data []byte) error {
+func (v *SessionsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SessionCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SessionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.Lexer, out *Session) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.Lexer, out *SessionInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -889,12 +907,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "sessionId":
-			out.SessionId = string(in.String())
-		case "userId":
-			out.UserId = int(in.Int())
-		case "expires":
-			out.Expires = time.Duration(in.Int64())
+		case "id":
+			out.ID = int(in.Int())
+		case "device":
+			out.Device = string(in.String())
+		case "user_agent":
+			out.UserAgent = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "last_active_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastActiveAt).UnmarshalJSON(data))
+			}
+		case "expires_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "current":
+			out.Current = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -905,52 +939,77 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(out *jwriter.Writer, in Session) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(out *jwriter.Writer, in SessionInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"sessionId\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.SessionId))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"userId\":"
+		const prefix string = ",\"device\":"
 		out.RawString(prefix)
-		out.Int(int(in.UserId))
+		out.String(string(in.Device))
 	}
 	{
-		const prefix string = ",\"expires\":"
+		const prefix string = ",\"user_agent\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Expires))
+		out.String(string(in.UserAgent))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"last_active_at\":"
+		out.RawString(prefix)
+		out.Raw((in.LastActiveAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"current\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Current))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Session) MarshalJSON() ([]byte, error) {
+func (v SessionInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Session) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SessionInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Session) UnmarshalJSON(data []byte) error {
+func (v *SessionInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Session) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SessionInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.Lexer, out *SearchProfileRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.Lexer, out *SessionCheckSuccessResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -969,47 +1028,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "input":
-			out.Input = string(in.String())
-		case "isMale":
-			out.IsMale = string(in.String())
-		case "ageMin":
-			out.AgeMin = int(in.Int())
-		case "ageMax":
-			out.AgeMax = int(in.Int())
-		case "heightMin":
-			out.HeightMin = int(in.Int())
-		case "heightMax":
-			out.HeightMax = int(in.Int())
-		case "goal":
-			out.Goal = int(in.Int())
-		case "preferences":
-			if in.IsNull() {
-				in.Skip()
-				out.Preferences = nil
-			} else {
-				in.Delim('[')
-				if out.Preferences == nil {
-					if !in.IsDelim(']') {
-						out.Preferences = make([]Preference, 0, 2)
-					} else {
-						out.Preferences = []Preference{}
-					}
-				} else {
-					out.Preferences = (out.Preferences)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 Preference
-					(v4).UnmarshalEasyJSON(in)
-					out.Preferences = append(out.Preferences, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "country":
-			out.Country = string(in.String())
-		case "city":
-			out.City = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		case "inSession":
+			out.InSession = bool(in.Bool())
+		case "id":
+			out.UserId = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1020,98 +1044,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(out *jwriter.Writer, in SearchProfileRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(out *jwriter.Writer, in SessionCheckSuccessResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"input\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Input))
+		out.String(string(in.Message))
 	}
 	{
-		const prefix string = ",\"isMale\":"
+		const prefix string = ",\"inSession\":"
 		out.RawString(prefix)
-		out.String(string(in.IsMale))
+		out.Bool(bool(in.InSession))
 	}
 	{
-		const prefix string = ",\"ageMin\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		out.Int(int(in.AgeMin))
-	}
-	{
-		const prefix string = ",\"ageMax\":"
-		out.RawString(prefix)
-		out.Int(int(in.AgeMax))
-	}
-	{
-		const prefix string = ",\"heightMin\":"
-		out.RawString(prefix)
-		out.Int(int(in.HeightMin))
-	}
-	{
-		const prefix string = ",\"heightMax\":"
-		out.RawString(prefix)
-		out.Int(int(in.HeightMax))
-	}
-	{
-		const prefix string = ",\"goal\":"
-		out.RawString(prefix)
-		out.Int(int(in.Goal))
-	}
-	{
-		const prefix string = ",\"preferences\":"
-		out.RawString(prefix)
-		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.Preferences {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				(v6).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"country\":"
-		out.RawString(prefix)
-		out.String(string(in.Country))
-	}
-	{
-		const prefix string = ",\"city\":"
-		out.RawString(prefix)
-		out.String(string(in.City))
+		out.Int(int(in.UserId))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SearchProfileRequest) MarshalJSON() ([]byte, error) {
+func (v SessionCheckSuccessResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SessionCheckSuccessResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchProfileRequest) UnmarshalJSON(data []byte) error {
+func (v *SessionCheckSuccessResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SessionCheckSuccessResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.Lexer, out *ReadPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.Lexer, out *SessionCheckResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1130,8 +1108,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
-			out.ChatID = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "inSession":
+			out.InSession = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1142,42 +1122,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(out *jwriter.Writer, in ReadPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(out *jwriter.Writer, in SessionCheckResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatID))
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"inSession\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.InSession))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReadPayload) MarshalJSON() ([]byte, error) {
+func (v SessionCheckResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReadPayload) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SessionCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReadPayload) UnmarshalJSON(data []byte) error {
+func (v *SessionCheckResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReadPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SessionCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.Lexer, out *ReadNotifPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.Lexer, out *Session) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1196,8 +1181,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "notif_type":
-			out.NotifType = string(in.String())
+		case "sessionId":
+			out.SessionId = string(in.String())
+		case "userId":
+			out.UserId = int(in.Int())
+		case "expires":
+			out.Expires = time.Duration(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1208,42 +1197,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(out *jwriter.Writer, in ReadNotifPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(out *jwriter.Writer, in Session) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notif_type\":"
+		const prefix string = ",\"sessionId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.NotifType))
+		out.String(string(in.SessionId))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserId))
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Expires))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReadNotifPayload) MarshalJSON() ([]byte, error) {
+func (v Session) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReadNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Session) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReadNotifPayload) UnmarshalJSON(data []byte) error {
+func (v *Session) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReadNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Session) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.Lexer, out *RawTimeConstraints) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.Lexer, out *SearchProfileRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1262,26 +1261,103 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "time_from":
+		case "input":
+			out.Input = string(in.String())
+		case "isMale":
+			out.IsMale = string(in.String())
+		case "ageMin":
+			out.AgeMin = int(in.Int())
+		case "ageMax":
+			out.AgeMax = int(in.Int())
+		case "heightMin":
+			out.HeightMin = int(in.Int())
+		case "heightMax":
+			out.HeightMax = int(in.Int())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "preferences":
 			if in.IsNull() {
 				in.Skip()
-				out.TimeFrom = nil
+				out.Preferences = nil
 			} else {
-				if out.TimeFrom == nil {
-					out.TimeFrom = new(string)
+				in.Delim('[')
+				if out.Preferences == nil {
+					if !in.IsDelim(']') {
+						out.Preferences = make([]Preference, 0, 2)
+					} else {
+						out.Preferences = []Preference{}
+					}
+				} else {
+					out.Preferences = (out.Preferences)[:0]
 				}
-				*out.TimeFrom = string(in.String())
+				for !in.IsDelim(']') {
+					var v7 Preference
+					(v7).UnmarshalEasyJSON(in)
+					out.Preferences = append(out.Preferences, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "time_to":
+		case "country":
+			out.Country = string(in.String())
+		case "city":
+			out.City = string(in.String())
+		case "mutual":
+			out.Mutual = bool(in.Bool())
+		case "maxDistanceKm":
+			out.MaxDistanceKm = int(in.Int())
+		case "sortBy":
+			out.SortBy = string(in.String())
+		case "interestsAll":
 			if in.IsNull() {
 				in.Skip()
-				out.TimeTo = nil
+				out.InterestsAll = nil
 			} else {
-				if out.TimeTo == nil {
-					out.TimeTo = new(string)
+				in.Delim('[')
+				if out.InterestsAll == nil {
+					if !in.IsDelim(']') {
+						out.InterestsAll = make([]string, 0, 4)
+					} else {
+						out.InterestsAll = []string{}
+					}
+				} else {
+					out.InterestsAll = (out.InterestsAll)[:0]
 				}
-				*out.TimeTo = string(in.String())
+				for !in.IsDelim(']') {
+					var v8 string
+					v8 = string(in.String())
+					out.InterestsAll = append(out.InterestsAll, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "interestsAny":
+			if in.IsNull() {
+				in.Skip()
+				out.InterestsAny = nil
+			} else {
+				in.Delim('[')
+				if out.InterestsAny == nil {
+					if !in.IsDelim(']') {
+						out.InterestsAny = make([]string, 0, 4)
+					} else {
+						out.InterestsAny = []string{}
+					}
+				} else {
+					out.InterestsAny = (out.InterestsAny)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 string
+					v9 = string(in.String())
+					out.InterestsAny = append(out.InterestsAny, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "limit":
+			out.Limit = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1292,55 +1368,155 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(out *jwriter.Writer, in RawTimeConstraints) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(out *jwriter.Writer, in SearchProfileRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"time_from\":"
+		const prefix string = ",\"input\":"
 		out.RawString(prefix[1:])
-		if in.TimeFrom == nil {
+		out.String(string(in.Input))
+	}
+	{
+		const prefix string = ",\"isMale\":"
+		out.RawString(prefix)
+		out.String(string(in.IsMale))
+	}
+	{
+		const prefix string = ",\"ageMin\":"
+		out.RawString(prefix)
+		out.Int(int(in.AgeMin))
+	}
+	{
+		const prefix string = ",\"ageMax\":"
+		out.RawString(prefix)
+		out.Int(int(in.AgeMax))
+	}
+	{
+		const prefix string = ",\"heightMin\":"
+		out.RawString(prefix)
+		out.Int(int(in.HeightMin))
+	}
+	{
+		const prefix string = ",\"heightMax\":"
+		out.RawString(prefix)
+		out.Int(int(in.HeightMax))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix)
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.TimeFrom))
+			out.RawByte('[')
+			for v10, v11 := range in.Preferences {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				(v11).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"time_to\":"
+		const prefix string = ",\"country\":"
 		out.RawString(prefix)
-		if in.TimeTo == nil {
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"mutual\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mutual))
+	}
+	{
+		const prefix string = ",\"maxDistanceKm\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxDistanceKm))
+	}
+	{
+		const prefix string = ",\"sortBy\":"
+		out.RawString(prefix)
+		out.String(string(in.SortBy))
+	}
+	{
+		const prefix string = ",\"interestsAll\":"
+		out.RawString(prefix)
+		if in.InterestsAll == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.TimeTo))
+			out.RawByte('[')
+			for v12, v13 := range in.InterestsAll {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v13))
+			}
+			out.RawByte(']')
 		}
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v RawTimeConstraints) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RawTimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
+	{
+		const prefix string = ",\"interestsAny\":"
+		out.RawString(prefix)
+		if in.InterestsAny == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.InterestsAny {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchProfileRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RawTimeConstraints) UnmarshalJSON(data []byte) error {
+func (v *SearchProfileRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RawTimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SearchProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.Lexer, out *QueryStats) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.Lexer, out *SavedSearchesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1359,14 +1535,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "TotalAnswers":
-			out.TotalAnswers = int(in.Int())
-		case "AverageScore":
-			out.AverageScore = float64(in.Float64())
-		case "MinScore":
-			out.MinScore = int(in.Int())
-		case "MaxScore":
-			out.MaxScore = int(in.Int())
+		case "searches":
+			if in.IsNull() {
+				in.Skip()
+				out.Searches = nil
+			} else {
+				in.Delim('[')
+				if out.Searches == nil {
+					if !in.IsDelim(']') {
+						out.Searches = make([]SavedSearch, 0, 0)
+					} else {
+						out.Searches = []SavedSearch{}
+					}
+				} else {
+					out.Searches = (out.Searches)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 SavedSearch
+					(v16).UnmarshalEasyJSON(in)
+					out.Searches = append(out.Searches, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1377,57 +1568,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(out *jwriter.Writer, in QueryStats) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(out *jwriter.Writer, in SavedSearchesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"TotalAnswers\":"
+		const prefix string = ",\"searches\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalAnswers))
-	}
-	{
-		const prefix string = ",\"AverageScore\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.AverageScore))
-	}
-	{
-		const prefix string = ",\"MinScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MinScore))
-	}
-	{
-		const prefix string = ",\"MaxScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MaxScore))
+		if in.Searches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Searches {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryStats) MarshalJSON() ([]byte, error) {
+func (v SavedSearchesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueryStats) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SavedSearchesResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryStats) UnmarshalJSON(data []byte) error {
+func (v *SavedSearchesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueryStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SavedSearchesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.Lexer, out *QueryResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.Lexer, out *SavedSearchAlert) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1446,28 +1633,17 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "queryis":
-			if in.IsNull() {
-				in.Skip()
-				out.Queries = nil
-			} else {
-				in.Delim('[')
-				if out.Queries == nil {
-					if !in.IsDelim(']') {
-						out.Queries = make([]QueryForUser, 0, 0)
-					} else {
-						out.Queries = []QueryForUser{}
-					}
-				} else {
-					out.Queries = (out.Queries)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 QueryForUser
-					(v7).UnmarshalEasyJSON(in)
-					out.Queries = append(out.Queries, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "SearchID":
+			out.SearchID = int(in.Int())
+		case "ProfileID":
+			out.ProfileID = int(in.Int())
+		case "Name":
+			out.Name = string(in.String())
+		case "Params":
+			(out.Params).UnmarshalEasyJSON(in)
+		case "Since":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Since).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -1479,53 +1655,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(out *jwriter.Writer, in QueryResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(out *jwriter.Writer, in SavedSearchAlert) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"queryis\":"
+		const prefix string = ",\"SearchID\":"
 		out.RawString(prefix[1:])
-		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Queries {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.SearchID))
+	}
+	{
+		const prefix string = ",\"ProfileID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ProfileID))
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Params\":"
+		out.RawString(prefix)
+		(in.Params).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Since\":"
+		out.RawString(prefix)
+		out.Raw((in.Since).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryResponse) MarshalJSON() ([]byte, error) {
+func (v SavedSearchAlert) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SavedSearchAlert) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryResponse) UnmarshalJSON(data []byte) error {
+func (v *SavedSearchAlert) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SavedSearchAlert) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.Lexer, out *QueryForUser) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.Lexer, out *SavedSearch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1544,18 +1729,16 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.L
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = int(in.Int())
 		case "name":
 			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "minScore":
-			out.MinScore = int(in.Int())
-		case "maxScore":
-			out.MaxScore = int(in.Int())
-		case "score":
-			out.Score = int(in.Int())
-		case "answer":
-			out.Answer = string(in.String())
+		case "params":
+			(out.Params).UnmarshalEasyJSON(in)
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1566,67 +1749,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(out *jwriter.Writer, in QueryForUser) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(out *jwriter.Writer, in SavedSearch) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"minScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MinScore))
+		out.Int(int(in.ID))
 	}
 	{
-		const prefix string = ",\"maxScore\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxScore))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"score\":"
+		const prefix string = ",\"params\":"
 		out.RawString(prefix)
-		out.Int(int(in.Score))
+		(in.Params).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"answer\":"
+		const prefix string = ",\"createdAt\":"
 		out.RawString(prefix)
-		out.String(string(in.Answer))
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryForUser) MarshalJSON() ([]byte, error) {
+func (v SavedSearch) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueryForUser) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SavedSearch) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryForUser) UnmarshalJSON(data []byte) error {
+func (v *SavedSearch) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueryForUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SavedSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.Lexer, out *Query) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.Lexer, out *SaveSearchRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1647,12 +1820,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.L
 		switch key {
 		case "name":
 			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "minScore":
-			out.MinScore = int(in.Int())
-		case "maxScore":
-			out.MaxScore = int(in.Int())
+		case "params":
+			(out.Params).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1663,7 +1832,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(out *jwriter.Writer, in Query) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(out *jwriter.Writer, in SaveSearchRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1673,47 +1842,37 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(out *jwriter
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"minScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MinScore))
-	}
-	{
-		const prefix string = ",\"maxScore\":"
+		const prefix string = ",\"params\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxScore))
+		(in.Params).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Query) MarshalJSON() ([]byte, error) {
+func (v SaveSearchRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Query) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SaveSearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Query) UnmarshalJSON(data []byte) error {
+func (v *SaveSearchRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Query) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SaveSearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.Lexer, out *QuerResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.Lexer, out *RewindResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1732,29 +1891,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "queryis":
-			if in.IsNull() {
-				in.Skip()
-				out.Queries = nil
-			} else {
-				in.Delim('[')
-				if out.Queries == nil {
-					if !in.IsDelim(']') {
-						out.Queries = make([]Query, 0, 1)
-					} else {
-						out.Queries = []Query{}
-					}
-				} else {
-					out.Queries = (out.Queries)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 Query
-					(v10).UnmarshalEasyJSON(in)
-					out.Queries = append(out.Queries, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
+		case "status":
+			out.Status = int(in.Int())
+		case "matchRemoved":
+			out.MatchRemoved = bool(in.Bool())
+		case "rewindsLeft":
+			out.RewindsLeft = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1765,53 +1909,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(out *jwriter.Writer, in QuerResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(out *jwriter.Writer, in RewindResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"queryis\":"
+		const prefix string = ",\"profile\":"
 		out.RawString(prefix[1:])
-		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.Queries {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				(v12).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		(in.Profile).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"matchRemoved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MatchRemoved))
+	}
+	{
+		const prefix string = ",\"rewindsLeft\":"
+		out.RawString(prefix)
+		out.Int(int(in.RewindsLeft))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QuerResponse) MarshalJSON() ([]byte, error) {
+func (v RewindResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QuerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v RewindResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QuerResponse) UnmarshalJSON(data []byte) error {
+func (v *RewindResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QuerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *RewindResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.Lexer, out *ProfileStats) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.Lexer, out *RecommendationsPageResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1830,20 +1978,33 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "likesGiven":
-			out.LikesGiven = int(in.Int())
-		case "likesReceived":
-			out.LikesReceived = int(in.Int())
-		case "matches":
-			out.Matches = int(in.Int())
-		case "complaintsMade":
-			out.ComplaintsMade = int(in.Int())
-		case "complaintsReceived":
-			out.ComplaintsReceived = int(in.Int())
-		case "messagesSent":
-			out.MessagesSent = int(in.Int())
-		case "chatCount":
-			out.ChatCount = int(in.Int())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]Recommendation, 0, 0)
+					} else {
+						out.Items = []Recommendation{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 Recommendation
+					(v19).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextOffset":
+			out.NextOffset = int(in.Int())
+		case "hasMore":
+			out.HasMore = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1854,72 +2015,63 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(out *jwriter.Writer, in ProfileStats) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(out *jwriter.Writer, in RecommendationsPageResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"likesGiven\":"
+		const prefix string = ",\"items\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.LikesGiven))
-	}
-	{
-		const prefix string = ",\"likesReceived\":"
-		out.RawString(prefix)
-		out.Int(int(in.LikesReceived))
-	}
-	{
-		const prefix string = ",\"matches\":"
-		out.RawString(prefix)
-		out.Int(int(in.Matches))
-	}
-	{
-		const prefix string = ",\"complaintsMade\":"
-		out.RawString(prefix)
-		out.Int(int(in.ComplaintsMade))
-	}
-	{
-		const prefix string = ",\"complaintsReceived\":"
-		out.RawString(prefix)
-		out.Int(int(in.ComplaintsReceived))
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Items {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"messagesSent\":"
+		const prefix string = ",\"nextOffset\":"
 		out.RawString(prefix)
-		out.Int(int(in.MessagesSent))
+		out.Int(int(in.NextOffset))
 	}
 	{
-		const prefix string = ",\"chatCount\":"
+		const prefix string = ",\"hasMore\":"
 		out.RawString(prefix)
-		out.Int(int(in.ChatCount))
+		out.Bool(bool(in.HasMore))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileStats) MarshalJSON() ([]byte, error) {
+func (v RecommendationsPageResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileStats) MarshalEasyJSON(w *jwriter.Writer) {
+func (v RecommendationsPageResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileStats) UnmarshalJSON(data []byte) error {
+func (v *RecommendationsPageResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *RecommendationsPageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(in *jlexer.Lexer, out *ProfileResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(in *jlexer.Lexer, out *Recommendation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1938,25 +2090,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profiles":
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
+		case "score":
+			out.Score = float64(in.Float64())
+		case "reasons":
 			if in.IsNull() {
 				in.Skip()
-				out.Profiles = nil
+				out.Reasons = nil
 			} else {
 				in.Delim('[')
-				if out.Profiles == nil {
+				if out.Reasons == nil {
 					if !in.IsDelim(']') {
-						out.Profiles = make([]Profile, 0, 0)
+						out.Reasons = make([]string, 0, 4)
 					} else {
-						out.Profiles = []Profile{}
+						out.Reasons = []string{}
 					}
 				} else {
-					out.Profiles = (out.Profiles)[:0]
+					out.Reasons = (out.Reasons)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 Profile
-					(v13).UnmarshalEasyJSON(in)
-					out.Profiles = append(out.Profiles, v13)
+					var v22 string
+					v22 = string(in.String())
+					out.Reasons = append(out.Reasons, v22)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1971,22 +2127,32 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(out *jwriter.Writer, in ProfileResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(out *jwriter.Writer, in Recommendation) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profiles\":"
+		const prefix string = ",\"profile\":"
 		out.RawString(prefix[1:])
-		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		(in.Profile).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Score))
+	}
+	{
+		const prefix string = ",\"reasons\":"
+		out.RawString(prefix)
+		if in.Reasons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Profiles {
-				if v14 > 0 {
+			for v23, v24 := range in.Reasons {
+				if v23 > 0 {
 					out.RawByte(',')
 				}
-				(v15).MarshalEasyJSON(out)
+				out.String(string(v24))
 			}
 			out.RawByte(']')
 		}
@@ -1995,29 +2161,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(out *jwriter
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileResponse) MarshalJSON() ([]byte, error) {
+func (v Recommendation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Recommendation) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
+func (v *Recommendation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Recommendation) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.Lexer, out *ProfileIsAdmin) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.Lexer, out *ReadPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2036,145 +2202,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profileId":
-			out.ProfileId = int(in.Int())
-		case "firstName":
-			out.FirstName = string(in.String())
-		case "lastName":
-			out.LastName = string(in.String())
-		case "isMale":
-			out.IsMale = bool(in.Bool())
-		case "goal":
-			out.Goal = int(in.Int())
-		case "height":
-			out.Height = int(in.Int())
-		case "birthday":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Birthday).UnmarshalJSON(data))
-			}
-		case "description":
-			out.Description = string(in.String())
-		case "location":
-			out.Location = string(in.String())
-		case "interests":
-			if in.IsNull() {
-				in.Skip()
-				out.Interests = nil
-			} else {
-				in.Delim('[')
-				if out.Interests == nil {
-					if !in.IsDelim(']') {
-						out.Interests = make([]string, 0, 4)
-					} else {
-						out.Interests = []string{}
-					}
-				} else {
-					out.Interests = (out.Interests)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v16 string
-					v16 = string(in.String())
-					out.Interests = append(out.Interests, v16)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "likedBy":
-			if in.IsNull() {
-				in.Skip()
-				out.LikedBy = nil
-			} else {
-				in.Delim('[')
-				if out.LikedBy == nil {
-					if !in.IsDelim(']') {
-						out.LikedBy = make([]int, 0, 8)
-					} else {
-						out.LikedBy = []int{}
-					}
-				} else {
-					out.LikedBy = (out.LikedBy)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v17 int
-					v17 = int(in.Int())
-					out.LikedBy = append(out.LikedBy, v17)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "preferences":
-			if in.IsNull() {
-				in.Skip()
-				out.Preferences = nil
-			} else {
-				in.Delim('[')
-				if out.Preferences == nil {
-					if !in.IsDelim(']') {
-						out.Preferences = make([]Preference, 0, 2)
-					} else {
-						out.Preferences = []Preference{}
-					}
-				} else {
-					out.Preferences = (out.Preferences)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v18 Preference
-					(v18).UnmarshalEasyJSON(in)
-					out.Preferences = append(out.Preferences, v18)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "parameters":
-			if in.IsNull() {
-				in.Skip()
-				out.Parameters = nil
-			} else {
-				in.Delim('[')
-				if out.Parameters == nil {
-					if !in.IsDelim(']') {
-						out.Parameters = make([]Preference, 0, 2)
-					} else {
-						out.Parameters = []Preference{}
-					}
-				} else {
-					out.Parameters = (out.Parameters)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v19 Preference
-					(v19).UnmarshalEasyJSON(in)
-					out.Parameters = append(out.Parameters, v19)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "photos":
-			if in.IsNull() {
-				in.Skip()
-				out.Photos = nil
-			} else {
-				in.Delim('[')
-				if out.Photos == nil {
-					if !in.IsDelim(']') {
-						out.Photos = make([]string, 0, 4)
-					} else {
-						out.Photos = []string{}
-					}
-				} else {
-					out.Photos = (out.Photos)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v20 string
-					v20 = string(in.String())
-					out.Photos = append(out.Photos, v20)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "Premium":
-			(out.Premium).UnmarshalEasyJSON(in)
-		case "isAdmin":
-			out.IsAdmin = bool(in.Bool())
+		case "chat_id":
+			out.ChatID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2185,172 +2214,292 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(out *jwriter.Writer, in ProfileIsAdmin) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(out *jwriter.Writer, in ReadPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profileId\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ProfileId))
+		out.Int(int(in.ChatID))
 	}
-	{
-		const prefix string = ",\"firstName\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstName))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReadPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReadPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReadPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReadPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.Lexer, out *ReadNotifPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"lastName\":"
-		out.RawString(prefix)
-		out.String(string(in.LastName))
-	}
-	{
-		const prefix string = ",\"isMale\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsMale))
-	}
-	{
-		const prefix string = ",\"goal\":"
-		out.RawString(prefix)
-		out.Int(int(in.Goal))
-	}
-	{
-		const prefix string = ",\"height\":"
-		out.RawString(prefix)
-		out.Int(int(in.Height))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notif_type":
+			out.NotifType = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"birthday\":"
-		out.RawString(prefix)
-		out.Raw((in.Birthday).MarshalJSON())
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(out *jwriter.Writer, in ReadNotifPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
+		const prefix string = ",\"notif_type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.NotifType))
 	}
-	{
-		const prefix string = ",\"location\":"
-		out.RawString(prefix)
-		out.String(string(in.Location))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReadNotifPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReadNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReadNotifPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReadNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.Lexer, out *RawTimeConstraints) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"interests\":"
-		out.RawString(prefix)
-		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v21, v22 := range in.Interests {
-				if v21 > 0 {
-					out.RawByte(',')
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "time_from":
+			if in.IsNull() {
+				in.Skip()
+				out.TimeFrom = nil
+			} else {
+				if out.TimeFrom == nil {
+					out.TimeFrom = new(string)
 				}
-				out.String(string(v22))
+				*out.TimeFrom = string(in.String())
 			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"likedBy\":"
-		out.RawString(prefix)
-		if in.LikedBy == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v23, v24 := range in.LikedBy {
-				if v23 > 0 {
-					out.RawByte(',')
+		case "time_to":
+			if in.IsNull() {
+				in.Skip()
+				out.TimeTo = nil
+			} else {
+				if out.TimeTo == nil {
+					out.TimeTo = new(string)
 				}
-				out.Int(int(v24))
+				*out.TimeTo = string(in.String())
 			}
-			out.RawByte(']')
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(out *jwriter.Writer, in RawTimeConstraints) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"preferences\":"
-		out.RawString(prefix)
-		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		const prefix string = ",\"time_from\":"
+		out.RawString(prefix[1:])
+		if in.TimeFrom == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v25, v26 := range in.Preferences {
-				if v25 > 0 {
-					out.RawByte(',')
-				}
-				(v26).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.String(string(*in.TimeFrom))
 		}
 	}
 	{
-		const prefix string = ",\"parameters\":"
+		const prefix string = ",\"time_to\":"
 		out.RawString(prefix)
-		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.TimeTo == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v27, v28 := range in.Parameters {
-				if v27 > 0 {
-					out.RawByte(',')
-				}
-				(v28).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.String(string(*in.TimeTo))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RawTimeConstraints) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RawTimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RawTimeConstraints) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RawTimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.Lexer, out *QuotaStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = string(in.String())
+		case "limit":
+			out.Limit = int(in.Int())
+		case "used":
+			out.Used = int(in.Int())
+		case "remaining":
+			out.Remaining = int(in.Int())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(out *jwriter.Writer, in QuotaStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"photos\":"
+		const prefix string = ",\"limit\":"
 		out.RawString(prefix)
-		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v29, v30 := range in.Photos {
-				if v29 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v30))
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.Limit))
 	}
 	{
-		const prefix string = ",\"Premium\":"
+		const prefix string = ",\"used\":"
 		out.RawString(prefix)
-		(in.Premium).MarshalEasyJSON(out)
+		out.Int(int(in.Used))
 	}
 	{
-		const prefix string = ",\"isAdmin\":"
+		const prefix string = ",\"remaining\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsAdmin))
+		out.Int(int(in.Remaining))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileIsAdmin) MarshalJSON() ([]byte, error) {
+func (v QuotaStatus) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileIsAdmin) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(w, v)
+func (v QuotaStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileIsAdmin) UnmarshalJSON(data []byte) error {
+func (v *QuotaStatus) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileIsAdmin) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(l, v)
+func (v *QuotaStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.Lexer, out *Profile) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.Lexer, out *QuotaResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2369,143 +2518,35 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profileId":
-			out.ProfileId = int(in.Int())
-		case "firstName":
-			out.FirstName = string(in.String())
-		case "lastName":
-			out.LastName = string(in.String())
-		case "isMale":
-			out.IsMale = bool(in.Bool())
-		case "goal":
-			out.Goal = int(in.Int())
-		case "height":
-			out.Height = int(in.Int())
-		case "birthday":
+		case "tier":
+			out.Tier = int(in.Int())
+		case "resetAt":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Birthday).UnmarshalJSON(data))
-			}
-		case "description":
-			out.Description = string(in.String())
-		case "location":
-			out.Location = string(in.String())
-		case "interests":
-			if in.IsNull() {
-				in.Skip()
-				out.Interests = nil
-			} else {
-				in.Delim('[')
-				if out.Interests == nil {
-					if !in.IsDelim(']') {
-						out.Interests = make([]string, 0, 4)
-					} else {
-						out.Interests = []string{}
-					}
-				} else {
-					out.Interests = (out.Interests)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v31 string
-					v31 = string(in.String())
-					out.Interests = append(out.Interests, v31)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "likedBy":
-			if in.IsNull() {
-				in.Skip()
-				out.LikedBy = nil
-			} else {
-				in.Delim('[')
-				if out.LikedBy == nil {
-					if !in.IsDelim(']') {
-						out.LikedBy = make([]int, 0, 8)
-					} else {
-						out.LikedBy = []int{}
-					}
-				} else {
-					out.LikedBy = (out.LikedBy)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v32 int
-					v32 = int(in.Int())
-					out.LikedBy = append(out.LikedBy, v32)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "preferences":
-			if in.IsNull() {
-				in.Skip()
-				out.Preferences = nil
-			} else {
-				in.Delim('[')
-				if out.Preferences == nil {
-					if !in.IsDelim(']') {
-						out.Preferences = make([]Preference, 0, 2)
-					} else {
-						out.Preferences = []Preference{}
-					}
-				} else {
-					out.Preferences = (out.Preferences)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v33 Preference
-					(v33).UnmarshalEasyJSON(in)
-					out.Preferences = append(out.Preferences, v33)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "parameters":
-			if in.IsNull() {
-				in.Skip()
-				out.Parameters = nil
-			} else {
-				in.Delim('[')
-				if out.Parameters == nil {
-					if !in.IsDelim(']') {
-						out.Parameters = make([]Preference, 0, 2)
-					} else {
-						out.Parameters = []Preference{}
-					}
-				} else {
-					out.Parameters = (out.Parameters)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v34 Preference
-					(v34).UnmarshalEasyJSON(in)
-					out.Parameters = append(out.Parameters, v34)
-					in.WantComma()
-				}
-				in.Delim(']')
+				in.AddError((out.ResetAt).UnmarshalJSON(data))
 			}
-		case "photos":
+		case "quotas":
 			if in.IsNull() {
 				in.Skip()
-				out.Photos = nil
+				out.Quotas = nil
 			} else {
 				in.Delim('[')
-				if out.Photos == nil {
+				if out.Quotas == nil {
 					if !in.IsDelim(']') {
-						out.Photos = make([]string, 0, 4)
+						out.Quotas = make([]QuotaStatus, 0, 1)
 					} else {
-						out.Photos = []string{}
+						out.Quotas = []QuotaStatus{}
 					}
 				} else {
-					out.Photos = (out.Photos)[:0]
+					out.Quotas = (out.Quotas)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v35 string
-					v35 = string(in.String())
-					out.Photos = append(out.Photos, v35)
+					var v25 QuotaStatus
+					(v25).UnmarshalEasyJSON(in)
+					out.Quotas = append(out.Quotas, v25)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Premium":
-			(out.Premium).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2516,167 +2557,3806 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(out *jwriter.Writer, in Profile) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(out *jwriter.Writer, in QuotaResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profileId\":"
+		const prefix string = ",\"tier\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ProfileId))
+		out.Int(int(in.Tier))
 	}
 	{
-		const prefix string = ",\"firstName\":"
+		const prefix string = ",\"resetAt\":"
 		out.RawString(prefix)
-		out.String(string(in.FirstName))
+		out.Raw((in.ResetAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"lastName\":"
+		const prefix string = ",\"quotas\":"
 		out.RawString(prefix)
-		out.String(string(in.LastName))
+		if in.Quotas == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Quotas {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuotaResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuotaResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuotaResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuotaResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.Lexer, out *QuietHours) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			out.Start = string(in.String())
+		case "end":
+			out.End = string(in.String())
+		case "timezone":
+			out.Timezone = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(out *jwriter.Writer, in QuietHours) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"isMale\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsMale))
+		const prefix string = ",\"start\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Start))
 	}
 	{
-		const prefix string = ",\"goal\":"
+		const prefix string = ",\"end\":"
 		out.RawString(prefix)
-		out.Int(int(in.Goal))
+		out.String(string(in.End))
 	}
 	{
-		const prefix string = ",\"height\":"
+		const prefix string = ",\"timezone\":"
 		out.RawString(prefix)
-		out.Int(int(in.Height))
+		out.String(string(in.Timezone))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuietHours) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuietHours) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuietHours) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuietHours) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.Lexer, out *QueryStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "TotalAnswers":
+			out.TotalAnswers = int(in.Int())
+		case "AverageScore":
+			out.AverageScore = float64(in.Float64())
+		case "MinScore":
+			out.MinScore = int(in.Int())
+		case "MaxScore":
+			out.MaxScore = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(out *jwriter.Writer, in QueryStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"birthday\":"
-		out.RawString(prefix)
-		out.Raw((in.Birthday).MarshalJSON())
+		const prefix string = ",\"TotalAnswers\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalAnswers))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"AverageScore\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Float64(float64(in.AverageScore))
 	}
 	{
-		const prefix string = ",\"location\":"
+		const prefix string = ",\"MinScore\":"
 		out.RawString(prefix)
-		out.String(string(in.Location))
+		out.Int(int(in.MinScore))
 	}
 	{
-		const prefix string = ",\"interests\":"
+		const prefix string = ",\"MaxScore\":"
 		out.RawString(prefix)
-		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Int(int(in.MaxScore))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.Lexer, out *QueryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "queryis":
+			if in.IsNull() {
+				in.Skip()
+				out.Queries = nil
+			} else {
+				in.Delim('[')
+				if out.Queries == nil {
+					if !in.IsDelim(']') {
+						out.Queries = make([]QueryForUser, 0, 0)
+					} else {
+						out.Queries = []QueryForUser{}
+					}
+				} else {
+					out.Queries = (out.Queries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 QueryForUser
+					(v28).UnmarshalEasyJSON(in)
+					out.Queries = append(out.Queries, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(out *jwriter.Writer, in QueryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"queryis\":"
+		out.RawString(prefix[1:])
+		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v36, v37 := range in.Interests {
-				if v36 > 0 {
+			for v29, v30 := range in.Queries {
+				if v29 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v37))
+				(v30).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.Lexer, out *QueryForUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "minScore":
+			out.MinScore = int(in.Int())
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "answer":
+			out.Answer = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(out *jwriter.Writer, in QueryForUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"minScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinScore))
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"answer\":"
+		out.RawString(prefix)
+		out.String(string(in.Answer))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryForUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryForUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryForUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryForUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.Lexer, out *Query) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "minScore":
+			out.MinScore = int(in.Int())
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(out *jwriter.Writer, in Query) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"minScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinScore))
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Query) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Query) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Query) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Query) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.Lexer, out *QuerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "queryis":
+			if in.IsNull() {
+				in.Skip()
+				out.Queries = nil
+			} else {
+				in.Delim('[')
+				if out.Queries == nil {
+					if !in.IsDelim(']') {
+						out.Queries = make([]Query, 0, 1)
+					} else {
+						out.Queries = []Query{}
+					}
+				} else {
+					out.Queries = (out.Queries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 Query
+					(v31).UnmarshalEasyJSON(in)
+					out.Queries = append(out.Queries, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(out *jwriter.Writer, in QuerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"queryis\":"
+		out.RawString(prefix[1:])
+		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Queries {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				(v33).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuerResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuerResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.Lexer, out *ProfileStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "likesGiven":
+			out.LikesGiven = int(in.Int())
+		case "likesReceived":
+			out.LikesReceived = int(in.Int())
+		case "matches":
+			out.Matches = int(in.Int())
+		case "complaintsMade":
+			out.ComplaintsMade = int(in.Int())
+		case "complaintsReceived":
+			out.ComplaintsReceived = int(in.Int())
+		case "messagesSent":
+			out.MessagesSent = int(in.Int())
+		case "chatCount":
+			out.ChatCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(out *jwriter.Writer, in ProfileStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"likesGiven\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LikesGiven))
+	}
+	{
+		const prefix string = ",\"likesReceived\":"
+		out.RawString(prefix)
+		out.Int(int(in.LikesReceived))
+	}
+	{
+		const prefix string = ",\"matches\":"
+		out.RawString(prefix)
+		out.Int(int(in.Matches))
+	}
+	{
+		const prefix string = ",\"complaintsMade\":"
+		out.RawString(prefix)
+		out.Int(int(in.ComplaintsMade))
+	}
+	{
+		const prefix string = ",\"complaintsReceived\":"
+		out.RawString(prefix)
+		out.Int(int(in.ComplaintsReceived))
+	}
+	{
+		const prefix string = ",\"messagesSent\":"
+		out.RawString(prefix)
+		out.Int(int(in.MessagesSent))
+	}
+	{
+		const prefix string = ",\"chatCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.ChatCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.Lexer, out *ProfileResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profiles":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]Profile, 0, 0)
+					} else {
+						out.Profiles = []Profile{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 Profile
+					(v34).UnmarshalEasyJSON(in)
+					out.Profiles = append(out.Profiles, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(out *jwriter.Writer, in ProfileResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profiles\":"
+		out.RawString(prefix[1:])
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Profiles {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.Lexer, out *ProfileIsAdmin) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "lastName":
+			out.LastName = string(in.String())
+		case "isMale":
+			out.IsMale = bool(in.Bool())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "birthday":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Birthday).UnmarshalJSON(data))
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "location":
+			out.Location = string(in.String())
+		case "interests":
+			if in.IsNull() {
+				in.Skip()
+				out.Interests = nil
+			} else {
+				in.Delim('[')
+				if out.Interests == nil {
+					if !in.IsDelim(']') {
+						out.Interests = make([]string, 0, 4)
+					} else {
+						out.Interests = []string{}
+					}
+				} else {
+					out.Interests = (out.Interests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 string
+					v37 = string(in.String())
+					out.Interests = append(out.Interests, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "likedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedBy = nil
+			} else {
+				in.Delim('[')
+				if out.LikedBy == nil {
+					if !in.IsDelim(']') {
+						out.LikedBy = make([]int, 0, 8)
+					} else {
+						out.LikedBy = []int{}
+					}
+				} else {
+					out.LikedBy = (out.LikedBy)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 int
+					v38 = int(in.Int())
+					out.LikedBy = append(out.LikedBy, v38)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "preferences":
+			if in.IsNull() {
+				in.Skip()
+				out.Preferences = nil
+			} else {
+				in.Delim('[')
+				if out.Preferences == nil {
+					if !in.IsDelim(']') {
+						out.Preferences = make([]Preference, 0, 2)
+					} else {
+						out.Preferences = []Preference{}
+					}
+				} else {
+					out.Preferences = (out.Preferences)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 Preference
+					(v39).UnmarshalEasyJSON(in)
+					out.Preferences = append(out.Preferences, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parameters":
+			if in.IsNull() {
+				in.Skip()
+				out.Parameters = nil
+			} else {
+				in.Delim('[')
+				if out.Parameters == nil {
+					if !in.IsDelim(']') {
+						out.Parameters = make([]Preference, 0, 2)
+					} else {
+						out.Parameters = []Preference{}
+					}
+				} else {
+					out.Parameters = (out.Parameters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 Preference
+					(v40).UnmarshalEasyJSON(in)
+					out.Parameters = append(out.Parameters, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]string, 0, 4)
+					} else {
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v41 string
+					v41 = string(in.String())
+					out.Photos = append(out.Photos, v41)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Premium":
+			(out.Premium).UnmarshalEasyJSON(in)
+		case "isAdmin":
+			out.IsAdmin = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(out *jwriter.Writer, in ProfileIsAdmin) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastName\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	{
+		const prefix string = ",\"isMale\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMale))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix)
+		out.Raw((in.Birthday).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	{
+		const prefix string = ",\"interests\":"
+		out.RawString(prefix)
+		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v42, v43 := range in.Interests {
+				if v42 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v43))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"likedBy\":"
+		out.RawString(prefix)
+		if in.LikedBy == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.LikedBy {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v45))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix)
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v46, v47 := range in.Preferences {
+				if v46 > 0 {
+					out.RawByte(',')
+				}
+				(v47).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parameters\":"
+		out.RawString(prefix)
+		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v48, v49 := range in.Parameters {
+				if v48 > 0 {
+					out.RawByte(',')
+				}
+				(v49).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.Photos {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v51))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Premium\":"
+		out.RawString(prefix)
+		(in.Premium).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"isAdmin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdmin))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileIsAdmin) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileIsAdmin) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileIsAdmin) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileIsAdmin) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.Lexer, out *Profile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "lastName":
+			out.LastName = string(in.String())
+		case "isMale":
+			out.IsMale = bool(in.Bool())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "birthday":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Birthday).UnmarshalJSON(data))
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "location":
+			out.Location = string(in.String())
+		case "interests":
+			if in.IsNull() {
+				in.Skip()
+				out.Interests = nil
+			} else {
+				in.Delim('[')
+				if out.Interests == nil {
+					if !in.IsDelim(']') {
+						out.Interests = make([]string, 0, 4)
+					} else {
+						out.Interests = []string{}
+					}
+				} else {
+					out.Interests = (out.Interests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 string
+					v52 = string(in.String())
+					out.Interests = append(out.Interests, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "likedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedBy = nil
+			} else {
+				in.Delim('[')
+				if out.LikedBy == nil {
+					if !in.IsDelim(']') {
+						out.LikedBy = make([]int, 0, 8)
+					} else {
+						out.LikedBy = []int{}
+					}
+				} else {
+					out.LikedBy = (out.LikedBy)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v53 int
+					v53 = int(in.Int())
+					out.LikedBy = append(out.LikedBy, v53)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "preferences":
+			if in.IsNull() {
+				in.Skip()
+				out.Preferences = nil
+			} else {
+				in.Delim('[')
+				if out.Preferences == nil {
+					if !in.IsDelim(']') {
+						out.Preferences = make([]Preference, 0, 2)
+					} else {
+						out.Preferences = []Preference{}
+					}
+				} else {
+					out.Preferences = (out.Preferences)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v54 Preference
+					(v54).UnmarshalEasyJSON(in)
+					out.Preferences = append(out.Preferences, v54)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parameters":
+			if in.IsNull() {
+				in.Skip()
+				out.Parameters = nil
+			} else {
+				in.Delim('[')
+				if out.Parameters == nil {
+					if !in.IsDelim(']') {
+						out.Parameters = make([]Preference, 0, 2)
+					} else {
+						out.Parameters = []Preference{}
+					}
+				} else {
+					out.Parameters = (out.Parameters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 Preference
+					(v55).UnmarshalEasyJSON(in)
+					out.Parameters = append(out.Parameters, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]string, 0, 4)
+					} else {
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 string
+					v56 = string(in.String())
+					out.Photos = append(out.Photos, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Premium":
+			(out.Premium).UnmarshalEasyJSON(in)
+		case "distance":
+			out.Distance = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(out *jwriter.Writer, in Profile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastName\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	{
+		const prefix string = ",\"isMale\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMale))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix)
+		out.Raw((in.Birthday).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	{
+		const prefix string = ",\"interests\":"
+		out.RawString(prefix)
+		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.Interests {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v58))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"likedBy\":"
+		out.RawString(prefix)
+		if in.LikedBy == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.LikedBy {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v60))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix)
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v61, v62 := range in.Preferences {
+				if v61 > 0 {
+					out.RawByte(',')
+				}
+				(v62).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parameters\":"
+		out.RawString(prefix)
+		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v63, v64 := range in.Parameters {
+				if v63 > 0 {
+					out.RawByte(',')
+				}
+				(v64).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v65, v66 := range in.Photos {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v66))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Premium\":"
+		out.RawString(prefix)
+		(in.Premium).MarshalEasyJSON(out)
+	}
+	if in.Distance != 0 {
+		const prefix string = ",\"distance\":"
+		out.RawString(prefix)
+		out.Int(int(in.Distance))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Profile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Profile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Profile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Profile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(in *jlexer.Lexer, out *PrimaryPhotoRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photo":
+			out.Photo = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(out *jwriter.Writer, in PrimaryPhotoRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Photo))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PrimaryPhotoRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PrimaryPhotoRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PrimaryPhotoRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PrimaryPhotoRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.Lexer, out *PresenceResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "presence":
+			if in.IsNull() {
+				in.Skip()
+				out.Presence = nil
+			} else {
+				in.Delim('[')
+				if out.Presence == nil {
+					if !in.IsDelim(']') {
+						out.Presence = make([]Presence, 0, 1)
+					} else {
+						out.Presence = []Presence{}
+					}
+				} else {
+					out.Presence = (out.Presence)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 Presence
+					(v67).UnmarshalEasyJSON(in)
+					out.Presence = append(out.Presence, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(out *jwriter.Writer, in PresenceResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"presence\":"
+		out.RawString(prefix[1:])
+		if in.Presence == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Presence {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				(v69).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PresenceResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PresenceResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PresenceResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PresenceResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.Lexer, out *Presence) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "isOnline":
+			out.IsOnline = bool(in.Bool())
+		case "lastSeen":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastSeen).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(out *jwriter.Writer, in Presence) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"isOnline\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsOnline))
+	}
+	{
+		const prefix string = ",\"lastSeen\":"
+		out.RawString(prefix)
+		out.Raw((in.LastSeen).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Presence) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Presence) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Presence) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Presence) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.Lexer, out *Premium) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Status":
+			out.Status = bool(in.Bool())
+		case "Border":
+			out.Border = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(out *jwriter.Writer, in Premium) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Status))
+	}
+	{
+		const prefix string = ",\"Border\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Border))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Premium) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Premium) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Premium) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Premium) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.Lexer, out *Preference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "preference_description":
+			out.Description = string(in.String())
+		case "preference_value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(out *jwriter.Writer, in Preference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"preference_description\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"preference_value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Preference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Preference) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Preference) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Preference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.Lexer, out *PhotoOrderRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]string, 0, 4)
+					} else {
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 string
+					v70 = string(in.String())
+					out.Photos = append(out.Photos, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(out *jwriter.Writer, in PhotoOrderRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix[1:])
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v71, v72 := range in.Photos {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v72))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoOrderRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoOrderRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoOrderRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoOrderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.Lexer, out *OutboundMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "userId":
+			out.UserID = int(in.Int())
+		case "channel":
+			out.Channel = string(in.String())
+		case "recipient":
+			out.Recipient = string(in.String())
+		case "subject":
+			out.Subject = string(in.String())
+		case "body":
+			out.Body = string(in.String())
+		case "attempts":
+			out.Attempts = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(out *jwriter.Writer, in OutboundMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		out.String(string(in.Channel))
+	}
+	if in.Recipient != "" {
+		const prefix string = ",\"recipient\":"
+		out.RawString(prefix)
+		out.String(string(in.Recipient))
+	}
+	{
+		const prefix string = ",\"subject\":"
+		out.RawString(prefix)
+		out.String(string(in.Subject))
+	}
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	{
+		const prefix string = ",\"attempts\":"
+		out.RawString(prefix)
+		out.Int(int(in.Attempts))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OutboundMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OutboundMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OutboundMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OutboundMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.Lexer, out *NotificationSettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mutedTypes":
+			if in.IsNull() {
+				in.Skip()
+				out.MutedTypes = nil
+			} else {
+				in.Delim('[')
+				if out.MutedTypes == nil {
+					if !in.IsDelim(']') {
+						out.MutedTypes = make([]string, 0, 4)
+					} else {
+						out.MutedTypes = []string{}
+					}
+				} else {
+					out.MutedTypes = (out.MutedTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 string
+					v73 = string(in.String())
+					out.MutedTypes = append(out.MutedTypes, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "mutedChats":
+			if in.IsNull() {
+				in.Skip()
+				out.MutedChats = nil
+			} else {
+				in.Delim('[')
+				if out.MutedChats == nil {
+					if !in.IsDelim(']') {
+						out.MutedChats = make([]int, 0, 8)
+					} else {
+						out.MutedChats = []int{}
+					}
+				} else {
+					out.MutedChats = (out.MutedChats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v74 int
+					v74 = int(in.Int())
+					out.MutedChats = append(out.MutedChats, v74)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "quietHours":
+			if in.IsNull() {
+				in.Skip()
+				out.QuietHours = nil
+			} else {
+				if out.QuietHours == nil {
+					out.QuietHours = new(QuietHours)
+				}
+				(*out.QuietHours).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(out *jwriter.Writer, in NotificationSettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mutedTypes\":"
+		out.RawString(prefix[1:])
+		if in.MutedTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v75, v76 := range in.MutedTypes {
+				if v75 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v76))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"mutedChats\":"
+		out.RawString(prefix)
+		if in.MutedChats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v77, v78 := range in.MutedChats {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v78))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.QuietHours != nil {
+		const prefix string = ",\"quietHours\":"
+		out.RawString(prefix)
+		(*in.QuietHours).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationSettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationSettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationSettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.Lexer, out *NotificationSend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notificationID":
+			out.NotificationID = int(in.Int())
+		case "read":
+			out.Read = int(in.Int())
+		case "type":
+			out.NotifType = string(in.String())
+		case "content":
+			out.Content = string(in.String())
+		case "payload":
+			if in.IsNull() {
+				in.Skip()
+				out.Payload = nil
+			} else {
+				if out.Payload == nil {
+					out.Payload = new(NotificationPayload)
+				}
+				(*out.Payload).UnmarshalEasyJSON(in)
+			}
+		case "count":
+			out.Count = int(in.Int())
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(out *jwriter.Writer, in NotificationSend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notificationID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.NotificationID))
+	}
+	{
+		const prefix string = ",\"read\":"
+		out.RawString(prefix)
+		out.Int(int(in.Read))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.NotifType))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	if in.Payload != nil {
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		(*in.Payload).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationSend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationSend) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationSend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.Lexer, out *NotificationPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = string(in.String())
+		case "actorId":
+			out.ActorID = int(in.Int())
+		case "chatId":
+			out.ChatID = int(in.Int())
+		case "searchId":
+			out.SearchID = int(in.Int())
+		case "count":
+			out.Count = int(in.Int())
+		case "link":
+			out.Link = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(out *jwriter.Writer, in NotificationPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	if in.ActorID != 0 {
+		const prefix string = ",\"actorId\":"
+		out.RawString(prefix)
+		out.Int(int(in.ActorID))
+	}
+	if in.ChatID != 0 {
+		const prefix string = ",\"chatId\":"
+		out.RawString(prefix)
+		out.Int(int(in.ChatID))
+	}
+	if in.SearchID != 0 {
+		const prefix string = ",\"searchId\":"
+		out.RawString(prefix)
+		out.Int(int(in.SearchID))
+	}
+	if in.Count != 0 {
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	if in.Link != "" {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.Lexer, out *Notification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "payload":
+			if m, ok := out.Payload.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Payload.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Payload = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(out *jwriter.Writer, in Notification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		if m, ok := in.Payload.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Payload.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Payload))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.Lexer, out *MessagesPage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]Message, 0, 0)
+					} else {
+						out.Messages = []Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 Message
+					(v79).UnmarshalEasyJSON(in)
+					out.Messages = append(out.Messages, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = int(in.Int())
+		case "has_more":
+			out.HasMore = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(out *jwriter.Writer, in MessagesPage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v80, v81 := range in.Messages {
+				if v80 > 0 {
+					out.RawByte(',')
+				}
+				(v81).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.Int(int(in.NextCursor))
+	}
+	{
+		const prefix string = ",\"has_more\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasMore))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessagesPage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessagesPage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessagesPage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessagesPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.Lexer, out *Message) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messageid":
+			out.MessageID = int(in.Int())
+		case "senderid":
+			out.SenderID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "status":
+			out.Status = int(in.Int())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "edited":
+			out.Edited = bool(in.Bool())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]Attachment, 0, 1)
+					} else {
+						out.Attachments = []Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 Attachment
+					(v82).UnmarshalEasyJSON(in)
+					out.Attachments = append(out.Attachments, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(out *jwriter.Writer, in Message) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messageid\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.MessageID))
+	}
+	{
+		const prefix string = ",\"senderid\":"
+		out.RawString(prefix)
+		out.Int(int(in.SenderID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"edited\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Edited))
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.Attachments {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				(v84).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Message) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Message) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.Lexer, out *LoginResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			out.Message = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(out *jwriter.Writer, in LoginResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.Lexer, out *LoginRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "login":
+			out.Login = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(out *jwriter.Writer, in LoginRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.Lexer, out *LikeBackResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			out.Message = string(in.String())
+		case "matched":
+			out.Matched = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(out *jwriter.Writer, in LikeBackResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"matched\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Matched))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LikeBackResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LikeBackResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LikeBackResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LikeBackResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.Lexer, out *IncomingLikesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "likes":
+			if in.IsNull() {
+				in.Skip()
+				out.Likes = nil
+			} else {
+				in.Delim('[')
+				if out.Likes == nil {
+					if !in.IsDelim(']') {
+						out.Likes = make([]IncomingLike, 0, 0)
+					} else {
+						out.Likes = []IncomingLike{}
+					}
+				} else {
+					out.Likes = (out.Likes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 IncomingLike
+					(v85).UnmarshalEasyJSON(in)
+					out.Likes = append(out.Likes, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = int(in.Int())
+		case "nextCursor":
+			out.NextCursor = int(in.Int())
+		case "hasMore":
+			out.HasMore = bool(in.Bool())
+		case "blurred":
+			out.Blurred = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(out *jwriter.Writer, in IncomingLikesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix[1:])
+		if in.Likes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v86, v87 := range in.Likes {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				(v87).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.Int(int(in.NextCursor))
+	}
+	{
+		const prefix string = ",\"hasMore\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasMore))
+	}
+	{
+		const prefix string = ",\"blurred\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blurred))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomingLikesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomingLikesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomingLikesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomingLikesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.Lexer, out *IncomingLike) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
+		case "status":
+			out.Status = int(in.Int())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(out *jwriter.Writer, in IncomingLike) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profile\":"
+		out.RawString(prefix[1:])
+		(in.Profile).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomingLike) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomingLike) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomingLike) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomingLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.Lexer, out *HistoryPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "before_message_id":
+			out.BeforeMessageID = int(in.Int())
+		case "limit":
+			out.Limit = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter.Writer, in HistoryPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"before_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BeforeMessageID))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HistoryPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HistoryPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HistoryPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HistoryPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.Lexer, out *HandleComplaint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "complaint_id":
+			out.Complaint_id = int(in.Int())
+		case "new_status":
+			out.NewStatus = int(in.Int())
+		case "reason":
+			out.Reason = string(in.String())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter.Writer, in HandleComplaint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"complaint_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Complaint_id))
+	}
+	{
+		const prefix string = ",\"new_status\":"
+		out.RawString(prefix)
+		out.Int(int(in.NewStatus))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HandleComplaint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HandleComplaint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HandleComplaint) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HandleComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(in *jlexer.Lexer, out *GetAnswerStatistics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query_name":
+			out.Query_name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(out *jwriter.Writer, in GetAnswerStatistics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query_name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query_name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetAnswerStatistics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetAnswerStatistics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetAnswerStatistics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetAnswerStatistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(in *jlexer.Lexer, out *FoundProfileResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profiles":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]FoundProfile, 0, 1)
+					} else {
+						out.Profiles = []FoundProfile{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 FoundProfile
+					(v88).UnmarshalEasyJSON(in)
+					out.Profiles = append(out.Profiles, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-			out.RawByte(']')
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(out *jwriter.Writer, in FoundProfileResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"likedBy\":"
-		out.RawString(prefix)
-		if in.LikedBy == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		const prefix string = ",\"profiles\":"
+		out.RawString(prefix[1:])
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v38, v39 := range in.LikedBy {
-				if v38 > 0 {
+			for v89, v90 := range in.Profiles {
+				if v89 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v39))
+				(v90).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
 	{
-		const prefix string = ",\"preferences\":"
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v40, v41 := range in.Preferences {
-				if v40 > 0 {
-					out.RawByte(',')
-				}
-				(v41).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FoundProfileResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FoundProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FoundProfileResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FoundProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel59(in *jlexer.Lexer, out *FoundProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "idUser":
+			out.IDUser = int(in.Int())
+		case "firstImgSrc":
+			out.FirstImg = string(in.String())
+		case "fullname":
+			out.Fullname = string(in.String())
+		case "age":
+			out.Age = int(in.Int())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "distance":
+			out.Distance = int(in.Int())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel59(out *jwriter.Writer, in FoundProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"parameters\":"
+		const prefix string = ",\"idUser\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.IDUser))
+	}
+	{
+		const prefix string = ",\"firstImgSrc\":"
 		out.RawString(prefix)
-		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v42, v43 := range in.Parameters {
-				if v42 > 0 {
-					out.RawByte(',')
-				}
-				(v43).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+		out.String(string(in.FirstImg))
+	}
+	{
+		const prefix string = ",\"fullname\":"
+		out.RawString(prefix)
+		out.String(string(in.Fullname))
+	}
+	{
+		const prefix string = ",\"age\":"
+		out.RawString(prefix)
+		out.Int(int(in.Age))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	if in.Distance != 0 {
+		const prefix string = ",\"distance\":"
+		out.RawString(prefix)
+		out.Int(int(in.Distance))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FoundProfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FoundProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FoundProfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FoundProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel59(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel60(in *jlexer.Lexer, out *FlowersPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel60(out *jwriter.Writer, in FlowersPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlowersPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlowersPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlowersPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlowersPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel60(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel61(in *jlexer.Lexer, out *FindQueryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "query_id":
+			out.Query_id = int(in.Int())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel61(out *jwriter.Writer, in FindQueryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"photos\":"
-		out.RawString(prefix)
-		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v44, v45 := range in.Photos {
-				if v44 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v45))
-			}
-			out.RawByte(']')
-		}
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Premium\":"
+		const prefix string = ",\"query_id\":"
 		out.RawString(prefix)
-		(in.Premium).MarshalEasyJSON(out)
+		out.Int(int(in.Query_id))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Profile) MarshalJSON() ([]byte, error) {
+func (v FindQueryRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Profile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(w, v)
+func (v FindQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Profile) UnmarshalJSON(data []byte) error {
+func (v *FindQueryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Profile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(l, v)
+func (v *FindQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel61(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.Lexer, out *Premium) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel62(in *jlexer.Lexer, out *FindComplaint) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2695,10 +6375,18 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "Status":
-			out.Status = bool(in.Bool())
-		case "Border":
-			out.Border = int32(in.Int32())
+		case "complaint_by":
+			out.Complaint_by = int(in.Int())
+		case "name_by":
+			out.Name_by = string(in.String())
+		case "complaint_on":
+			out.Complaint_on = int(in.Int())
+		case "name_on":
+			out.Name_on = string(in.String())
+		case "complaint_type":
+			out.Complaint_type = string(in.String())
+		case "status":
+			out.Status = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2709,47 +6397,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(out *jwriter.Writer, in Premium) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel62(out *jwriter.Writer, in FindComplaint) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Status\":"
+		const prefix string = ",\"complaint_by\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Status))
+		out.Int(int(in.Complaint_by))
 	}
 	{
-		const prefix string = ",\"Border\":"
+		const prefix string = ",\"name_by\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Border))
+		out.String(string(in.Name_by))
+	}
+	{
+		const prefix string = ",\"complaint_on\":"
+		out.RawString(prefix)
+		out.Int(int(in.Complaint_on))
+	}
+	{
+		const prefix string = ",\"name_on\":"
+		out.RawString(prefix)
+		out.String(string(in.Name_on))
+	}
+	{
+		const prefix string = ",\"complaint_type\":"
+		out.RawString(prefix)
+		out.String(string(in.Complaint_type))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Premium) MarshalJSON() ([]byte, error) {
+func (v FindComplaint) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel62(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Premium) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(w, v)
+func (v FindComplaint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel62(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Premium) UnmarshalJSON(data []byte) error {
+func (v *FindComplaint) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel62(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Premium) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(l, v)
+func (v *FindComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel62(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.Lexer, out *Preference) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel63(in *jlexer.Lexer, out *ErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2768,10 +6476,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "preference_description":
-			out.Description = string(in.String())
-		case "preference_value":
-			out.Value = string(in.String())
+		case "message":
+			out.Message = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2782,47 +6488,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(out *jwriter.Writer, in Preference) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel63(out *jwriter.Writer, in ErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"preference_description\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"preference_value\":"
-		out.RawString(prefix)
-		out.String(string(in.Value))
+		out.String(string(in.Message))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Preference) MarshalJSON() ([]byte, error) {
+func (v ErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel63(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Preference) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(w, v)
+func (v ErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel63(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Preference) UnmarshalJSON(data []byte) error {
+func (v *ErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel63(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Preference) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(l, v)
+func (v *ErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel63(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.Lexer, out *NotificationSend) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel64(in *jlexer.Lexer, out *EditPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2841,12 +6542,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "notificationID":
-			out.NotificationID = int(in.Int())
-		case "read":
-			out.Read = int(in.Int())
-		case "type":
-			out.NotifType = string(in.String())
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "message_id":
+			out.MessageID = int(in.Int())
 		case "content":
 			out.Content = string(in.String())
 		default:
@@ -2859,24 +6558,19 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(out *jwriter.Writer, in NotificationSend) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel64(out *jwriter.Writer, in EditPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notificationID\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.NotificationID))
-	}
-	{
-		const prefix string = ",\"read\":"
-		out.RawString(prefix)
-		out.Int(int(in.Read))
+		out.Int(int(in.ChatID))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"message_id\":"
 		out.RawString(prefix)
-		out.String(string(in.NotifType))
+		out.Int(int(in.MessageID))
 	}
 	{
 		const prefix string = ",\"content\":"
@@ -2887,29 +6581,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(out *jwriter
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NotificationSend) MarshalJSON() ([]byte, error) {
+func (v EditPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel64(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NotificationSend) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(w, v)
+func (v EditPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel64(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NotificationSend) UnmarshalJSON(data []byte) error {
+func (v *EditPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel64(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NotificationSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(l, v)
+func (v *EditPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel64(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.Lexer, out *Notification) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel65(in *jlexer.Lexer, out *Digest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2928,16 +6622,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "payload":
-			if m, ok := out.Payload.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Payload.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Payload = in.Interface()
-			}
+		case "UserID":
+			out.UserID = int(in.Int())
+		case "Email":
+			out.Email = string(in.String())
+		case "Matches":
+			out.Matches = int(in.Int())
+		case "Messages":
+			out.Messages = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2948,53 +6640,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(out *jwriter.Writer, in Notification) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel65(out *jwriter.Writer, in Digest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"UserID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Int(int(in.UserID))
 	}
 	{
-		const prefix string = ",\"payload\":"
+		const prefix string = ",\"Email\":"
 		out.RawString(prefix)
-		if m, ok := in.Payload.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Payload.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Payload))
-		}
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"Matches\":"
+		out.RawString(prefix)
+		out.Int(int(in.Matches))
+	}
+	{
+		const prefix string = ",\"Messages\":"
+		out.RawString(prefix)
+		out.Int(int(in.Messages))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Notification) MarshalJSON() ([]byte, error) {
+func (v Digest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel65(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(w, v)
+func (v Digest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel65(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Notification) UnmarshalJSON(data []byte) error {
+func (v *Digest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel65(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(l, v)
+func (v *Digest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel65(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.Lexer, out *Message) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel66(in *jlexer.Lexer, out *DeleteQueryRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3013,18 +6709,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "messageid":
-			out.MessageID = int(in.Int())
-		case "senderid":
-			out.SenderID = int(in.Int())
-		case "text":
-			out.Text = string(in.String())
-		case "status":
-			out.Status = int(in.Int())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
+		case "query_name":
+			out.Query_name = string(in.String())
+		case "user_id":
+			out.User_id = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3035,62 +6723,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(out *jwriter.Writer, in Message) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel66(out *jwriter.Writer, in DeleteQueryRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"messageid\":"
+		const prefix string = ",\"query_name\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.MessageID))
-	}
-	{
-		const prefix string = ",\"senderid\":"
-		out.RawString(prefix)
-		out.Int(int(in.SenderID))
-	}
-	{
-		const prefix string = ",\"text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.Int(int(in.Status))
+		out.String(string(in.Query_name))
 	}
 	{
-		const prefix string = ",\"createdAt\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Int(int(in.User_id))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Message) MarshalJSON() ([]byte, error) {
+func (v DeleteQueryRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel66(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(w, v)
+func (v DeleteQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel66(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Message) UnmarshalJSON(data []byte) error {
+func (v *DeleteQueryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel66(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(l, v)
+func (v *DeleteQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel66(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.Lexer, out *LoginResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel67(in *jlexer.Lexer, out *DeletePayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3109,10 +6782,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
-		case "user_id":
-			out.UserID = int(in.Int())
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "message_id":
+			out.MessageID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3123,47 +6796,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(out *jwriter.Writer, in LoginResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel67(out *jwriter.Writer, in DeletePayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.Int(int(in.ChatID))
 	}
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"message_id\":"
 		out.RawString(prefix)
-		out.Int(int(in.UserID))
+		out.Int(int(in.MessageID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LoginResponse) MarshalJSON() ([]byte, error) {
+func (v DeletePayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LoginResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(w, v)
+func (v DeletePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LoginResponse) UnmarshalJSON(data []byte) error {
+func (v *DeletePayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LoginResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(l, v)
+func (v *DeletePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel67(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.Lexer, out *LoginRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel68(in *jlexer.Lexer, out *DeleteNotifPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3182,10 +6855,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "login":
-			out.Login = string(in.String())
-		case "password":
-			out.Password = string(in.String())
+		case "notif_id":
+			out.NotifID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3196,47 +6867,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(out *jwriter.Writer, in LoginRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel68(out *jwriter.Writer, in DeleteNotifPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"notif_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Login))
-	}
-	{
-		const prefix string = ",\"password\":"
-		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.Int(int(in.NotifID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LoginRequest) MarshalJSON() ([]byte, error) {
+func (v DeleteNotifPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel68(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LoginRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(w, v)
+func (v DeleteNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel68(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LoginRequest) UnmarshalJSON(data []byte) error {
+func (v *DeleteNotifPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel68(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LoginRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(l, v)
+func (v *DeleteNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel68(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.Lexer, out *HandleComplaint) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel69(in *jlexer.Lexer, out *DeleteComlaint) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3257,8 +6923,6 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.L
 		switch key {
 		case "complaint_id":
 			out.Complaint_id = int(in.Int())
-		case "new_status":
-			out.NewStatus = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3269,7 +6933,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(out *jwriter.Writer, in HandleComplaint) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel69(out *jwriter.Writer, in DeleteComlaint) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3278,38 +6942,33 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(out *jwriter
 		out.RawString(prefix[1:])
 		out.Int(int(in.Complaint_id))
 	}
-	{
-		const prefix string = ",\"new_status\":"
-		out.RawString(prefix)
-		out.Int(int(in.NewStatus))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HandleComplaint) MarshalJSON() ([]byte, error) {
+func (v DeleteComlaint) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HandleComplaint) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(w, v)
+func (v DeleteComlaint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HandleComplaint) UnmarshalJSON(data []byte) error {
+func (v *DeleteComlaint) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HandleComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(l, v)
+func (v *DeleteComlaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel69(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.Lexer, out *GetAnswerStatistics) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel70(in *jlexer.Lexer, out *DeleteChatRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3328,8 +6987,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "query_name":
-			out.Query_name = string(in.String())
+		case "firstID":
+			out.FristID = int(in.Int())
+		case "secondID":
+			out.SecondID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3340,42 +7001,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(out *jwriter.Writer, in GetAnswerStatistics) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel70(out *jwriter.Writer, in DeleteChatRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"query_name\":"
+		const prefix string = ",\"firstID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Query_name))
+		out.Int(int(in.FristID))
+	}
+	{
+		const prefix string = ",\"secondID\":"
+		out.RawString(prefix)
+		out.Int(int(in.SecondID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetAnswerStatistics) MarshalJSON() ([]byte, error) {
+func (v DeleteChatRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetAnswerStatistics) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(w, v)
+func (v DeleteChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetAnswerStatistics) UnmarshalJSON(data []byte) error {
+func (v *DeleteChatRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetAnswerStatistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(l, v)
+func (v *DeleteChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel70(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.Lexer, out *FoundProfileResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel71(in *jlexer.Lexer, out *CreatePayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3394,25 +7060,31 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profiles":
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "content":
+			out.Content = string(in.String())
+		case "attachment_ids":
 			if in.IsNull() {
 				in.Skip()
-				out.Profiles = nil
+				out.AttachmentIDs = nil
 			} else {
 				in.Delim('[')
-				if out.Profiles == nil {
+				if out.AttachmentIDs == nil {
 					if !in.IsDelim(']') {
-						out.Profiles = make([]FoundProfile, 0, 1)
+						out.AttachmentIDs = make([]int, 0, 8)
 					} else {
-						out.Profiles = []FoundProfile{}
+						out.AttachmentIDs = []int{}
 					}
 				} else {
-					out.Profiles = (out.Profiles)[:0]
+					out.AttachmentIDs = (out.AttachmentIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v46 FoundProfile
-					(v46).UnmarshalEasyJSON(in)
-					out.Profiles = append(out.Profiles, v46)
+					var v91 int
+					v91 = int(in.Int())
+					out.AttachmentIDs = append(out.AttachmentIDs, v91)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3427,22 +7099,37 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(out *jwriter.Writer, in FoundProfileResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel71(out *jwriter.Writer, in CreatePayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profiles\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"attachment_ids\":"
+		out.RawString(prefix)
+		if in.AttachmentIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v47, v48 := range in.Profiles {
-				if v47 > 0 {
+			for v92, v93 := range in.AttachmentIDs {
+				if v92 > 0 {
 					out.RawByte(',')
 				}
-				(v48).MarshalEasyJSON(out)
+				out.Int(int(v93))
 			}
 			out.RawByte(']')
 		}
@@ -3451,29 +7138,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(out *jwriter
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FoundProfileResponse) MarshalJSON() ([]byte, error) {
+func (v CreatePayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FoundProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(w, v)
+func (v CreatePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FoundProfileResponse) UnmarshalJSON(data []byte) error {
+func (v *CreatePayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FoundProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(l, v)
+func (v *CreatePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel71(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.Lexer, out *FoundProfile) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel72(in *jlexer.Lexer, out *CreateComplaintRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3492,16 +7179,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "idUser":
-			out.IDUser = int(in.Int())
-		case "firstImgSrc":
-			out.FirstImg = string(in.String())
-		case "fullname":
-			out.Fullname = string(in.String())
-		case "age":
-			out.Age = int(in.Int())
-		case "goal":
-			out.Goal = int(in.Int())
+		case "firstID":
+			out.Complaint_type = string(in.String())
+		case "complaint_text":
+			out.Complaint_text = string(in.String())
+		case "complaint_on":
+			out.Complaint_on = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3512,62 +7195,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(out *jwriter.Writer, in FoundProfile) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel72(out *jwriter.Writer, in CreateComplaintRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"idUser\":"
+		const prefix string = ",\"firstID\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.IDUser))
-	}
-	{
-		const prefix string = ",\"firstImgSrc\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstImg))
-	}
-	{
-		const prefix string = ",\"fullname\":"
-		out.RawString(prefix)
-		out.String(string(in.Fullname))
+		out.String(string(in.Complaint_type))
 	}
 	{
-		const prefix string = ",\"age\":"
+		const prefix string = ",\"complaint_text\":"
 		out.RawString(prefix)
-		out.Int(int(in.Age))
+		out.String(string(in.Complaint_text))
 	}
 	{
-		const prefix string = ",\"goal\":"
+		const prefix string = ",\"complaint_on\":"
 		out.RawString(prefix)
-		out.Int(int(in.Goal))
+		out.String(string(in.Complaint_on))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FoundProfile) MarshalJSON() ([]byte, error) {
+func (v CreateComplaintRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FoundProfile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(w, v)
+func (v CreateComplaintRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FoundProfile) UnmarshalJSON(data []byte) error {
+func (v *CreateComplaintRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FoundProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(l, v)
+func (v *CreateComplaintRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel72(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.Lexer, out *FlowersPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel73(in *jlexer.Lexer, out *CreateChatRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3586,8 +7259,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = int(in.Int())
+		case "firstID":
+			out.FristID = int(in.Int())
+		case "secondID":
+			out.SecondID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3598,42 +7273,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(out *jwriter.Writer, in FlowersPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel73(out *jwriter.Writer, in CreateChatRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"firstID\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.UserID))
+		out.Int(int(in.FristID))
+	}
+	{
+		const prefix string = ",\"secondID\":"
+		out.RawString(prefix)
+		out.Int(int(in.SecondID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FlowersPayload) MarshalJSON() ([]byte, error) {
+func (v CreateChatRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FlowersPayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(w, v)
+func (v CreateChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FlowersPayload) UnmarshalJSON(data []byte) error {
+func (v *CreateChatRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FlowersPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(l, v)
+func (v *CreateChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel73(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.Lexer, out *FindQueryRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel74(in *jlexer.Lexer, out *CoordinatesRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3652,10 +7332,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "query_id":
-			out.Query_id = int(in.Int())
+		case "latitude":
+			out.Latitude = float64(in.Float64())
+		case "longitude":
+			out.Longitude = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3666,47 +7346,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(out *jwriter.Writer, in FindQueryRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel74(out *jwriter.Writer, in CoordinatesRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"latitude\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.Float64(float64(in.Latitude))
 	}
 	{
-		const prefix string = ",\"query_id\":"
+		const prefix string = ",\"longitude\":"
 		out.RawString(prefix)
-		out.Int(int(in.Query_id))
+		out.Float64(float64(in.Longitude))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FindQueryRequest) MarshalJSON() ([]byte, error) {
+func (v CoordinatesRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel74(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FindQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(w, v)
+func (v CoordinatesRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FindQueryRequest) UnmarshalJSON(data []byte) error {
+func (v *CoordinatesRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FindQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(l, v)
+func (v *CoordinatesRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel74(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(in *jlexer.Lexer, out *FindComplaint) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel75(in *jlexer.Lexer, out *Cookie) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3725,18 +7405,20 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaint_by":
-			out.Complaint_by = int(in.Int())
-		case "name_by":
-			out.Name_by = string(in.String())
-		case "complaint_on":
-			out.Complaint_on = int(in.Int())
-		case "name_on":
-			out.Name_on = string(in.String())
-		case "complaint_type":
-			out.Complaint_type = string(in.String())
-		case "status":
-			out.Status = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "value":
+			out.Value = string(in.String())
+		case "expires":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Expires).UnmarshalJSON(data))
+			}
+		case "httpOnly":
+			out.HttpOnly = bool(in.Bool())
+		case "secure":
+			out.Secure = bool(in.Bool())
+		case "path":
+			out.Path = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3747,67 +7429,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(out *jwriter.Writer, in FindComplaint) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel75(out *jwriter.Writer, in Cookie) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"complaint_by\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Complaint_by))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"name_by\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix)
-		out.String(string(in.Name_by))
+		out.String(string(in.Value))
 	}
 	{
-		const prefix string = ",\"complaint_on\":"
+		const prefix string = ",\"expires\":"
 		out.RawString(prefix)
-		out.Int(int(in.Complaint_on))
+		out.Raw((in.Expires).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"name_on\":"
+		const prefix string = ",\"httpOnly\":"
 		out.RawString(prefix)
-		out.String(string(in.Name_on))
+		out.Bool(bool(in.HttpOnly))
 	}
 	{
-		const prefix string = ",\"complaint_type\":"
+		const prefix string = ",\"secure\":"
 		out.RawString(prefix)
-		out.String(string(in.Complaint_type))
+		out.Bool(bool(in.Secure))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"path\":"
 		out.RawString(prefix)
-		out.Int(int(in.Status))
+		out.String(string(in.Path))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FindComplaint) MarshalJSON() ([]byte, error) {
+func (v Cookie) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FindComplaint) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(w, v)
+func (v Cookie) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FindComplaint) UnmarshalJSON(data []byte) error {
+func (v *Cookie) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FindComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(l, v)
+func (v *Cookie) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel75(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.Lexer, out *ErrorResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel76(in *jlexer.Lexer, out *ComplaintsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3826,8 +7508,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
+		case "complaints":
+			if in.IsNull() {
+				in.Skip()
+				out.Complaints = nil
+			} else {
+				in.Delim('[')
+				if out.Complaints == nil {
+					if !in.IsDelim(']') {
+						out.Complaints = make([]ComplaintWithLogins, 0, 0)
+					} else {
+						out.Complaints = []ComplaintWithLogins{}
+					}
+				} else {
+					out.Complaints = (out.Complaints)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v94 ComplaintWithLogins
+					(v94).UnmarshalEasyJSON(in)
+					out.Complaints = append(out.Complaints, v94)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3838,42 +7541,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(out *jwriter.Writer, in ErrorResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel76(out *jwriter.Writer, in ComplaintsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"complaints\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		if in.Complaints == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v95, v96 := range in.Complaints {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				(v96).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ErrorResponse) MarshalJSON() ([]byte, error) {
+func (v ComplaintsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(w, v)
+func (v ComplaintsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ErrorResponse) UnmarshalJSON(data []byte) error {
+func (v *ComplaintsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(l, v)
+func (v *ComplaintsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel76(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.Lexer, out *DeleteQueryRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel77(in *jlexer.Lexer, out *ComplaintWithLogins) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3892,10 +7606,36 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "query_name":
-			out.Query_name = string(in.String())
-		case "user_id":
-			out.User_id = int(in.Int())
+		case "complaint_id":
+			out.ComplaintID = int64(in.Int64())
+		case "complaint_by":
+			out.ComplaintBy = string(in.String())
+		case "complaint_on":
+			out.ComplaintOn = string(in.String())
+		case "complaint_type":
+			out.ComplaintType = int64(in.Int64())
+		case "type_description":
+			out.TypeDesc = string(in.String())
+		case "complaint_text":
+			out.Text = string(in.String())
+		case "status":
+			out.Status = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "closed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3906,47 +7646,86 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(out *jwriter.Writer, in DeleteQueryRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel77(out *jwriter.Writer, in ComplaintWithLogins) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"query_name\":"
+		const prefix string = ",\"complaint_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Query_name))
+		out.Int64(int64(in.ComplaintID))
 	}
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"complaint_by\":"
 		out.RawString(prefix)
-		out.Int(int(in.User_id))
+		out.String(string(in.ComplaintBy))
+	}
+	{
+		const prefix string = ",\"complaint_on\":"
+		out.RawString(prefix)
+		out.String(string(in.ComplaintOn))
+	}
+	{
+		const prefix string = ",\"complaint_type\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ComplaintType))
+	}
+	{
+		const prefix string = ",\"type_description\":"
+		out.RawString(prefix)
+		out.String(string(in.TypeDesc))
+	}
+	{
+		const prefix string = ",\"complaint_text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"closed_at\":"
+		out.RawString(prefix)
+		if in.ClosedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ClosedAt).MarshalJSON())
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteQueryRequest) MarshalJSON() ([]byte, error) {
+func (v ComplaintWithLogins) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(w, v)
+func (v ComplaintWithLogins) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteQueryRequest) UnmarshalJSON(data []byte) error {
+func (v *ComplaintWithLogins) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(l, v)
+func (v *ComplaintWithLogins) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel77(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.Lexer, out *DeletePayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel78(in *jlexer.Lexer, out *ComplaintStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3965,10 +7744,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
-			out.ChatID = int(in.Int())
-		case "message_id":
-			out.MessageID = int(in.Int())
+		case "total_complaints":
+			out.Total = int(in.Int())
+		case "rejected":
+			out.Rejected = int(in.Int())
+		case "pending":
+			out.Pending = int(in.Int())
+		case "approved":
+			out.Approved = int(in.Int())
+		case "closed":
+			out.Closed = int(in.Int())
+		case "total_complainants":
+			out.TotalBy = int(in.Int())
+		case "total_reported":
+			out.TotalOn = int(in.Int())
+		case "first_complaint":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.FirstComplaint).UnmarshalJSON(data))
+			}
+		case "last_complaint":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastComplaint).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3979,47 +7776,82 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(out *jwriter.Writer, in DeletePayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel78(out *jwriter.Writer, in ComplaintStats) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"total_complaints\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatID))
+		out.Int(int(in.Total))
 	}
 	{
-		const prefix string = ",\"message_id\":"
+		const prefix string = ",\"rejected\":"
 		out.RawString(prefix)
-		out.Int(int(in.MessageID))
+		out.Int(int(in.Rejected))
+	}
+	{
+		const prefix string = ",\"pending\":"
+		out.RawString(prefix)
+		out.Int(int(in.Pending))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Int(int(in.Approved))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Closed))
+	}
+	{
+		const prefix string = ",\"total_complainants\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalBy))
+	}
+	{
+		const prefix string = ",\"total_reported\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalOn))
+	}
+	{
+		const prefix string = ",\"first_complaint\":"
+		out.RawString(prefix)
+		out.Raw((in.FirstComplaint).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"last_complaint\":"
+		out.RawString(prefix)
+		out.Raw((in.LastComplaint).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeletePayload) MarshalJSON() ([]byte, error) {
+func (v ComplaintStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeletePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(w, v)
+func (v ComplaintStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeletePayload) UnmarshalJSON(data []byte) error {
+func (v *ComplaintStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeletePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(l, v)
+func (v *ComplaintStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel78(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.Lexer, out *DeleteNotifPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel79(in *jlexer.Lexer, out *ComplaintNote) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4038,8 +7870,24 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "notif_id":
-			out.NotifID = int(in.Int())
+		case "note_id":
+			out.NoteID = int(in.Int())
+		case "author_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AuthorID = nil
+			} else {
+				if out.AuthorID == nil {
+					out.AuthorID = new(int)
+				}
+				*out.AuthorID = int(in.Int())
+			}
+		case "note":
+			out.Note = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4050,42 +7898,61 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(out *jwriter.Writer, in DeleteNotifPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel79(out *jwriter.Writer, in ComplaintNote) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notif_id\":"
+		const prefix string = ",\"note_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.NotifID))
+		out.Int(int(in.NoteID))
+	}
+	{
+		const prefix string = ",\"author_id\":"
+		out.RawString(prefix)
+		if in.AuthorID == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.AuthorID))
+		}
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteNotifPayload) MarshalJSON() ([]byte, error) {
+func (v ComplaintNote) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(w, v)
+func (v ComplaintNote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteNotifPayload) UnmarshalJSON(data []byte) error {
+func (v *ComplaintNote) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(l, v)
+func (v *ComplaintNote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel79(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.Lexer, out *DeleteComlaint) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel80(in *jlexer.Lexer, out *ComplaintHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4105,7 +7972,77 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.L
 		}
 		switch key {
 		case "complaint_id":
-			out.Complaint_id = int(in.Int())
+			out.ComplaintID = int(in.Int())
+		case "status":
+			out.Status = int(in.Int())
+		case "assigned_to":
+			if in.IsNull() {
+				in.Skip()
+				out.AssignedTo = nil
+			} else {
+				if out.AssignedTo == nil {
+					out.AssignedTo = new(int)
+				}
+				*out.AssignedTo = int(in.Int())
+			}
+		case "resolved_by":
+			if in.IsNull() {
+				in.Skip()
+				out.ResolvedBy = nil
+			} else {
+				if out.ResolvedBy == nil {
+					out.ResolvedBy = new(int)
+				}
+				*out.ResolvedBy = int(in.Int())
+			}
+		case "resolution_reason":
+			out.ResolutionReason = string(in.String())
+		case "notes":
+			if in.IsNull() {
+				in.Skip()
+				out.Notes = nil
+			} else {
+				in.Delim('[')
+				if out.Notes == nil {
+					if !in.IsDelim(']') {
+						out.Notes = make([]ComplaintNote, 0, 1)
+					} else {
+						out.Notes = []ComplaintNote{}
+					}
+				} else {
+					out.Notes = (out.Notes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 ComplaintNote
+					(v97).UnmarshalEasyJSON(in)
+					out.Notes = append(out.Notes, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]ComplaintAuditEntry, 0, 0)
+					} else {
+						out.Events = []ComplaintAuditEntry{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v98 ComplaintAuditEntry
+					(v98).UnmarshalEasyJSON(in)
+					out.Events = append(out.Events, v98)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4116,42 +8053,102 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(out *jwriter.Writer, in DeleteComlaint) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel80(out *jwriter.Writer, in ComplaintHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"complaint_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Complaint_id))
+		out.Int(int(in.ComplaintID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"assigned_to\":"
+		out.RawString(prefix)
+		if in.AssignedTo == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.AssignedTo))
+		}
+	}
+	{
+		const prefix string = ",\"resolved_by\":"
+		out.RawString(prefix)
+		if in.ResolvedBy == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.ResolvedBy))
+		}
+	}
+	if in.ResolutionReason != "" {
+		const prefix string = ",\"resolution_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.ResolutionReason))
+	}
+	{
+		const prefix string = ",\"notes\":"
+		out.RawString(prefix)
+		if in.Notes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v99, v100 := range in.Notes {
+				if v99 > 0 {
+					out.RawByte(',')
+				}
+				(v100).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix)
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Events {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				(v102).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteComlaint) MarshalJSON() ([]byte, error) {
+func (v ComplaintHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteComlaint) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(w, v)
+func (v ComplaintHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteComlaint) UnmarshalJSON(data []byte) error {
+func (v *ComplaintHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteComlaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(l, v)
+func (v *ComplaintHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel80(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.Lexer, out *DeleteChatRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel81(in *jlexer.Lexer, out *ComplaintAuditEntry) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4170,10 +8167,50 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "firstID":
-			out.FristID = int(in.Int())
-		case "secondID":
-			out.SecondID = int(in.Int())
+		case "audit_id":
+			out.AuditID = int(in.Int())
+		case "actor_id":
+			out.ActorID = int(in.Int())
+		case "action":
+			out.Action = string(in.String())
+		case "from_status":
+			if in.IsNull() {
+				in.Skip()
+				out.FromStatus = nil
+			} else {
+				if out.FromStatus == nil {
+					out.FromStatus = new(int)
+				}
+				*out.FromStatus = int(in.Int())
+			}
+		case "to_status":
+			if in.IsNull() {
+				in.Skip()
+				out.ToStatus = nil
+			} else {
+				if out.ToStatus == nil {
+					out.ToStatus = new(int)
+				}
+				*out.ToStatus = int(in.Int())
+			}
+		case "assignee_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AssigneeID = nil
+			} else {
+				if out.AssigneeID == nil {
+					out.AssigneeID = new(int)
+				}
+				*out.AssigneeID = int(in.Int())
+			}
+		case "reason":
+			out.Reason = string(in.String())
+		case "note":
+			out.Note = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4184,47 +8221,82 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(out *jwriter.Writer, in DeleteChatRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel81(out *jwriter.Writer, in ComplaintAuditEntry) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"firstID\":"
+		const prefix string = ",\"audit_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.FristID))
+		out.Int(int(in.AuditID))
 	}
 	{
-		const prefix string = ",\"secondID\":"
+		const prefix string = ",\"actor_id\":"
 		out.RawString(prefix)
-		out.Int(int(in.SecondID))
+		out.Int(int(in.ActorID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	if in.FromStatus != nil {
+		const prefix string = ",\"from_status\":"
+		out.RawString(prefix)
+		out.Int(int(*in.FromStatus))
+	}
+	if in.ToStatus != nil {
+		const prefix string = ",\"to_status\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ToStatus))
+	}
+	if in.AssigneeID != nil {
+		const prefix string = ",\"assignee_id\":"
+		out.RawString(prefix)
+		out.Int(int(*in.AssigneeID))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteChatRequest) MarshalJSON() ([]byte, error) {
+func (v ComplaintAuditEntry) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(w, v)
+func (v ComplaintAuditEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteChatRequest) UnmarshalJSON(data []byte) error {
+func (v *ComplaintAuditEntry) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(l, v)
+func (v *ComplaintAuditEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel81(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.Lexer, out *CreatePayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel82(in *jlexer.Lexer, out *ChatsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4243,12 +8315,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
-			out.ChatID = int(in.Int())
-		case "user_id":
-			out.UserID = int(in.Int())
-		case "content":
-			out.Content = string(in.String())
+		case "chats":
+			if in.IsNull() {
+				in.Skip()
+				out.Chats = nil
+			} else {
+				in.Delim('[')
+				if out.Chats == nil {
+					if !in.IsDelim(']') {
+						out.Chats = make([]Chat, 0, 0)
+					} else {
+						out.Chats = []Chat{}
+					}
+				} else {
+					out.Chats = (out.Chats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v103 Chat
+					(v103).UnmarshalEasyJSON(in)
+					out.Chats = append(out.Chats, v103)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4259,52 +8348,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(out *jwriter.Writer, in CreatePayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel82(out *jwriter.Writer, in ChatsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"chats\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatID))
-	}
-	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.Int(int(in.UserID))
-	}
-	{
-		const prefix string = ",\"content\":"
-		out.RawString(prefix)
-		out.String(string(in.Content))
+		if in.Chats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v104, v105 := range in.Chats {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				(v105).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreatePayload) MarshalJSON() ([]byte, error) {
+func (v ChatsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreatePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(w, v)
+func (v ChatsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreatePayload) UnmarshalJSON(data []byte) error {
+func (v *ChatsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreatePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(l, v)
+func (v *ChatsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel82(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.Lexer, out *CreateComplaintRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel83(in *jlexer.Lexer, out *ChatSubscriptionPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4323,12 +8413,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "firstID":
-			out.Complaint_type = string(in.String())
-		case "complaint_text":
-			out.Complaint_text = string(in.String())
-		case "complaint_on":
-			out.Complaint_on = string(in.String())
+		case "chat_id":
+			out.ChatID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4339,52 +8425,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(out *jwriter.Writer, in CreateComplaintRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel83(out *jwriter.Writer, in ChatSubscriptionPayload) {
 	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"firstID\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Complaint_type))
-	}
-	{
-		const prefix string = ",\"complaint_text\":"
-		out.RawString(prefix)
-		out.String(string(in.Complaint_text))
-	}
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"complaint_on\":"
-		out.RawString(prefix)
-		out.String(string(in.Complaint_on))
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateComplaintRequest) MarshalJSON() ([]byte, error) {
+func (v ChatSubscriptionPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateComplaintRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(w, v)
+func (v ChatSubscriptionPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateComplaintRequest) UnmarshalJSON(data []byte) error {
+func (v *ChatSubscriptionPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateComplaintRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(l, v)
+func (v *ChatSubscriptionPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel83(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.Lexer, out *CreateChatRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel84(in *jlexer.Lexer, out *ChatNotificationsPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4403,10 +8479,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "firstID":
-			out.FristID = int(in.Int())
-		case "secondID":
-			out.SecondID = int(in.Int())
+		case "chat_id":
+			out.ChatID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4417,47 +8491,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(out *jwriter.Writer, in CreateChatRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel84(out *jwriter.Writer, in ChatNotificationsPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"firstID\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.FristID))
-	}
-	{
-		const prefix string = ",\"secondID\":"
-		out.RawString(prefix)
-		out.Int(int(in.SecondID))
+		out.Int(int(in.ChatID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateChatRequest) MarshalJSON() ([]byte, error) {
+func (v ChatNotificationsPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(w, v)
+func (v ChatNotificationsPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateChatRequest) UnmarshalJSON(data []byte) error {
+func (v *ChatNotificationsPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(l, v)
+func (v *ChatNotificationsPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel84(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.Lexer, out *Cookie) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel85(in *jlexer.Lexer, out *Chat) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4476,20 +8545,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "value":
-			out.Value = string(in.String())
-		case "expires":
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "chatId":
+			out.ChatId = int(in.Int())
+		case "profileName":
+			out.ProfileName = string(in.String())
+		case "profilePicture":
+			out.ProfilePicture = string(in.String())
+		case "profileDescription":
+			out.ProfileDescription = string(in.String())
+		case "lastMessage":
+			out.LastMessage = string(in.String())
+		case "isRead":
+			out.IsRead = bool(in.Bool())
+		case "isSelf":
+			out.IsSelf = bool(in.Bool())
+		case "isOnline":
+			out.IsOnline = bool(in.Bool())
+		case "lastSeen":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Expires).UnmarshalJSON(data))
+				in.AddError((out.LastSeen).UnmarshalJSON(data))
 			}
-		case "httpOnly":
-			out.HttpOnly = bool(in.Bool())
-		case "secure":
-			out.Secure = bool(in.Bool())
-		case "path":
-			out.Path = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4500,67 +8577,87 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(out *jwriter.Writer, in Cookie) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel85(out *jwriter.Writer, in Chat) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"profileId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.Int(int(in.ProfileId))
 	}
 	{
-		const prefix string = ",\"value\":"
+		const prefix string = ",\"chatId\":"
 		out.RawString(prefix)
-		out.String(string(in.Value))
+		out.Int(int(in.ChatId))
 	}
 	{
-		const prefix string = ",\"expires\":"
+		const prefix string = ",\"profileName\":"
 		out.RawString(prefix)
-		out.Raw((in.Expires).MarshalJSON())
+		out.String(string(in.ProfileName))
 	}
 	{
-		const prefix string = ",\"httpOnly\":"
+		const prefix string = ",\"profilePicture\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.HttpOnly))
+		out.String(string(in.ProfilePicture))
 	}
 	{
-		const prefix string = ",\"secure\":"
+		const prefix string = ",\"profileDescription\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Secure))
+		out.String(string(in.ProfileDescription))
 	}
 	{
-		const prefix string = ",\"path\":"
+		const prefix string = ",\"lastMessage\":"
 		out.RawString(prefix)
-		out.String(string(in.Path))
+		out.String(string(in.LastMessage))
+	}
+	{
+		const prefix string = ",\"isRead\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRead))
+	}
+	{
+		const prefix string = ",\"isSelf\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSelf))
+	}
+	{
+		const prefix string = ",\"isOnline\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsOnline))
+	}
+	{
+		const prefix string = ",\"lastSeen\":"
+		out.RawString(prefix)
+		out.Raw((in.LastSeen).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Cookie) MarshalJSON() ([]byte, error) {
+func (v Chat) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Cookie) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(w, v)
+func (v Chat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Cookie) UnmarshalJSON(data []byte) error {
+func (v *Chat) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Cookie) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(l, v)
+func (v *Chat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel85(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.Lexer, out *ComplaintsResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel86(in *jlexer.Lexer, out *ChangeBorderRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4579,29 +8676,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaints":
-			if in.IsNull() {
-				in.Skip()
-				out.Complaints = nil
-			} else {
-				in.Delim('[')
-				if out.Complaints == nil {
-					if !in.IsDelim(']') {
-						out.Complaints = make([]ComplaintWithLogins, 0, 0)
-					} else {
-						out.Complaints = []ComplaintWithLogins{}
-					}
-				} else {
-					out.Complaints = (out.Complaints)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v49 ComplaintWithLogins
-					(v49).UnmarshalEasyJSON(in)
-					out.Complaints = append(out.Complaints, v49)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "new_border":
+			out.NewBorder = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4612,53 +8688,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(out *jwriter.Writer, in ComplaintsResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel86(out *jwriter.Writer, in ChangeBorderRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"complaints\":"
+		const prefix string = ",\"new_border\":"
 		out.RawString(prefix[1:])
-		if in.Complaints == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v50, v51 := range in.Complaints {
-				if v50 > 0 {
-					out.RawByte(',')
-				}
-				(v51).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.NewBorder))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ComplaintsResponse) MarshalJSON() ([]byte, error) {
+func (v ChangeBorderRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ComplaintsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(w, v)
+func (v ChangeBorderRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ComplaintsResponse) UnmarshalJSON(data []byte) error {
+func (v *ChangeBorderRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ComplaintsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(l, v)
+func (v *ChangeBorderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel86(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.Lexer, out *ComplaintWithLogins) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel87(in *jlexer.Lexer, out *BlockedProfilesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4677,35 +8742,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaint_id":
-			out.ComplaintID = int64(in.Int64())
-		case "complaint_by":
-			out.ComplaintBy = string(in.String())
-		case "complaint_on":
-			out.ComplaintOn = string(in.String())
-		case "complaint_type":
-			out.ComplaintType = int64(in.Int64())
-		case "type_description":
-			out.TypeDesc = string(in.String())
-		case "complaint_text":
-			out.Text = string(in.String())
-		case "status":
-			out.Status = int(in.Int())
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "closed_at":
+		case "blocked":
 			if in.IsNull() {
 				in.Skip()
-				out.ClosedAt = nil
+				out.Blocked = nil
 			} else {
-				if out.ClosedAt == nil {
-					out.ClosedAt = new(time.Time)
+				in.Delim('[')
+				if out.Blocked == nil {
+					if !in.IsDelim(']') {
+						out.Blocked = make([]BlockedProfile, 0, 1)
+					} else {
+						out.Blocked = []BlockedProfile{}
+					}
+				} else {
+					out.Blocked = (out.Blocked)[:0]
 				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				for !in.IsDelim(']') {
+					var v106 BlockedProfile
+					(v106).UnmarshalEasyJSON(in)
+					out.Blocked = append(out.Blocked, v106)
+					in.WantComma()
 				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -4717,86 +8775,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(out *jwriter.Writer, in ComplaintWithLogins) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel87(out *jwriter.Writer, in BlockedProfilesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"complaint_id\":"
+		const prefix string = ",\"blocked\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ComplaintID))
-	}
-	{
-		const prefix string = ",\"complaint_by\":"
-		out.RawString(prefix)
-		out.String(string(in.ComplaintBy))
-	}
-	{
-		const prefix string = ",\"complaint_on\":"
-		out.RawString(prefix)
-		out.String(string(in.ComplaintOn))
-	}
-	{
-		const prefix string = ",\"complaint_type\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.ComplaintType))
-	}
-	{
-		const prefix string = ",\"type_description\":"
-		out.RawString(prefix)
-		out.String(string(in.TypeDesc))
-	}
-	{
-		const prefix string = ",\"complaint_text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.Int(int(in.Status))
-	}
-	{
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"closed_at\":"
-		out.RawString(prefix)
-		if in.ClosedAt == nil {
+		if in.Blocked == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.Raw((*in.ClosedAt).MarshalJSON())
+			out.RawByte('[')
+			for v107, v108 := range in.Blocked {
+				if v107 > 0 {
+					out.RawByte(',')
+				}
+				(v108).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ComplaintWithLogins) MarshalJSON() ([]byte, error) {
+func (v BlockedProfilesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ComplaintWithLogins) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(w, v)
+func (v BlockedProfilesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ComplaintWithLogins) UnmarshalJSON(data []byte) error {
+func (v *BlockedProfilesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ComplaintWithLogins) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(l, v)
+func (v *BlockedProfilesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel87(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.Lexer, out *ComplaintStats) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel88(in *jlexer.Lexer, out *BlockedProfile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4815,27 +8840,15 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "total_complaints":
-			out.Total = int(in.Int())
-		case "rejected":
-			out.Rejected = int(in.Int())
-		case "pending":
-			out.Pending = int(in.Int())
-		case "approved":
-			out.Approved = int(in.Int())
-		case "closed":
-			out.Closed = int(in.Int())
-		case "total_complainants":
-			out.TotalBy = int(in.Int())
-		case "total_reported":
-			out.TotalOn = int(in.Int())
-		case "first_complaint":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.FirstComplaint).UnmarshalJSON(data))
-			}
-		case "last_complaint":
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "blockedAt":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.LastComplaint).UnmarshalJSON(data))
+				in.AddError((out.BlockedAt).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -4847,82 +8860,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(out *jwriter.Writer, in ComplaintStats) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel88(out *jwriter.Writer, in BlockedProfile) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"total_complaints\":"
+		const prefix string = ",\"profileId\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Total))
-	}
-	{
-		const prefix string = ",\"rejected\":"
-		out.RawString(prefix)
-		out.Int(int(in.Rejected))
-	}
-	{
-		const prefix string = ",\"pending\":"
-		out.RawString(prefix)
-		out.Int(int(in.Pending))
-	}
-	{
-		const prefix string = ",\"approved\":"
-		out.RawString(prefix)
-		out.Int(int(in.Approved))
-	}
-	{
-		const prefix string = ",\"closed\":"
-		out.RawString(prefix)
-		out.Int(int(in.Closed))
-	}
-	{
-		const prefix string = ",\"total_complainants\":"
-		out.RawString(prefix)
-		out.Int(int(in.TotalBy))
+		out.Int(int(in.ProfileId))
 	}
 	{
-		const prefix string = ",\"total_reported\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Int(int(in.TotalOn))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"first_complaint\":"
+		const prefix string = ",\"avatar\":"
 		out.RawString(prefix)
-		out.Raw((in.FirstComplaint).MarshalJSON())
+		out.String(string(in.Avatar))
 	}
 	{
-		const prefix string = ",\"last_complaint\":"
+		const prefix string = ",\"blockedAt\":"
 		out.RawString(prefix)
-		out.Raw((in.LastComplaint).MarshalJSON())
+		out.Raw((in.BlockedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ComplaintStats) MarshalJSON() ([]byte, error) {
+func (v BlockedProfile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ComplaintStats) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(w, v)
+func (v BlockedProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ComplaintStats) UnmarshalJSON(data []byte) error {
+func (v *BlockedProfile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ComplaintStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(l, v)
+func (v *BlockedProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel88(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.Lexer, out *ChatsResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel89(in *jlexer.Lexer, out *AttachmentsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4941,25 +8929,25 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chats":
+		case "attachments":
 			if in.IsNull() {
 				in.Skip()
-				out.Chats = nil
+				out.Attachments = nil
 			} else {
 				in.Delim('[')
-				if out.Chats == nil {
+				if out.Attachments == nil {
 					if !in.IsDelim(']') {
-						out.Chats = make([]Chat, 0, 0)
+						out.Attachments = make([]Attachment, 0, 1)
 					} else {
-						out.Chats = []Chat{}
+						out.Attachments = []Attachment{}
 					}
 				} else {
-					out.Chats = (out.Chats)[:0]
+					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v52 Chat
-					(v52).UnmarshalEasyJSON(in)
-					out.Chats = append(out.Chats, v52)
+					var v109 Attachment
+					(v109).UnmarshalEasyJSON(in)
+					out.Attachments = append(out.Attachments, v109)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4974,22 +8962,22 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(out *jwriter.Writer, in ChatsResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel89(out *jwriter.Writer, in AttachmentsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chats\":"
+		const prefix string = ",\"attachments\":"
 		out.RawString(prefix[1:])
-		if in.Chats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v53, v54 := range in.Chats {
-				if v53 > 0 {
+			for v110, v111 := range in.Attachments {
+				if v110 > 0 {
 					out.RawByte(',')
 				}
-				(v54).MarshalEasyJSON(out)
+				(v111).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4998,29 +8986,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(out *jwriter
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChatsResponse) MarshalJSON() ([]byte, error) {
+func (v AttachmentsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChatsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(w, v)
+func (v AttachmentsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChatsResponse) UnmarshalJSON(data []byte) error {
+func (v *AttachmentsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChatsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(l, v)
+func (v *AttachmentsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel89(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.Lexer, out *ChatNotificationsPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel90(in *jlexer.Lexer, out *AttachmentFile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5039,8 +9027,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
+		case "ChatID":
 			out.ChatID = int(in.Int())
+		case "Path":
+			out.Path = string(in.String())
+		case "ContentType":
+			out.ContentType = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5051,42 +9043,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(out *jwriter.Writer, in ChatNotificationsPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel90(out *jwriter.Writer, in AttachmentFile) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"ChatID\":"
 		out.RawString(prefix[1:])
 		out.Int(int(in.ChatID))
 	}
+	{
+		const prefix string = ",\"Path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"ContentType\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChatNotificationsPayload) MarshalJSON() ([]byte, error) {
+func (v AttachmentFile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChatNotificationsPayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(w, v)
+func (v AttachmentFile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChatNotificationsPayload) UnmarshalJSON(data []byte) error {
+func (v *AttachmentFile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChatNotificationsPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(l, v)
+func (v *AttachmentFile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel90(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.Lexer, out *Chat) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel91(in *jlexer.Lexer, out *Attachment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5105,22 +9107,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profileId":
-			out.ProfileId = int(in.Int())
-		case "chatId":
-			out.ChatId = int(in.Int())
-		case "profileName":
-			out.ProfileName = string(in.String())
-		case "profilePicture":
-			out.ProfilePicture = string(in.String())
-		case "profileDescription":
-			out.ProfileDescription = string(in.String())
-		case "lastMessage":
-			out.LastMessage = string(in.String())
-		case "isRead":
-			out.IsRead = bool(in.Bool())
-		case "isSelf":
-			out.IsSelf = bool(in.Bool())
+		case "attachmentId":
+			out.AttachmentID = int(in.Int())
+		case "contentType":
+			out.ContentType = string(in.String())
+		case "url":
+			out.URL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5131,77 +9123,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(out *jwriter.Writer, in Chat) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel91(out *jwriter.Writer, in Attachment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profileId\":"
+		const prefix string = ",\"attachmentId\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ProfileId))
-	}
-	{
-		const prefix string = ",\"chatId\":"
-		out.RawString(prefix)
-		out.Int(int(in.ChatId))
+		out.Int(int(in.AttachmentID))
 	}
 	{
-		const prefix string = ",\"profileName\":"
-		out.RawString(prefix)
-		out.String(string(in.ProfileName))
-	}
-	{
-		const prefix string = ",\"profilePicture\":"
-		out.RawString(prefix)
-		out.String(string(in.ProfilePicture))
-	}
-	{
-		const prefix string = ",\"profileDescription\":"
-		out.RawString(prefix)
-		out.String(string(in.ProfileDescription))
-	}
-	{
-		const prefix string = ",\"lastMessage\":"
+		const prefix string = ",\"contentType\":"
 		out.RawString(prefix)
-		out.String(string(in.LastMessage))
-	}
-	{
-		const prefix string = ",\"isRead\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsRead))
+		out.String(string(in.ContentType))
 	}
 	{
-		const prefix string = ",\"isSelf\":"
+		const prefix string = ",\"url\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsSelf))
+		out.String(string(in.URL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Chat) MarshalJSON() ([]byte, error) {
+func (v Attachment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Chat) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(w, v)
+func (v Attachment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Chat) UnmarshalJSON(data []byte) error {
+func (v *Attachment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Chat) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(l, v)
+func (v *Attachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel91(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.Lexer, out *ChangeBorderRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel92(in *jlexer.Lexer, out *AssignComplaint) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5220,8 +9187,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "new_border":
-			out.NewBorder = int(in.Int())
+		case "complaint_id":
+			out.Complaint_id = int(in.Int())
+		case "assignee_id":
+			out.AssigneeID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -5232,42 +9201,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(out *jwriter.Writer, in ChangeBorderRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel92(out *jwriter.Writer, in AssignComplaint) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"new_border\":"
+		const prefix string = ",\"complaint_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.NewBorder))
+		out.Int(int(in.Complaint_id))
+	}
+	{
+		const prefix string = ",\"assignee_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AssigneeID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChangeBorderRequest) MarshalJSON() ([]byte, error) {
+func (v AssignComplaint) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChangeBorderRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(w, v)
+func (v AssignComplaint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChangeBorderRequest) UnmarshalJSON(data []byte) error {
+func (v *AssignComplaint) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChangeBorderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(l, v)
+func (v *AssignComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel92(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.Lexer, out *AnswersResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel93(in *jlexer.Lexer, out *AnswersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5302,9 +9276,9 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.L
 					out.Answers = (out.Answers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v55 UsersForQuery
-					(v55).UnmarshalEasyJSON(in)
-					out.Answers = append(out.Answers, v55)
+					var v112 UsersForQuery
+					(v112).UnmarshalEasyJSON(in)
+					out.Answers = append(out.Answers, v112)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5319,7 +9293,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter.Writer, in AnswersResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel93(out *jwriter.Writer, in AnswersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5330,11 +9304,11 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v56, v57 := range in.Answers {
-				if v56 > 0 {
+			for v113, v114 := range in.Answers {
+				if v113 > 0 {
 					out.RawByte(',')
 				}
-				(v57).MarshalEasyJSON(out)
+				(v114).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5345,27 +9319,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AnswersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AnswersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AnswersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AnswersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel93(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.Lexer, out *AnswersForResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel94(in *jlexer.Lexer, out *AnswersForResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5400,9 +9374,9 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.L
 					out.Answers = (out.Answers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 AnswersForQuery
-					(v58).UnmarshalEasyJSON(in)
-					out.Answers = append(out.Answers, v58)
+					var v115 AnswersForQuery
+					(v115).UnmarshalEasyJSON(in)
+					out.Answers = append(out.Answers, v115)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5417,7 +9391,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter.Writer, in AnswersForResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel94(out *jwriter.Writer, in AnswersForResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5428,11 +9402,11 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v59, v60 := range in.Answers {
-				if v59 > 0 {
+			for v116, v117 := range in.Answers {
+				if v116 > 0 {
 					out.RawByte(',')
 				}
-				(v60).MarshalEasyJSON(out)
+				(v117).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5443,27 +9417,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AnswersForResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel94(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AnswersForResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel94(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AnswersForResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel94(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AnswersForResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel94(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(in *jlexer.Lexer, out *AnswersForQuery) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel95(in *jlexer.Lexer, out *AnswersForQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5508,7 +9482,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(out *jwriter.Writer, in AnswersForQuery) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel95(out *jwriter.Writer, in AnswersForQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5558,27 +9532,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AnswersForQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel95(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AnswersForQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel95(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AnswersForQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel95(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AnswersForQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel95(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(in *jlexer.Lexer, out *AddSubRequet) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel96(in *jlexer.Lexer, out *AddSubRequet) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5609,7 +9583,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(out *jwriter.Writer, in AddSubRequet) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel96(out *jwriter.Writer, in AddSubRequet) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5624,23 +9598,96 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AddSubRequet) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddSubRequet) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddSubRequet) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddSubRequet) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel96(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel97(in *jlexer.Lexer, out *AddComplaintNote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "complaint_id":
+			out.Complaint_id = int(in.Int())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel97(out *jwriter.Writer, in AddComplaintNote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"complaint_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Complaint_id))
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddComplaintNote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel97(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AddComplaintNote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel97(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddComplaintNote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel97(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AddComplaintNote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel97(l, v)
 }
@@ -22,16 +22,23 @@ type ProfilesServiceClient interface {
 	StoreProfile(ctx context.Context, in *StoreProfileRequest, opts ...grpc.CallOption) (*StoreProfileResponse, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetProfileCoordinates(ctx context.Context, in *SetProfileCoordinatesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProfiles(ctx context.Context, in *GetProfilesRequest, opts ...grpc.CallOption) (*GetProfilesResponse, error)
 	GetProfileImages(ctx context.Context, in *GetProfileImagesRequest, opts ...grpc.CallOption) (*GetProfileImagesResponse, error)
 	UploadProfileImage(ctx context.Context, in *UploadProfileImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReorderProfileImages(ctx context.Context, in *ReorderProfileImagesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetPrimaryProfileImage(ctx context.Context, in *SetPrimaryProfileImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProfileMatches(ctx context.Context, in *GetProfileMatchesRequest, opts ...grpc.CallOption) (*GetProfileMatchesResponse, error)
 	SetProfileLike(ctx context.Context, in *SetProfileLikeRequest, opts ...grpc.CallOption) (*SetProfileLikeResponse, error)
+	RewindProfileLike(ctx context.Context, in *RewindProfileLikeRequest, opts ...grpc.CallOption) (*RewindProfileLikeResponse, error)
+	UnmatchProfile(ctx context.Context, in *UnmatchProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetIncomingLikes(ctx context.Context, in *GetIncomingLikesRequest, opts ...grpc.CallOption) (*GetIncomingLikesResponse, error)
 	SearchProfile(ctx context.Context, in *SearchProfileRequest, opts ...grpc.CallOption) (*SearchProfileResponse, error)
 	GetProfileStats(ctx context.Context, in *GetProfileStatsRequest, opts ...grpc.CallOption) (*GetProfileStatsResponse, error)
 	GetRecommendations(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	GetRecommendationsPage(ctx context.Context, in *GetRecommendationsPageRequest, opts ...grpc.CallOption) (*GetRecommendationsPageResponse, error)
 }
 
 type profilesServiceClient struct {
@@ -69,6 +76,15 @@ func (c *profilesServiceClient) UpdateProfile(ctx context.Context, in *UpdatePro
 	return out, nil
 }
 
+func (c *profilesServiceClient) SetProfileCoordinates(ctx context.Context, in *SetProfileCoordinatesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SetProfileCoordinates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profilesServiceClient) DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/DeleteProfile", in, out, opts...)
@@ -114,6 +130,24 @@ func (c *profilesServiceClient) DeleteImage(ctx context.Context, in *DeleteImage
 	return out, nil
 }
 
+func (c *profilesServiceClient) ReorderProfileImages(ctx context.Context, in *ReorderProfileImagesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/ReorderProfileImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) SetPrimaryProfileImage(ctx context.Context, in *SetPrimaryProfileImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SetPrimaryProfileImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profilesServiceClient) GetProfileMatches(ctx context.Context, in *GetProfileMatchesRequest, opts ...grpc.CallOption) (*GetProfileMatchesResponse, error) {
 	out := new(GetProfileMatchesResponse)
 	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetProfileMatches", in, out, opts...)
@@ -132,6 +166,33 @@ func (c *profilesServiceClient) SetProfileLike(ctx context.Context, in *SetProfi
 	return out, nil
 }
 
+func (c *profilesServiceClient) RewindProfileLike(ctx context.Context, in *RewindProfileLikeRequest, opts ...grpc.CallOption) (*RewindProfileLikeResponse, error) {
+	out := new(RewindProfileLikeResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/RewindProfileLike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) UnmatchProfile(ctx context.Context, in *UnmatchProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/UnmatchProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetIncomingLikes(ctx context.Context, in *GetIncomingLikesRequest, opts ...grpc.CallOption) (*GetIncomingLikesResponse, error) {
+	out := new(GetIncomingLikesResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetIncomingLikes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profilesServiceClient) SearchProfile(ctx context.Context, in *SearchProfileRequest, opts ...grpc.CallOption) (*SearchProfileResponse, error) {
 	out := new(SearchProfileResponse)
 	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SearchProfile", in, out, opts...)
@@ -159,6 +220,15 @@ func (c *profilesServiceClient) GetRecommendations(ctx context.Context, in *GetP
 	return out, nil
 }
 
+func (c *profilesServiceClient) GetRecommendationsPage(ctx context.Context, in *GetRecommendationsPageRequest, opts ...grpc.CallOption) (*GetRecommendationsPageResponse, error) {
+	out := new(GetRecommendationsPageResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetRecommendationsPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfilesServiceServer is the server API for ProfilesService service.
 // All implementations must embed UnimplementedProfilesServiceServer
 // for forward compatibility
@@ -166,16 +236,23 @@ type ProfilesServiceServer interface {
 	StoreProfile(context.Context, *StoreProfileRequest) (*StoreProfileResponse, error)
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*emptypb.Empty, error)
+	SetProfileCoordinates(context.Context, *SetProfileCoordinatesRequest) (*emptypb.Empty, error)
 	DeleteProfile(context.Context, *DeleteProfileRequest) (*emptypb.Empty, error)
 	GetProfiles(context.Context, *GetProfilesRequest) (*GetProfilesResponse, error)
 	GetProfileImages(context.Context, *GetProfileImagesRequest) (*GetProfileImagesResponse, error)
 	UploadProfileImage(context.Context, *UploadProfileImageRequest) (*emptypb.Empty, error)
 	DeleteImage(context.Context, *DeleteImageRequest) (*emptypb.Empty, error)
+	ReorderProfileImages(context.Context, *ReorderProfileImagesRequest) (*emptypb.Empty, error)
+	SetPrimaryProfileImage(context.Context, *SetPrimaryProfileImageRequest) (*emptypb.Empty, error)
 	GetProfileMatches(context.Context, *GetProfileMatchesRequest) (*GetProfileMatchesResponse, error)
 	SetProfileLike(context.Context, *SetProfileLikeRequest) (*SetProfileLikeResponse, error)
+	RewindProfileLike(context.Context, *RewindProfileLikeRequest) (*RewindProfileLikeResponse, error)
+	UnmatchProfile(context.Context, *UnmatchProfileRequest) (*emptypb.Empty, error)
+	GetIncomingLikes(context.Context, *GetIncomingLikesRequest) (*GetIncomingLikesResponse, error)
 	SearchProfile(context.Context, *SearchProfileRequest) (*SearchProfileResponse, error)
 	GetProfileStats(context.Context, *GetProfileStatsRequest) (*GetProfileStatsResponse, error)
 	GetRecommendations(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	GetRecommendationsPage(context.Context, *GetRecommendationsPageRequest) (*GetRecommendationsPageResponse, error)
 	mustEmbedUnimplementedProfilesServiceServer()
 }
 
@@ -192,6 +269,9 @@ func (UnimplementedProfilesServiceServer) GetProfile(context.Context, *GetProfil
 func (UnimplementedProfilesServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedProfilesServiceServer) SetProfileCoordinates(context.Context, *SetProfileCoordinatesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfileCoordinates not implemented")
+}
 func (UnimplementedProfilesServiceServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
 }
@@ -207,12 +287,27 @@ func (UnimplementedProfilesServiceServer) UploadProfileImage(context.Context, *U
 func (UnimplementedProfilesServiceServer) DeleteImage(context.Context, *DeleteImageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
 }
+func (UnimplementedProfilesServiceServer) ReorderProfileImages(context.Context, *ReorderProfileImagesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderProfileImages not implemented")
+}
+func (UnimplementedProfilesServiceServer) SetPrimaryProfileImage(context.Context, *SetPrimaryProfileImageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryProfileImage not implemented")
+}
 func (UnimplementedProfilesServiceServer) GetProfileMatches(context.Context, *GetProfileMatchesRequest) (*GetProfileMatchesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfileMatches not implemented")
 }
 func (UnimplementedProfilesServiceServer) SetProfileLike(context.Context, *SetProfileLikeRequest) (*SetProfileLikeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProfileLike not implemented")
 }
+func (UnimplementedProfilesServiceServer) RewindProfileLike(context.Context, *RewindProfileLikeRequest) (*RewindProfileLikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewindProfileLike not implemented")
+}
+func (UnimplementedProfilesServiceServer) UnmatchProfile(context.Context, *UnmatchProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmatchProfile not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetIncomingLikes(context.Context, *GetIncomingLikesRequest) (*GetIncomingLikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomingLikes not implemented")
+}
 func (UnimplementedProfilesServiceServer) SearchProfile(context.Context, *SearchProfileRequest) (*SearchProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchProfile not implemented")
 }
@@ -222,6 +317,9 @@ func (UnimplementedProfilesServiceServer) GetProfileStats(context.Context, *GetP
 func (UnimplementedProfilesServiceServer) GetRecommendations(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
 }
+func (UnimplementedProfilesServiceServer) GetRecommendationsPage(context.Context, *GetRecommendationsPageRequest) (*GetRecommendationsPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendationsPage not implemented")
+}
 func (UnimplementedProfilesServiceServer) mustEmbedUnimplementedProfilesServiceServer() {}
 
 // UnsafeProfilesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -289,6 +387,24 @@ func _ProfilesService_UpdateProfile_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfilesService_SetProfileCoordinates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileCoordinatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).SetProfileCoordinates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/SetProfileCoordinates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).SetProfileCoordinates(ctx, req.(*SetProfileCoordinatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfilesService_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteProfileRequest)
 	if err := dec(in); err != nil {
@@ -379,6 +495,42 @@ func _ProfilesService_DeleteImage_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfilesService_ReorderProfileImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProfileImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).ReorderProfileImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/ReorderProfileImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).ReorderProfileImages(ctx, req.(*ReorderProfileImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_SetPrimaryProfileImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryProfileImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).SetPrimaryProfileImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/SetPrimaryProfileImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).SetPrimaryProfileImage(ctx, req.(*SetPrimaryProfileImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfilesService_GetProfileMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProfileMatchesRequest)
 	if err := dec(in); err != nil {
@@ -415,6 +567,60 @@ func _ProfilesService_SetProfileLike_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfilesService_RewindProfileLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewindProfileLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).RewindProfileLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/RewindProfileLike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).RewindProfileLike(ctx, req.(*RewindProfileLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_UnmatchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmatchProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).UnmatchProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/UnmatchProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).UnmatchProfile(ctx, req.(*UnmatchProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetIncomingLikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncomingLikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetIncomingLikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetIncomingLikes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetIncomingLikes(ctx, req.(*GetIncomingLikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfilesService_SearchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchProfileRequest)
 	if err := dec(in); err != nil {
@@ -469,6 +675,24 @@ func _ProfilesService_GetRecommendations_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfilesService_GetRecommendationsPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetRecommendationsPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetRecommendationsPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetRecommendationsPage(ctx, req.(*GetRecommendationsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfilesService_ServiceDesc is the grpc.ServiceDesc for ProfilesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -488,6 +712,10 @@ var ProfilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _ProfilesService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "SetProfileCoordinates",
+			Handler:    _ProfilesService_SetProfileCoordinates_Handler,
+		},
 		{
 			MethodName: "DeleteProfile",
 			Handler:    _ProfilesService_DeleteProfile_Handler,
@@ -508,6 +736,14 @@ var ProfilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteImage",
 			Handler:    _ProfilesService_DeleteImage_Handler,
 		},
+		{
+			MethodName: "ReorderProfileImages",
+			Handler:    _ProfilesService_ReorderProfileImages_Handler,
+		},
+		{
+			MethodName: "SetPrimaryProfileImage",
+			Handler:    _ProfilesService_SetPrimaryProfileImage_Handler,
+		},
 		{
 			MethodName: "GetProfileMatches",
 			Handler:    _ProfilesService_GetProfileMatches_Handler,
@@ -516,6 +752,18 @@ var ProfilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProfileLike",
 			Handler:    _ProfilesService_SetProfileLike_Handler,
 		},
+		{
+			MethodName: "RewindProfileLike",
+			Handler:    _ProfilesService_RewindProfileLike_Handler,
+		},
+		{
+			MethodName: "UnmatchProfile",
+			Handler:    _ProfilesService_UnmatchProfile_Handler,
+		},
+		{
+			MethodName: "GetIncomingLikes",
+			Handler:    _ProfilesService_GetIncomingLikes_Handler,
+		},
 		{
 			MethodName: "SearchProfile",
 			Handler:    _ProfilesService_SearchProfile_Handler,
@@ -528,6 +776,10 @@ var ProfilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecommendations",
 			Handler:    _ProfilesService_GetRecommendations_Handler,
 		},
+		{
+			MethodName: "GetRecommendationsPage",
+			Handler:    _ProfilesService_GetRecommendationsPage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profiles.proto",
@@ -0,0 +1,102 @@
+package geo
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+const (
+	earthRadiusKm = 6371.0
+
+	// Positions are stored on a 0.01° grid, roughly a kilometre, so the
+	// database never holds a profile's exact position.
+	gridStep = 0.01
+)
+
+// cities.csv is a small gazetteer of country,city,latitude,longitude rows.
+// Every city is listed under both its English and its Russian name.
+//
+//go:embed cities.csv
+var citiesCSV string
+
+var gazetteer = loadGazetteer(citiesCSV)
+
+func loadGazetteer(data string) map[string]model.Coordinates {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		panic("geo: malformed gazetteer: " + err.Error())
+	}
+
+	cities := make(map[string]model.Coordinates, len(records))
+	for _, r := range records[1:] {
+		lat, latErr := strconv.ParseFloat(r[2], 64)
+		lon, lonErr := strconv.ParseFloat(r[3], 64)
+		if latErr != nil || lonErr != nil {
+			panic("geo: malformed gazetteer row: " + strings.Join(r, ","))
+		}
+		cities[cityKey(r[0], r[1])] = model.Coordinates{Latitude: lat, Longitude: lon}
+	}
+	return cities
+}
+
+func cityKey(country, city string) string {
+	return strings.ToLower(strings.TrimSpace(country)) + "@" + strings.ToLower(strings.TrimSpace(city))
+}
+
+// Lookup returns the coordinates of a city from the bundled gazetteer.
+func Lookup(country, city string) (model.Coordinates, bool) {
+	c, ok := gazetteer[cityKey(country, city)]
+	return c, ok
+}
+
+func Valid(c model.Coordinates) bool {
+	return !math.IsNaN(c.Latitude) && !math.IsNaN(c.Longitude) &&
+		c.Latitude >= -90 && c.Latitude <= 90 &&
+		c.Longitude >= -180 && c.Longitude <= 180
+}
+
+// Snap rounds a position to the storage grid.
+func Snap(c model.Coordinates) model.Coordinates {
+	return model.Coordinates{
+		Latitude:  math.Round(c.Latitude/gridStep) * gridStep,
+		Longitude: math.Round(c.Longitude/gridStep) * gridStep,
+	}
+}
+
+// DistanceKm is the great-circle distance between two points.
+func DistanceKm(a, b model.Coordinates) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(b.Longitude - a.Longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// ApproxKm turns an exact distance into what a profile card may show: never
+// less than 2 km, whole kilometres up to 10, then steps of 5 and 10 km, so
+// repeated queries cannot be used to pin somebody down. The approx join of
+// the profile queries rounds the same way; keep the two in step.
+func ApproxKm(km float64) int {
+	switch {
+	case km < 0 || math.IsNaN(km):
+		return 0
+	case km <= 2:
+		return 2
+	case km <= 10:
+		return int(math.Ceil(km))
+	case km <= 50:
+		return int(math.Ceil(km/5)) * 5
+	default:
+		return int(math.Ceil(km/10)) * 10
+	}
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -7,6 +7,52 @@ import (
 
 var PageSize = 10
 var SearchLimit = 20
+var IncomingLikesLimit = 50
+var RecommendationsLimit = 50
+var RecommendationPoolSize = 500
+
+// MutualFeedFilter hides feed profiles whose own preferences the viewer
+// does not satisfy.
+var MutualFeedFilter = true
+
+// Sort modes of SearchProfileRequest.SortBy. The empty mode orders by
+// profile id.
+const (
+	SortByDistance  = "distance"
+	SortByRelevance = "relevance"
+	SortByYoungest  = "age"
+	SortByOldest    = "age_desc"
+	SortByNewest    = "newest"
+)
+
+const (
+	SearchPageSize    = 20
+	MaxSearchPageSize = 50
+)
+
+const (
+	MaxPhotosFree    = 5
+	MaxPhotosPremium = 10
+
+	MaxImagePixels   = 40_000_000
+	PhotoContentType = "image/jpeg"
+
+	VariantThumb = "thumb"
+	VariantCard  = "card"
+	VariantFull  = "full"
+)
+
+type ImageVariant struct {
+	Name    string
+	MaxSide int
+	Quality int
+}
+
+var PhotoVariants = []ImageVariant{
+	{Name: VariantThumb, MaxSide: 160, Quality: 80},
+	{Name: VariantCard, MaxSide: 600, Quality: 85},
+	{Name: VariantFull, MaxSide: 1600, Quality: 90},
+}
 
 type Preference struct {
 	Description string `yaml:"preference_description" json:"preference_description"`
@@ -28,7 +74,9 @@ type Profile struct {
 	Preferences []Preference `yaml:"preferences" json:"preferences"`
 	Parameters  []Preference `yaml:"parameters" json:"parameters"`
 	Photos      []string     `yaml:"photos" json:"photos"`
-	Premium     struct {
+	// Distance is the approximate distance to the viewer in km, 0 if unknown.
+	Distance int `yaml:"distance" json:"distance,omitempty"`
+	Premium  struct {
 		Status bool `yaml:"Status" json:"Status"`
 		Border int  `yaml:"Border" json:"Border"`
 	}
@@ -45,6 +93,26 @@ type SearchProfileRequest struct {
 	Preferences []Preference `yaml:"preferences" json:"preferences"`
 	Country     string       `json:"country"`
 	City        string       `json:"city"`
+	Mutual      bool         `json:"mutual"`
+	// MaxDistanceKm of 0 means any distance.
+	MaxDistanceKm int    `json:"maxDistanceKm"`
+	SortBy        string `json:"sortBy"`
+	// InterestsAll must all be present, at least one of InterestsAny.
+	InterestsAll []string `json:"interestsAll"`
+	InterestsAny []string `json:"interestsAny"`
+	// Cursor is the NextCursor of the previous page, empty for the first.
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+	// CreatedAfter limits the search to newer profiles; zero means any.
+	CreatedAfter time.Time `json:"-"`
+}
+
+type SearchPage struct {
+	Profiles   []FoundProfile
+	NextCursor string
+	// Total counts every match at the time of the query, so it may drift
+	// while the user pages through the results.
+	Total int
 }
 
 type FoundProfile struct {
@@ -53,6 +121,7 @@ type FoundProfile struct {
 	Fullname string `json:"fullname"`
 	Age      int    `json:"age"`
 	Goal     int    `yaml:"goal" json:"goal"`
+	Distance int    `json:"distance,omitempty"`
 }
 
 type ProfileStats struct {
@@ -65,9 +134,58 @@ type ProfileStats struct {
 	ChatCount          int `json:"chatCount"`
 }
 
+// IncomingLike is a like or superlike the profile has not answered yet.
+type IncomingLike struct {
+	LikeID    int
+	Profile   Profile
+	Status    int
+	CreatedAt time.Time
+}
+
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// RankingFeatures is what the recommendation ranker knows about a profile.
+// Zero values mean the signal is unknown.
+type RankingFeatures struct {
+	ProfileID   int
+	IsMale      bool
+	Goal        int
+	Height      int
+	Birthday    time.Time
+	Country     string
+	City        string
+	LastActive  time.Time
+	Interests   []string
+	Preferences []Preference
+	Parameters  []Preference
+}
+
+// RewoundLike describes a swipe removed by ProfileRepo.RewindLike.
+type RewoundLike struct {
+	LikedProfileID int
+	Status         int
+	MatchRemoved   bool
+	CreatedAt      time.Time
+}
+
 var (
 	ErrInvalidUserRepoConfig = errors.New("invalid user repository config")
 	ErrProfileNotFound       = errors.New("profile not found")
 	ErrInvalidProfile        = errors.New("invalid profile")
 	ErrDeleteProfile         = errors.New("failed to delete profile")
+	ErrUnsupportedImage      = errors.New("unsupported image format")
+	ErrImageTooLarge         = errors.New("image dimensions are too large")
+	ErrPhotoLimitReached     = errors.New("photo limit reached")
+	ErrPhotoNotFound         = errors.New("photo not found")
+	ErrInvalidPhotoOrder     = errors.New("photo order must list every photo exactly once")
+	ErrNothingToRewind       = errors.New("no swipe to rewind")
+	ErrRewindEndedMatch      = errors.New("the swipe ended a match and cannot be rewound")
+	ErrInvalidCoordinates    = errors.New("invalid coordinates")
+	ErrNotMatched            = errors.New("profiles are not matched")
+	ErrInvalidCursor         = errors.New("invalid cursor")
+	ErrBlocked               = errors.New("one of the profiles has blocked the other")
+	ErrUnmatched             = errors.New("profiles have unmatched")
 )
@@ -0,0 +1,266 @@
+package ranking
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+const (
+	ReasonInterests   = "shared_interests"
+	ReasonPreferences = "preferences_match"
+	ReasonGoal        = "same_goal"
+	ReasonAge         = "similar_age"
+	ReasonHeight      = "height_match"
+	ReasonCity        = "same_city"
+	ReasonCountry     = "same_country"
+	ReasonActive      = "recently_active"
+
+	MaxReasons = 3
+
+	// a signal has to score at least this much to be named as a reason
+	reasonThreshold = 0.5
+
+	ageSpanYears   = 15.0
+	heightSpanCm   = 40.0
+	activityHalfAt = 7 * 24 * time.Hour
+)
+
+type Weights struct {
+	Interests   float64
+	Preferences float64
+	Goal        float64
+	Age         float64
+	Location    float64
+	Activity    float64
+	Height      float64
+}
+
+var DefaultWeights = Weights{
+	Interests:   0.25,
+	Preferences: 0.20,
+	Goal:        0.15,
+	Age:         0.15,
+	Location:    0.10,
+	Activity:    0.10,
+	Height:      0.05,
+}
+
+type Scored struct {
+	ProfileID  int
+	Score      float64
+	Reasons    []string
+	lastActive time.Time
+}
+
+type signal struct {
+	weight float64
+	value  float64
+	reason string
+}
+
+// Score rates how well candidate fits viewer on a 0..1 scale. Signals that
+// are unknown for either side are left out and the remaining weights are
+// renormalised, so sparse profiles still get a score. The result is then
+// damped by the share of weight that was actually known, which keeps a
+// profile with a single lucky signal from outranking a well-described one.
+func (w Weights) Score(viewer, candidate model.RankingFeatures, now time.Time) Scored {
+	var signals []signal
+	add := func(weight, value float64, reason string, known bool) {
+		if known && weight > 0 {
+			signals = append(signals, signal{weight: weight, value: value, reason: reason})
+		}
+	}
+
+	v, ok := interestOverlap(viewer.Interests, candidate.Interests)
+	add(w.Interests, v, ReasonInterests, ok)
+
+	v, ok = preferenceMatch(viewer, candidate)
+	add(w.Preferences, v, ReasonPreferences, ok)
+
+	add(w.Goal, boolScore(viewer.Goal == candidate.Goal), ReasonGoal,
+		viewer.Goal != 0 && candidate.Goal != 0)
+
+	add(w.Age, closeness(yearsBetween(viewer.Birthday, candidate.Birthday), ageSpanYears), ReasonAge,
+		!viewer.Birthday.IsZero() && !candidate.Birthday.IsZero())
+
+	add(w.Height, closeness(float64(viewer.Height-candidate.Height), heightSpanCm), ReasonHeight,
+		viewer.Height > 0 && candidate.Height > 0)
+
+	v, reason, ok := locationMatch(viewer, candidate)
+	add(w.Location, v, reason, ok)
+
+	add(w.Activity, activity(candidate.LastActive, now), ReasonActive, !candidate.LastActive.IsZero())
+
+	res := Scored{ProfileID: candidate.ProfileID, lastActive: candidate.LastActive}
+	total := w.total()
+	if len(signals) == 0 || total == 0 {
+		return res
+	}
+
+	var known, sum float64
+	for _, s := range signals {
+		known += s.weight
+		sum += s.weight * s.value
+	}
+	coverage := known / total
+	res.Score = sum / known * (0.5 + 0.5*coverage)
+
+	sort.SliceStable(signals, func(i, j int) bool {
+		return signals[i].weight*signals[i].value > signals[j].weight*signals[j].value
+	})
+	for _, s := range signals {
+		if len(res.Reasons) == MaxReasons {
+			break
+		}
+		if s.value >= reasonThreshold {
+			res.Reasons = append(res.Reasons, s.reason)
+		}
+	}
+
+	return res
+}
+
+// Rank scores every candidate and orders them best first. Ties go to the
+// more recently active profile, then to the lower id for a stable order.
+func (w Weights) Rank(viewer model.RankingFeatures, candidates []model.RankingFeatures, now time.Time) []Scored {
+	ranked := make([]Scored, 0, len(candidates))
+	for _, c := range candidates {
+		if c.ProfileID == viewer.ProfileID {
+			continue
+		}
+		ranked = append(ranked, w.Score(viewer, c, now))
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.lastActive.Equal(b.lastActive) {
+			return a.lastActive.After(b.lastActive)
+		}
+		return a.ProfileID < b.ProfileID
+	})
+	return ranked
+}
+
+func Rank(viewer model.RankingFeatures, candidates []model.RankingFeatures, now time.Time) []Scored {
+	return DefaultWeights.Rank(viewer, candidates, now)
+}
+
+func (w Weights) total() float64 {
+	return w.Interests + w.Preferences + w.Goal + w.Age + w.Location + w.Activity + w.Height
+}
+
+// interestOverlap is the share of the smaller interest list found in the
+// other one, so a profile with two interests is not punished for it.
+func interestOverlap(a, b []string) (float64, bool) {
+	if len(a) == 0 || len(b) == 0 {
+		return 0, false
+	}
+
+	set := make(map[string]struct{}, len(a))
+	for _, i := range a {
+		set[strings.ToLower(i)] = struct{}{}
+	}
+	shared := 0
+	seen := make(map[string]struct{}, len(b))
+	for _, i := range b {
+		key := strings.ToLower(i)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		if _, ok := set[key]; ok {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(min(len(set), len(seen))), true
+}
+
+// preferenceMatch checks the viewer's preferences against the candidate's
+// parameters and the other way round. Preferences about a parameter the
+// other side never filled in are skipped.
+func preferenceMatch(viewer, candidate model.RankingFeatures) (float64, bool) {
+	var sum float64
+	directions := 0
+	for _, pair := range [][2][]model.Preference{
+		{viewer.Preferences, candidate.Parameters},
+		{candidate.Preferences, viewer.Parameters},
+	} {
+		if v, ok := satisfied(pair[0], pair[1]); ok {
+			sum += v
+			directions++
+		}
+	}
+	if directions == 0 {
+		return 0, false
+	}
+	return sum / float64(directions), true
+}
+
+func satisfied(prefs, params []model.Preference) (float64, bool) {
+	values := make(map[string]string, len(params))
+	for _, p := range params {
+		values[p.Description] = p.Value
+	}
+
+	asked, met := 0, 0
+	for _, p := range prefs {
+		value, ok := values[p.Description]
+		if !ok {
+			continue
+		}
+		asked++
+		if strings.EqualFold(value, p.Value) {
+			met++
+		}
+	}
+	if asked == 0 {
+		return 0, false
+	}
+	return float64(met) / float64(asked), true
+}
+
+func locationMatch(viewer, candidate model.RankingFeatures) (float64, string, bool) {
+	if viewer.Country == "" || candidate.Country == "" {
+		return 0, "", false
+	}
+	if !strings.EqualFold(viewer.Country, candidate.Country) {
+		return 0, ReasonCountry, true
+	}
+	if viewer.City != "" && strings.EqualFold(viewer.City, candidate.City) {
+		return 1, ReasonCity, true
+	}
+	return 0.5, ReasonCountry, true
+}
+
+// activity halves every activityHalfAt since the candidate was last seen.
+func activity(lastActive, now time.Time) float64 {
+	idle := now.Sub(lastActive)
+	if idle <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, float64(idle)/float64(activityHalfAt))
+}
+
+func yearsBetween(a, b time.Time) float64 {
+	return math.Abs(a.Sub(b).Hours()) / (24 * 365.25)
+}
+
+// closeness maps a difference to 1 when it is zero and to 0 at span.
+func closeness(diff, span float64) float64 {
+	return math.Max(0, 1-math.Abs(diff)/span)
+}
+
+func boolScore(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -3,14 +3,20 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/geo"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/ranking"
 	"github.com/go-redis/redis/v8"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -20,20 +26,32 @@ import (
 
 type ProfileRepository interface {
 	GetProfileById(userId int) (model.Profile, error)
+	GetProfilesByIds(profileIds []int) (map[int]model.Profile, error)
 	StoreProfile(model.Profile) (int, error)
-	GetProfilesByUserId(forUserId int) ([]model.Profile, error)
+	GetProfilesByUserId(forUserId int, maxDistanceKm int) ([]model.Profile, error)
 	GetMatches(forUserId int) ([]model.Profile, error)
 	UpdateProfile(int, model.Profile) error
+	SetCoordinates(profileId int, pos *model.Coordinates) error
 	GetPhotos(userId int) ([]string, error)
 	DeletePhoto(userId int, url string) error
-	StorePhoto(userId int, url string) error
+	StorePhoto(userId int, url, thumbURL, cardURL string, limit int) error
+	GetPhotoQuota(userId int) (count int, premium bool, err error)
+	ReorderPhotos(userId int, paths []string) error
+	SetPrimaryPhoto(userId int, path string) error
 	DeleteProfile(userId int) error
 	StorePhotos(profileId int, paths []string) error
 	StoreInterests(profileId int, interests []string) error
 	SetLike(from int, to int, status int) (int, error)
-	SearchProfiles(cur_user int, params model.SearchProfileRequest) ([]model.FoundProfile, error)
+	RewindLike(from int, window time.Duration) (model.RewoundLike, error)
+	Unmatch(from int, to int) error
+	GetIncomingLikes(profileId int, cursor int, limit int) ([]model.IncomingLike, error)
+	CountIncomingLikes(profileId int) (int, error)
+	SearchProfiles(cur_user int, params model.SearchProfileRequest) (model.SearchPage, error)
 	GetProfileStats(profileID int) (model.ProfileStats, error)
-	GetRecomendations(profileId int) (model.Profile, error)
+	GetRankingFeatures(profileId int) (model.RankingFeatures, error)
+	GetRecommendationCandidates(profileId int, limit int) ([]model.RankingFeatures, error)
+	SaveRecommendations(profileId int, ranked []ranking.Scored) error
+	GetSavedRecommendations(profileId int) ([]ranking.Scored, bool, error)
 	CloseRepo()
 }
 
@@ -155,14 +173,9 @@ func ClosePostgresConnection(pool *pgxpool.Pool) {
 	}
 }
 
-const GetProfileByIdQuery = `
-WITH base_profile AS (
-    SELECT 
-        profile_id, firstname, lastname, is_male,
-        height, birthday, description, goal, location_id
-    FROM profiles
-    WHERE profile_id = $1
-)
+// profileRowsSelect reads a profile from base_profile as one row per
+// combination of its photos, interests, preferences and parameters.
+const profileRowsSelect = `
 SELECT 
     bp.profile_id,
     bp.firstname,
@@ -194,12 +207,76 @@ LEFT JOIN preferences pr ON pp.preference_id = pr.preference_id
 LEFT JOIN profile_parameter pp2 ON pp2.profile_id = bp.profile_id
 LEFT JOIN parameters param ON pp2.parameter_id = param.parameter_id
 LEFT JOIN likes liked ON liked.liked_profile_id = bp.profile_id
-LEFT JOIN subscriptions sbs ON sbs.user_id = bp.profile_id AND sbs.expires_at > NOW();
+    AND NOT EXISTS (
+        SELECT 1 FROM profile_blocks pb
+        WHERE (pb.blocker_id = bp.profile_id AND pb.blocked_id = liked.profile_id)
+           OR (pb.blocker_id = liked.profile_id AND pb.blocked_id = bp.profile_id)
+    )
+LEFT JOIN subscriptions sbs ON sbs.user_id = bp.profile_id AND sbs.expires_at > NOW()
+`
+
+const GetProfileByIdQuery = `
+WITH base_profile AS (
+    SELECT 
+        profile_id, firstname, lastname, is_male,
+        height, birthday, description, goal, location_id
+    FROM profiles
+    WHERE profile_id = $1
+)` + profileRowsSelect + `ORDER BY s.is_primary DESC, s.position, s.id;
 
 `
 
+const GetProfilesByIdsQuery = `
+WITH base_profile AS (
+    SELECT 
+        profile_id, firstname, lastname, is_male,
+        height, birthday, description, goal, location_id
+    FROM profiles
+    WHERE profile_id = ANY($1)
+)` + profileRowsSelect + `ORDER BY bp.profile_id, s.is_primary DESC, s.position, s.id;
+`
+
 func (pr *ProfileRepo) GetProfileById(profileId int) (model.Profile, error) {
-	var profile model.Profile
+	rows, err := pr.DB.Query(context.Background(), GetProfileByIdQuery, profileId)
+	if err != nil {
+		return model.Profile{}, err
+	}
+	defer rows.Close()
+
+	profiles, err := scanProfileRows(rows)
+	if err != nil || len(profiles) == 0 {
+		return model.Profile{}, err
+	}
+	return *profiles[0], nil
+}
+
+// GetProfilesByIds loads several profiles in one query, keyed by profile id.
+// Ids without a profile are left out.
+func (pr *ProfileRepo) GetProfilesByIds(profileIds []int) (map[int]model.Profile, error) {
+	byID := make(map[int]model.Profile, len(profileIds))
+	if len(profileIds) == 0 {
+		return byID, nil
+	}
+
+	rows, err := pr.DB.Query(context.Background(), GetProfilesByIdsQuery, profileIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	profiles, err := scanProfileRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		byID[profile.ProfileId] = *profile
+	}
+	return byID, nil
+}
+
+// scanProfileRows folds the rows of profileRowsSelect into profiles, in the
+// order their first row came.
+func scanProfileRows(rows pgx.Rows) ([]*model.Profile, error) {
 	var birth sql.NullTime
 	var interest sql.NullString
 	var preferenceDesc sql.NullString
@@ -214,23 +291,18 @@ func (pr *ProfileRepo) GetProfileById(profileId int) (model.Profile, error) {
 	var premiumStatus sql.NullBool
 	var premiumBorder sql.NullInt64
 
-	ctx := context.Background()
-	rows, err := pr.DB.Query(ctx, GetProfileByIdQuery, profileId)
-
-	if err != nil {
-		return profile, err
-	}
-	defer rows.Close()
-
+	profiles := []*model.Profile{}
+	byID := map[int]*model.Profile{}
 	for rows.Next() {
+		var row model.Profile
 		if err := rows.Scan(
-			&profile.ProfileId,
-			&profile.FirstName,
-			&profile.LastName,
-			&profile.IsMale,
-			&profile.Height,
+			&row.ProfileId,
+			&row.FirstName,
+			&row.LastName,
+			&row.IsMale,
+			&row.Height,
 			&birth,
-			&profile.Description,
+			&row.Description,
 			&goal,
 			&country,
 			&city,
@@ -245,7 +317,14 @@ func (pr *ProfileRepo) GetProfileById(profileId int) (model.Profile, error) {
 			&premiumStatus,
 			&premiumBorder,
 		); err != nil {
-			return profile, err
+			return nil, err
+		}
+
+		profile, ok := byID[row.ProfileId]
+		if !ok {
+			profile = &row
+			byID[row.ProfileId] = profile
+			profiles = append(profiles, profile)
 		}
 
 		if birth.Valid {
@@ -298,10 +377,10 @@ func (pr *ProfileRepo) GetProfileById(profileId int) (model.Profile, error) {
 	}
 
 	if rows.Err() != nil {
-		return profile, rows.Err()
+		return nil, rows.Err()
 	}
 
-	return profile, nil
+	return profiles, nil
 }
 
 const CreateProfileQuery = `
@@ -328,7 +407,8 @@ func (pr *ProfileRepo) StoreProfile(profile model.Profile) (profileId int, err e
 		var id int
 		err := pr.DB.QueryRow(ctx, GetLocationID, country, city, district).Scan(&id)
 		if err != nil {
-			err = pr.DB.QueryRow(ctx, InsertLocation, country, city, district).Scan(&id)
+			lat, lon := cityCoordinates(country, city)
+			err = pr.DB.QueryRow(ctx, InsertLocation, country, city, district, lat, lon).Scan(&id)
 			if err != nil {
 				return 0, fmt.Errorf("failed to insert/get location: %w", err)
 			}
@@ -352,18 +432,107 @@ func (pr *ProfileRepo) StoreProfile(profile model.Profile) (profileId int, err e
 	return
 }
 
+// mutualPreferenceFilter keeps candidate p only if viewer $1 satisfies the
+// candidate's own preferences. Preferences are grouped by description: any
+// value of a group will do, and every group has to be met by the viewer's
+// parameter of the same description. A group about a parameter the viewer
+// never filled in is not held against them.
+const mutualPreferenceFilter = `
+NOT EXISTS (
+    SELECT 1
+    FROM profile_preferences cpp
+    JOIN preferences cpr ON cpr.preference_id = cpp.preference_id
+    WHERE cpp.profile_id = p.profile_id
+      AND EXISTS (
+          SELECT 1 FROM profile_parameter vpp
+          JOIN parameters vpa ON vpa.parameter_id = vpp.parameter_id
+          WHERE vpp.profile_id = $1
+            AND vpa.parameter_description = cpr.preference_description
+      )
+    GROUP BY cpr.preference_description
+    HAVING NOT bool_or(EXISTS (
+        SELECT 1 FROM profile_parameter vpp
+        JOIN parameters vpa ON vpa.parameter_id = vpp.parameter_id
+        WHERE vpp.profile_id = $1
+          AND vpa.parameter_description = cpr.preference_description
+          AND vpa.parameter_value = cpr.preference_value
+    ))
+)`
+
+// notUnmatched drops candidate p if it and viewer $1 have ever unmatched.
+const notUnmatched = `NOT EXISTS (
+        SELECT 1 FROM unmatches um
+        WHERE um.first_profile_id = LEAST(p.profile_id, $1)
+          AND um.second_profile_id = GREATEST(p.profile_id, $1)
+    )`
+
+// notBlocked drops candidate p if it or viewer $1 has blocked the other.
+const notBlocked = `NOT EXISTS (
+        SELECT 1 FROM profile_blocks pb
+        WHERE (pb.blocker_id = $1 AND pb.blocked_id = p.profile_id)
+           OR (pb.blocker_id = p.profile_id AND pb.blocked_id = $1)
+    )`
+
+// viewerPosition and candidateDistance work out how far candidate p is from
+// viewer $1. A position set by the client wins over the coordinates of the
+// city the profile is in; the distance is NULL when either side is unknown.
+// approx.km is the distance rounded the way geo.ApproxKm shows it; anything
+// that leaves the server or is driven by the client, such as a page cursor
+// or the radius filter, is built from it.
+const (
+	viewerPosition = `
+viewer AS (
+    SELECT
+        CASE WHEN vp.latitude IS NOT NULL THEN vp.latitude ELSE vl.latitude END AS lat,
+        CASE WHEN vp.latitude IS NOT NULL THEN vp.longitude ELSE vl.longitude END AS lon
+    FROM profiles vp
+    LEFT JOIN locations vl ON vl.location_id = vp.location_id
+    WHERE vp.profile_id = $1
+)`
+
+	candidateDistance = `
+LEFT JOIN locations cl ON cl.location_id = p.location_id
+LEFT JOIN viewer v ON TRUE
+LEFT JOIN LATERAL (
+    SELECT
+        CASE WHEN p.latitude IS NOT NULL THEN p.latitude ELSE cl.latitude END AS lat,
+        CASE WHEN p.latitude IS NOT NULL THEN p.longitude ELSE cl.longitude END AS lon
+) cp ON TRUE
+LEFT JOIN LATERAL (
+    SELECT 12742 * ASIN(LEAST(1, SQRT(
+        POWER(SIN(RADIANS(cp.lat - v.lat) / 2), 2) +
+        COS(RADIANS(v.lat)) * COS(RADIANS(cp.lat)) * POWER(SIN(RADIANS(cp.lon - v.lon) / 2), 2)
+    ))) AS km
+) dist ON TRUE
+LEFT JOIN LATERAL (
+    SELECT (CASE
+        WHEN dist.km <= 2 THEN 2
+        WHEN dist.km <= 10 THEN CEIL(dist.km)
+        WHEN dist.km <= 50 THEN CEIL(dist.km / 5) * 5
+        ELSE CEIL(dist.km / 10) * 10
+    END)::float8 AS km
+) approx ON TRUE`
+)
+
 const GetProfilesQuery = `
-WITH filtered_profiles AS (
-    SELECT p.profile_id
+WITH ` + viewerPosition + `,
+-- the feed pages nearest first by (feed_key, profile_id); feed_key is the
+-- rounded distance, with unknown distances last
+filtered_profiles AS (
+    SELECT p.profile_id, dist.km, COALESCE(approx.km, 'Infinity'::float8) AS feed_key
     FROM profiles p
     LEFT JOIN likes liked 
         ON liked.liked_profile_id = p.profile_id AND liked.profile_id = $1
-    JOIN users u ON u.profile_id = p.profile_id
+    JOIN users u ON u.profile_id = p.profile_id` + candidateDistance + `
     WHERE p.profile_id != $1
       AND liked.profile_id IS NULL
       AND u.user_id NOT IN (SELECT user_id FROM blacklist)
-      AND ($2 = 0 OR p.profile_id > $2)
-    ORDER BY p.profile_id
+      AND ` + notUnmatched + `
+      AND ` + notBlocked + `
+      AND ($2 = 0 OR (COALESCE(approx.km, 'Infinity'::float8), p.profile_id) > ($5::float8, $2))
+      AND ($4 = 0 OR approx.km <= $4)
+      AND (NOT $6 OR ` + mutualPreferenceFilter + `)
+    ORDER BY feed_key, p.profile_id
     LIMIT $3
 )
 SELECT DISTINCT ON (p.profile_id)
@@ -378,18 +547,20 @@ SELECT DISTINCT ON (p.profile_id)
     l.country, 
     l.city,
     l.district,
-    s.path AS avatar,
+    COALESCE(s.thumb_path, s.path) AS avatar,
     i.description AS interest,
     pr.preference_description,
     pr.preference_value,
     param.parameter_description,
     param.parameter_value,
     CASE WHEN sbs.sub_id IS NOT NULL THEN TRUE ELSE FALSE END AS premium_status,
-    sbs.border
+    sbs.border,
+    fp.km,
+    fp.feed_key
 FROM filtered_profiles fp
 JOIN profiles p ON p.profile_id = fp.profile_id
 LEFT JOIN locations l ON p.location_id = l.location_id
-LEFT JOIN "static" s ON p.profile_id = s.profile_id
+LEFT JOIN "static" s ON p.profile_id = s.profile_id AND s.is_primary
 LEFT JOIN profile_interests pi ON pi.profile_id = p.profile_id
 LEFT JOIN interests i ON pi.interest_id = i.interest_id
 LEFT JOIN profile_preferences pp ON pp.profile_id = p.profile_id
@@ -401,32 +572,59 @@ ORDER BY p.profile_id, i.description NULLS LAST;
 
 `
 
-func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, error) {
-	const redisKeyFormat = "profiles_for_user:%d"
-	redisKey := fmt.Sprintf(redisKeyFormat, forUserId)
+// GetProfilesByUserId returns the next feed page, nearest profiles first.
+// maxDistanceKm of 0 disables the radius filter. Each radius keeps its own
+// position in the feed, so switching the filter does not skip profiles.
+// With MutualFeedFilter, profiles whose preferences the viewer does not meet
+// are left out.
+func (pr *ProfileRepo) GetProfilesByUserId(forUserId int, maxDistanceKm int) ([]model.Profile, error) {
+	const redisKeyFormat = "profiles_for_user:%d:%d"
+	redisKey := fmt.Sprintf(redisKeyFormat, forUserId, maxDistanceKm)
 
+	var lastKey float64
 	var lastSeenID int
 	ctx := context.Background()
 
 	result, err := pr.Client.Get(ctx, redisKey).Result()
-	lastSeenID = 0
 	if err == nil {
-		if id, convErr := strconv.Atoi(result); convErr == nil {
-			lastSeenID = id
+		if key, id, decErr := DecodeSearchCursor(result); decErr == nil {
+			lastKey, lastSeenID = key, id
 		}
 	} else if err != redis.Nil {
 		return nil, err
 	}
 
-	rows, err := pr.DB.Query(ctx, GetProfilesQuery, forUserId, lastSeenID, model.PageSize)
+	profiles, feedKeys, err := pr.getFeedPage(forUserId, lastSeenID, lastKey, maxDistanceKm)
 	if err != nil {
 		return nil, err
 	}
+
+	if len(profiles) > 0 {
+		last := profiles[len(profiles)-1].ProfileId
+		cursor := EncodeSearchCursor(feedKeys[last], last)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			_ = pr.Client.Set(ctx, redisKey, cursor, 10*time.Minute).Err()
+		}()
+	}
+
+	return profiles, nil
+}
+
+// getFeedPage reads one page of the feed after the (lastKey, lastSeenID)
+// position, in feed order, with the feed key of every profile.
+func (pr *ProfileRepo) getFeedPage(forUserId, lastSeenID int, lastKey float64, maxDistanceKm int) ([]model.Profile, map[int]float64, error) {
+	rows, err := pr.DB.Query(context.Background(), GetProfilesQuery,
+		forUserId, lastSeenID, model.PageSize, maxDistanceKm, lastKey, model.MutualFeedFilter,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
 	defer rows.Close()
 
 	profileMap := make(map[int]*model.Profile)
-
-	var maxProfileID int
+	feedKeys := make(map[int]float64)
 
 	for rows.Next() {
 		var (
@@ -446,6 +644,8 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 			parameterDesc, parameterValue sql.NullString
 			premiumStatus                 sql.NullBool
 			premiumBorder                 sql.NullInt64
+			distance                      sql.NullFloat64
+			feedKey                       float64
 		)
 
 		if err := rows.Scan(
@@ -468,13 +668,12 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 			&parameterValue,
 			&premiumStatus,
 			&premiumBorder,
+			&distance,
+			&feedKey,
 		); err != nil {
-			return nil, err
-		}
-
-		if profileId > maxProfileID {
-			maxProfileID = profileId
+			return nil, nil, err
 		}
+		feedKeys[profileId] = feedKey
 
 		profile, exists := profileMap[profileId]
 		if !exists {
@@ -502,6 +701,10 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 				profile.Location = fmt.Sprintf("%s@%s@%s", country.String, city.String, district.String)
 			}
 
+			if distance.Valid {
+				profile.Distance = geo.ApproxKm(distance.Float64)
+			}
+
 			profileMap[profileId] = profile
 		}
 
@@ -542,31 +745,86 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	profiles := make([]model.Profile, 0, len(profileMap))
 	for _, p := range profileMap {
 		profiles = append(profiles, *p)
 	}
+	sortByFeedKey(profiles, feedKeys)
+	return profiles, feedKeys, nil
+}
 
-	if maxProfileID > 0 {
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-			defer cancel()
-			_ = pr.Client.Set(ctx, redisKey, strconv.Itoa(maxProfileID), 10*time.Minute).Err()
-		}()
+// sortByFeedKey puts profiles in the order the feed pages through them:
+// by rounded distance, unknown distances last, then by profile id.
+func sortByFeedKey(profiles []model.Profile, keys map[int]float64) {
+	sort.Slice(profiles, func(i, j int) bool {
+		ki, kj := keys[profiles[i].ProfileId], keys[profiles[j].ProfileId]
+		if ki != kj {
+			return ki < kj
+		}
+		return profiles[i].ProfileId < profiles[j].ProfileId
+	})
+}
+
+// cityCoordinates looks the city up in the gazetteer; unknown cities get no
+// coordinates rather than a guess.
+func cityCoordinates(country, city string) (lat, lon *float64) {
+	c, ok := geo.Lookup(country, city)
+	if !ok {
+		return nil, nil
 	}
+	return &c.Latitude, &c.Longitude
+}
 
-	return profiles, nil
+const (
+	SetProfileCoordinatesQuery = `
+UPDATE profiles SET latitude = $2, longitude = $3, updated_at = CURRENT_TIMESTAMP
+WHERE profile_id = $1;
+`
+	ClearProfileCoordinatesQuery = `
+UPDATE profiles SET latitude = NULL, longitude = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE profile_id = $1;
+`
+)
+
+// SetCoordinates stores a client reported position, snapped to the storage
+// grid. A nil position falls back to the coordinates of the profile's city.
+func (pr *ProfileRepo) SetCoordinates(profileId int, pos *model.Coordinates) error {
+	var (
+		tag pgconn.CommandTag
+		err error
+	)
+	if pos == nil {
+		tag, err = pr.DB.Exec(context.Background(), ClearProfileCoordinatesQuery, profileId)
+	} else {
+		if !geo.Valid(*pos) {
+			return model.ErrInvalidCoordinates
+		}
+		snapped := geo.Snap(*pos)
+		tag, err = pr.DB.Exec(context.Background(), SetProfileCoordinatesQuery, profileId, snapped.Latitude, snapped.Longitude)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set coordinates: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrProfileNotFound
+	}
+	return nil
 }
 
 const GetMatches = `
 SELECT 
     profile_id, 
     matched_profile_id
-FROM matches
-WHERE profile_id = $1 OR matched_profile_id = $1;
+FROM matches m
+WHERE (profile_id = $1 OR matched_profile_id = $1)
+  AND NOT EXISTS (
+      SELECT 1 FROM profile_blocks pb
+      WHERE (pb.blocker_id = m.profile_id AND pb.blocked_id = m.matched_profile_id)
+         OR (pb.blocker_id = m.matched_profile_id AND pb.blocked_id = m.profile_id)
+  );
 `
 
 func (pr *ProfileRepo) GetMatches(forUserId int) ([]model.Profile, error) {
@@ -658,8 +916,8 @@ ON CONFLICT DO NOTHING
 `
 
 	InsertStaticPhoto = `
-INSERT INTO static (profile_id, path)
-VALUES ($1, $2)
+INSERT INTO static (profile_id, path, position, is_primary)
+VALUES ($1, $2, $3, $4)
 `
 	InsertInterestIfNotExists = `
 INSERT INTO interests (description)
@@ -672,8 +930,8 @@ WHERE country = $1 AND city = $2 AND district = $3
 `
 
 	InsertLocation = `
-INSERT INTO locations (country, city, district)
-VALUES ($1, $2, $3)
+INSERT INTO locations (country, city, district, latitude, longitude)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING location_id			
 `
 
@@ -718,7 +976,8 @@ func (pr *ProfileRepo) UpdateProfile(profileID int, newProfile model.Profile) er
 
 		err := tx.QueryRow(ctx, GetLocationID, country, city, district).Scan(&locationID)
 		if err != nil {
-			err = tx.QueryRow(ctx, InsertLocation, country, city, district).Scan(&locationID)
+			lat, lon := cityCoordinates(country, city)
+			err = tx.QueryRow(ctx, InsertLocation, country, city, district, lat, lon).Scan(&locationID)
 			if err != nil {
 				return fmt.Errorf("failed to insert or get location: %w", err)
 			}
@@ -821,7 +1080,8 @@ const GetPhotoPathsQuery = `
 SELECT path FROM static 
 WHERE profile_id = (
 	SELECT profile_id FROM users WHERE user_id = $1
-);
+)
+ORDER BY is_primary DESC, position, id;
 `
 
 func (pr *ProfileRepo) GetPhotos(userID int) ([]string, error) {
@@ -850,6 +1110,15 @@ func (pr *ProfileRepo) GetPhotos(userID int) ([]string, error) {
 const (
 	DeleteStaticQuery = `
 	DELETE FROM "static" WHERE profile_id = $1 AND path = $2;
+`
+	PromotePrimaryPhotoQuery = `
+	UPDATE "static" SET is_primary = TRUE, updated_at = CURRENT_TIMESTAMP
+	WHERE id = (
+		SELECT id FROM "static" WHERE profile_id = $1 ORDER BY position, id LIMIT 1
+	)
+	AND NOT EXISTS (
+		SELECT 1 FROM "static" WHERE profile_id = $1 AND is_primary
+	);
 `
 )
 
@@ -863,18 +1132,163 @@ func (pr *ProfileRepo) DeletePhoto(profileID int, url string) error {
 		return fmt.Errorf("no photo found to delete")
 	}
 
+	_, err = pr.DB.Exec(context.Background(), PromotePrimaryPhotoQuery, profileID)
+	if err != nil {
+		return fmt.Errorf("error promoting primary photo: %w", err)
+	}
+
 	return nil
 }
 
-const UploadPhotoQuery = `
-INSERT INTO static (profile_id, path, created_at, updated_at)
-VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+const (
+	// LockProfilePhotosQuery serializes uploads of one profile, so two of
+	// them cannot both pass the photo limit or both become primary.
+	LockProfilePhotosQuery = `
+SELECT profile_id FROM profiles WHERE profile_id = $1 FOR UPDATE;
+`
+	UploadPhotoQuery = `
+INSERT INTO static (profile_id, path, thumb_path, card_path, position, is_primary, created_at, updated_at)
+SELECT $1, $2, $3, $4,
+    COALESCE((SELECT MAX(position) + 1 FROM static WHERE profile_id = $1), 0),
+    NOT EXISTS (SELECT 1 FROM static WHERE profile_id = $1 AND is_primary),
+    CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+WHERE (SELECT COUNT(*) FROM static WHERE profile_id = $1) < $5
 RETURNING profile_id, path, created_at;
 `
+)
 
-func (pr *ProfileRepo) StorePhoto(userID int, url string) error {
-	_, err := pr.DB.Exec(context.Background(), UploadPhotoQuery, userID, url)
-	return err
+func (pr *ProfileRepo) StorePhoto(userID int, url, thumbURL, cardURL string, limit int) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var profileID int
+	err = tx.QueryRow(ctx, LockProfilePhotosQuery, userID).Scan(&profileID)
+	if err == pgx.ErrNoRows {
+		return model.ErrProfileNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	cmdTag, err := tx.Exec(ctx, UploadPhotoQuery, userID, url, thumbURL, cardURL, limit)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return model.ErrPhotoLimitReached
+	}
+
+	return tx.Commit(ctx)
+}
+
+const GetPhotoQuotaQuery = `
+SELECT
+    (SELECT COUNT(*) FROM static WHERE profile_id = $1),
+    EXISTS (SELECT 1 FROM subscriptions WHERE user_id = $1 AND expires_at > NOW());
+`
+
+func (pr *ProfileRepo) GetPhotoQuota(userID int) (int, bool, error) {
+	var count int
+	var premium bool
+	err := pr.DB.QueryRow(context.Background(), GetPhotoQuotaQuery, userID).Scan(&count, &premium)
+	return count, premium, err
+}
+
+const (
+	GetProfilePhotoPathsQuery = `
+	SELECT path FROM static WHERE profile_id = $1;
+`
+	UpdatePhotoPositionQuery = `
+	UPDATE static SET position = $3, updated_at = CURRENT_TIMESTAMP
+	WHERE profile_id = $1 AND path = $2;
+`
+	// The unique index on primary photos is checked row by row, so the old
+	// primary is cleared before the new one is set.
+	ClearPrimaryPhotoQuery = `
+	UPDATE static SET is_primary = FALSE, updated_at = CURRENT_TIMESTAMP
+	WHERE profile_id = $1 AND is_primary AND path <> $2;
+`
+	SetPrimaryPhotoQuery = `
+	UPDATE static SET is_primary = TRUE, updated_at = CURRENT_TIMESTAMP
+	WHERE profile_id = $1 AND path = $2;
+`
+)
+
+func (pr *ProfileRepo) ReorderPhotos(userID int, paths []string) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, GetProfilePhotoPathsQuery, userID)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			rows.Close()
+			return err
+		}
+		existing[path] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(paths) != len(existing) {
+		return model.ErrInvalidPhotoOrder
+	}
+	for _, path := range paths {
+		if !existing[path] {
+			return model.ErrInvalidPhotoOrder
+		}
+		delete(existing, path)
+	}
+
+	for position, path := range paths {
+		if _, err := tx.Exec(ctx, UpdatePhotoPositionQuery, userID, path, position); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (pr *ProfileRepo) SetPrimaryPhoto(userID int, path string) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, ClearPrimaryPhotoQuery, userID, path); err != nil {
+		return err
+	}
+
+	cmdTag, err := tx.Exec(ctx, SetPrimaryPhotoQuery, userID, path)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return model.ErrPhotoNotFound
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (pr *ProfileRepo) CloseRepo() {
@@ -895,11 +1309,28 @@ const (
 	ON CONFLICT (profile_id, liked_profile_id)
 	DO UPDATE SET
     	status = EXCLUDED.status,
-    	created_at = CURRENT_TIMESTAMP
+    	created_at = CURRENT_TIMESTAMP,
+    	created_match = FALSE,
+    	removed_match = FALSE,
+    	implied_by = NULL
 	RETURNING like_id;
 
 	`
 
+	CreateImpliedLikeQuery = `
+	INSERT INTO likes (profile_id, liked_profile_id, created_at, status, implied_by)
+	VALUES ($1, $2, CURRENT_TIMESTAMP, $3, $4)
+	ON CONFLICT (profile_id, liked_profile_id) DO NOTHING;
+	`
+
+	MarkLikeMatchedQuery = `
+	UPDATE likes SET created_match = TRUE WHERE like_id = $1;
+	`
+
+	MarkLikeRemovedMatchQuery = `
+	UPDATE likes SET removed_match = TRUE WHERE like_id = $1;
+	`
+
 	CreateMatchQuery = `
 	INSERT INTO matches (profile_id, matched_profile_id, created_at)
 	VALUES ($1, $2, CURRENT_TIMESTAMP)
@@ -907,11 +1338,52 @@ const (
 	DeleteMatchQuery = `DELETE FROM matches WHERE 
 				(profile_id = $1 AND matched_profile_id = $2) OR 
 				(profile_id = $2 AND matched_profile_id = $1)`
+
+	IsPairBlockedQuery = `
+	SELECT EXISTS (
+		SELECT 1 FROM profile_blocks
+		WHERE (blocker_id = $1 AND blocked_id = $2)
+		   OR (blocker_id = $2 AND blocked_id = $1)
+	);
+	`
+
+	IsPairUnmatchedQuery = `
+	SELECT EXISTS (
+		SELECT 1 FROM unmatches
+		WHERE first_profile_id = LEAST($1, $2)
+		  AND second_profile_id = GREATEST($1, $2)
+	);
+	`
 )
 
+// SetLike stores a swipe of from on to. Likes and superlikes between
+// profiles where either side blocked the other are refused with
+// model.ErrBlocked, and between profiles that unmatched with
+// model.ErrUnmatched, so such pairs can never match again.
 func (pr *ProfileRepo) SetLike(from int, to int, status int) (likeID int, err error) {
 	var existingID int
 	var existing_status int
+
+	if status == 1 || status == 3 {
+		var blocked bool
+		err = pr.DB.QueryRow(context.Background(), IsPairBlockedQuery, from, to).Scan(&blocked)
+		if err != nil {
+			return 0, fmt.Errorf("error checking blocks: %w", err)
+		}
+		if blocked {
+			return 0, model.ErrBlocked
+		}
+
+		var unmatched bool
+		err = pr.DB.QueryRow(context.Background(), IsPairUnmatchedQuery, from, to).Scan(&unmatched)
+		if err != nil {
+			return 0, fmt.Errorf("error checking unmatches: %w", err)
+		}
+		if unmatched {
+			return 0, model.ErrUnmatched
+		}
+	}
+
 	err = pr.DB.QueryRow(
 		context.Background(),
 		CreateLikeQuery,
@@ -929,10 +1401,11 @@ func (pr *ProfileRepo) SetLike(from int, to int, status int) (likeID int, err er
 		if err == pgx.ErrNoRows {
 			_, err = pr.DB.Exec(
 				context.Background(),
-				CreateLikeQuery,
+				CreateImpliedLikeQuery,
 				to,
 				from,
 				1,
+				likeID,
 			)
 			if err != nil {
 				return likeID, fmt.Errorf("error inserting reverse like: %w", err)
@@ -950,6 +1423,9 @@ func (pr *ProfileRepo) SetLike(from int, to int, status int) (likeID int, err er
 		if err != nil {
 			return likeID, fmt.Errorf("error creating match: %w", err)
 		}
+		if err = pr.markLikeMatched(likeID); err != nil {
+			return likeID, err
+		}
 
 		likeID = -1
 		return likeID, nil
@@ -967,11 +1443,14 @@ func (pr *ProfileRepo) SetLike(from int, to int, status int) (likeID int, err er
 		if err != nil {
 			return likeID, fmt.Errorf("error creating match: %w", err)
 		}
+		if err = pr.markLikeMatched(likeID); err != nil {
+			return likeID, err
+		}
 		likeID = -1
 	}
 
 	if status == 2 {
-		_, err = pr.DB.Exec(
+		cmdTag, err := pr.DB.Exec(
 			context.Background(),
 			DeleteMatchQuery,
 			from, to,
@@ -979,11 +1458,210 @@ func (pr *ProfileRepo) SetLike(from int, to int, status int) (likeID int, err er
 		if err != nil {
 			return likeID, fmt.Errorf("error deleting match on dislike: %w", err)
 		}
+		if cmdTag.RowsAffected() > 0 {
+			_, err = pr.DB.Exec(context.Background(), MarkLikeRemovedMatchQuery, likeID)
+			if err != nil {
+				return likeID, fmt.Errorf("error marking dislike: %w", err)
+			}
+		}
 	}
 
 	return likeID, nil
 }
 
+func (pr *ProfileRepo) markLikeMatched(likeID int) error {
+	if _, err := pr.DB.Exec(context.Background(), MarkLikeMatchedQuery, likeID); err != nil {
+		return fmt.Errorf("error marking like as matched: %w", err)
+	}
+	return nil
+}
+
+const (
+	LastRewindableLikeQuery = `
+	SELECT like_id, liked_profile_id, status, created_match, removed_match, created_at
+	FROM likes
+	WHERE profile_id = $1
+	  AND implied_by IS NULL
+	  AND created_at > CURRENT_TIMESTAMP - make_interval(secs => $2)
+	ORDER BY created_at DESC, like_id DESC
+	LIMIT 1
+	FOR UPDATE;
+	`
+
+	DeleteLikeQuery = `
+	DELETE FROM likes WHERE like_id = $1;
+	`
+)
+
+// RewindLike removes the most recent swipe of from made within window,
+// together with the reverse like a superlike implied and the match the
+// swipe created. Earlier swipes can be rewound by calling it again. A
+// dislike that ended a match is refused with model.ErrRewindEndedMatch.
+func (pr *ProfileRepo) RewindLike(from int, window time.Duration) (model.RewoundLike, error) {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return model.RewoundLike{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		likeID       int
+		rewound      model.RewoundLike
+		matched      bool
+		removedMatch bool
+	)
+	err = tx.QueryRow(ctx, LastRewindableLikeQuery, from, window.Seconds()).
+		Scan(&likeID, &rewound.LikedProfileID, &rewound.Status, &matched, &removedMatch, &rewound.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return model.RewoundLike{}, model.ErrNothingToRewind
+	}
+	if err != nil {
+		return model.RewoundLike{}, fmt.Errorf("error finding last swipe: %w", err)
+	}
+	if removedMatch {
+		return model.RewoundLike{}, model.ErrRewindEndedMatch
+	}
+
+	if _, err := tx.Exec(ctx, DeleteLikeQuery, likeID); err != nil {
+		return model.RewoundLike{}, fmt.Errorf("error deleting like: %w", err)
+	}
+
+	if matched {
+		cmdTag, err := tx.Exec(ctx, DeleteMatchQuery, from, rewound.LikedProfileID)
+		if err != nil {
+			return model.RewoundLike{}, fmt.Errorf("error deleting match: %w", err)
+		}
+		rewound.MatchRemoved = cmdTag.RowsAffected() > 0
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.RewoundLike{}, err
+	}
+	return rewound, nil
+}
+
+const InsertUnmatchQuery = `
+	INSERT INTO unmatches (first_profile_id, second_profile_id, unmatched_by)
+	VALUES (LEAST($1, $2), GREATEST($1, $2), $1)
+	ON CONFLICT (first_profile_id, second_profile_id) DO NOTHING;
+	`
+
+// Unmatch breaks the match between from and to and records the pair, so
+// that neither is shown to the other again. It returns model.ErrNotMatched
+// when the two are not matched.
+func (pr *ProfileRepo) Unmatch(from int, to int) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmdTag, err := tx.Exec(ctx, DeleteMatchQuery, from, to)
+	if err != nil {
+		return fmt.Errorf("error deleting match: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return model.ErrNotMatched
+	}
+
+	if _, err := tx.Exec(ctx, InsertUnmatchQuery, from, to); err != nil {
+		return fmt.Errorf("error recording unmatch: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// pendingIncomingLikes selects likes and superlikes addressed to $1 that the
+// profile has not swiped back on. Reverse likes implied by a superlike are
+// not an answer, so they do not hide the superlike.
+const pendingIncomingLikes = `
+FROM likes l
+JOIN users u ON u.profile_id = l.profile_id
+WHERE l.liked_profile_id = $1
+  AND l.status IN (1, 3)
+  AND l.implied_by IS NULL
+  AND u.user_id NOT IN (SELECT user_id FROM blacklist)
+  AND NOT EXISTS (
+      SELECT 1 FROM unmatches um
+      WHERE um.first_profile_id = LEAST(l.profile_id, $1)
+        AND um.second_profile_id = GREATEST(l.profile_id, $1)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM profile_blocks pb
+      WHERE (pb.blocker_id = $1 AND pb.blocked_id = l.profile_id)
+         OR (pb.blocker_id = l.profile_id AND pb.blocked_id = $1)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM likes back
+      WHERE back.profile_id = $1
+        AND back.liked_profile_id = l.profile_id
+        AND back.implied_by IS NULL
+  )
+`
+
+const (
+	GetIncomingLikesQuery = `
+SELECT l.like_id, l.profile_id, l.status, l.created_at
+` + pendingIncomingLikes + `
+  AND ($2 = 0 OR l.like_id < $2)
+ORDER BY l.like_id DESC
+LIMIT $3;
+`
+
+	CountIncomingLikesQuery = `
+SELECT COUNT(*)
+` + pendingIncomingLikes + `;
+`
+)
+
+// GetIncomingLikes pages through pending likes newest first; cursor is the
+// like id of the last item of the previous page, 0 for the first page.
+func (pr *ProfileRepo) GetIncomingLikes(profileId int, cursor int, limit int) ([]model.IncomingLike, error) {
+	rows, err := pr.DB.Query(context.Background(), GetIncomingLikesQuery, profileId, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var likes []model.IncomingLike
+	for rows.Next() {
+		var like model.IncomingLike
+		if err := rows.Scan(&like.LikeID, &like.Profile.ProfileId, &like.Status, &like.CreatedAt); err != nil {
+			return nil, err
+		}
+		likes = append(likes, like)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	ids := make([]int, 0, len(likes))
+	for _, like := range likes {
+		ids = append(ids, like.Profile.ProfileId)
+	}
+	profiles, err := pr.GetProfilesByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range likes {
+		if profile, ok := profiles[likes[i].Profile.ProfileId]; ok {
+			likes[i].Profile = profile
+		}
+	}
+	return likes, nil
+}
+
+func (pr *ProfileRepo) CountIncomingLikes(profileId int) (int, error) {
+	var count int
+	err := pr.DB.QueryRow(context.Background(), CountIncomingLikesQuery, profileId).Scan(&count)
+	return count, err
+}
+
 func (pr *ProfileRepo) StoreInterests(profileID int, interests []string) error {
 	ctx := context.Background()
 
@@ -1017,8 +1695,8 @@ func (pr *ProfileRepo) StoreInterests(profileID int, interests []string) error {
 func (pr *ProfileRepo) StorePhotos(profileID int, paths []string) error {
 	ctx := context.Background()
 
-	for _, path := range paths {
-		_, err := pr.DB.Exec(ctx, InsertStaticPhoto, profileID, path)
+	for i, path := range paths {
+		_, err := pr.DB.Exec(ctx, InsertStaticPhoto, profileID, path, i, i == 0)
 		if err != nil {
 			return err
 		}
@@ -1054,16 +1732,23 @@ func (pr *ProfileRepo) DeleteProfile(userId int) error {
 }
 
 const SearchProfilesQuery = `
-WITH filtered_profiles AS (
-    SELECT DISTINCT p.profile_id, p.firstname, p.lastname, p.birthday, p.goal,
-           s.path AS avatar
+WITH ` + viewerPosition + `,
+filtered_profiles AS (
+    SELECT DISTINCT p.profile_id, p.firstname, p.lastname, p.birthday, p.goal, p.created_at,
+           COALESCE(s.thumb_path, s.path) AS avatar, dist.km, approx.km AS approx_km,
+           GREATEST(
+               similarity((p.firstname || ' ' || p.lastname), $11),
+               similarity(COALESCE(p.fullname_translit, ''), $11)
+           ) AS rank
     FROM profiles p
     JOIN users u ON u.profile_id = p.profile_id
-    LEFT JOIN "static" s ON s.profile_id = p.profile_id
+    LEFT JOIN "static" s ON s.profile_id = p.profile_id AND s.is_primary` + candidateDistance + `
     LEFT JOIN likes liked ON liked.liked_profile_id = p.profile_id AND liked.profile_id = $1
     WHERE p.profile_id != $1
       AND liked.profile_id IS NULL
       AND u.user_id NOT IN (SELECT user_id FROM blacklist)
+      AND ` + notUnmatched + `
+      AND ` + notBlocked + `
       AND (
           $2 = '' OR $2 = 'Any' OR
           (p.is_male = CASE 
@@ -1116,6 +1801,27 @@ WITH filtered_profiles AS (
           )
           OR jsonb_array_length($10) = 0
       )
+      AND (NOT $12 OR ` + mutualPreferenceFilter + `)
+      AND ($13 = 0 OR approx.km <= $13)
+      AND ($20::timestamp IS NULL OR p.created_at > $20)
+      AND (
+          cardinality($15::text[]) = 0 OR (
+              SELECT COUNT(DISTINCT LOWER(i.description))
+              FROM profile_interests pi
+              JOIN interests i ON i.interest_id = pi.interest_id
+              WHERE pi.profile_id = p.profile_id
+                AND LOWER(i.description) = ANY($15::text[])
+          ) = cardinality($15::text[])
+      )
+      AND (
+          cardinality($16::text[]) = 0 OR EXISTS (
+              SELECT 1
+              FROM profile_interests pi
+              JOIN interests i ON i.interest_id = pi.interest_id
+              WHERE pi.profile_id = p.profile_id
+                AND LOWER(i.description) = ANY($16::text[])
+          )
+      )
       AND (
           $11 = '' OR (
               similarity((p.firstname || ' ' || p.lastname), $11) > 0.3
@@ -1127,22 +1833,99 @@ WITH filtered_profiles AS (
           )
       )
 )
-SELECT DISTINCT ON (profile_id)
-    profile_id AS "IDUser",
-    avatar AS "FirstImg",
-    firstname || ' ' || lastname AS "Fullname",
-    FLOOR(DATE_PART('year', AGE(CURRENT_DATE, birthday)))::int AS "Age",
-    goal AS "Goal"
-FROM filtered_profiles
-ORDER BY profile_id
-LIMIT 20;
+-- sort_key orders every mode ascending; together with the profile id it
+-- forms the keyset the cursor points into. Distance sorts by the rounded
+-- distance, so the cursor tells no more than the page already shows.
+ranked AS (
+    SELECT DISTINCT ON (profile_id)
+        profile_id,
+        avatar,
+        firstname || ' ' || lastname AS fullname,
+        FLOOR(DATE_PART('year', AGE(CURRENT_DATE, birthday)))::int AS age,
+        goal,
+        km,
+        (CASE $14
+            WHEN 'distance' THEN COALESCE(approx_km, 'Infinity'::float8)
+            WHEN 'relevance' THEN -rank
+            WHEN 'age' THEN -EXTRACT(EPOCH FROM birthday)
+            WHEN 'age_desc' THEN EXTRACT(EPOCH FROM birthday)
+            WHEN 'newest' THEN -EXTRACT(EPOCH FROM COALESCE(created_at, 'epoch'::timestamp))
+            ELSE 0
+        END)::float8 AS sort_key
+    FROM filtered_profiles
+    ORDER BY profile_id
+)
+SELECT profile_id, avatar, fullname, age, goal, km, sort_key,
+       (SELECT COUNT(*) FROM ranked)::int AS total
+FROM ranked
+WHERE $17 = 0 OR (sort_key, profile_id) > ($18::float8, $17)
+ORDER BY sort_key, profile_id
+LIMIT $19;
+`
 
+// EncodeSearchCursor packs the position of the last profile of a page.
+func EncodeSearchCursor(sortKey float64, profileId int) string {
+	raw := strconv.FormatFloat(sortKey, 'g', -1, 64) + "_" + strconv.Itoa(profileId)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
 
-`
+func DecodeSearchCursor(cursor string) (float64, int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, model.ErrInvalidCursor
+	}
+	key, id, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return 0, 0, model.ErrInvalidCursor
+	}
+	sortKey, err := strconv.ParseFloat(key, 64)
+	if err != nil || math.IsNaN(sortKey) {
+		return 0, 0, model.ErrInvalidCursor
+	}
+	profileId, err := strconv.Atoi(id)
+	if err != nil || profileId <= 0 {
+		return 0, 0, model.ErrInvalidCursor
+	}
+	return sortKey, profileId, nil
+}
+
+// normalizeInterests lowercases and dedupes interest names. It never
+// returns nil, which the query would read as NULL.
+func normalizeInterests(interests []string) []string {
+	normalized := make([]string, 0, len(interests))
+	for _, i := range interests {
+		i = strings.ToLower(strings.TrimSpace(i))
+		if i != "" && !slices.Contains(normalized, i) {
+			normalized = append(normalized, i)
+		}
+	}
+	return normalized
+}
 
-func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRequest) ([]model.FoundProfile, error) {
+func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRequest) (model.SearchPage, error) {
 	ctx := context.Background()
 
+	limit := params.Limit
+	if limit <= 0 {
+		limit = model.SearchPageSize
+	}
+	limit = min(limit, model.MaxSearchPageSize)
+
+	var cursorKey float64
+	var cursorID int
+	if params.Cursor != "" {
+		var err error
+		cursorKey, cursorID, err = DecodeSearchCursor(params.Cursor)
+		if err != nil {
+			return model.SearchPage{}, err
+		}
+	}
+
+	var createdAfter *time.Time
+	if !params.CreatedAfter.IsZero() {
+		createdAfter = &params.CreatedAfter
+	}
+
 	rows, err := pr.DB.Query(ctx, SearchProfilesQuery,
 		cur_user,
 		params.IsMale,
@@ -1155,33 +1938,58 @@ func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRe
 		params.City,
 		params.Preferences,
 		params.Input,
+		params.Mutual,
+		params.MaxDistanceKm,
+		params.SortBy,
+		normalizeInterests(params.InterestsAll),
+		normalizeInterests(params.InterestsAny),
+		cursorID,
+		cursorKey,
+		limit+1,
+		createdAfter,
 	)
 	if err != nil {
-		return nil, err
+		return model.SearchPage{}, err
 	}
 	defer rows.Close()
 
-	var results []model.FoundProfile
+	page := model.SearchPage{Profiles: []model.FoundProfile{}}
+	var lastKey float64
 
 	for rows.Next() {
 		var fp model.FoundProfile
+		var distance sql.NullFloat64
+		var sortKey float64
 		if err := rows.Scan(
 			&fp.IDUser,
 			&fp.FirstImg,
 			&fp.Fullname,
 			&fp.Age,
 			&fp.Goal,
+			&distance,
+			&sortKey,
+			&page.Total,
 		); err != nil {
-			return nil, err
+			return model.SearchPage{}, err
+		}
+		if len(page.Profiles) == limit {
+			// the extra row only tells that there is another page
+			last := page.Profiles[limit-1]
+			page.NextCursor = EncodeSearchCursor(lastKey, last.IDUser)
+			break
 		}
-		results = append(results, fp)
+		if distance.Valid {
+			fp.Distance = geo.ApproxKm(distance.Float64)
+		}
+		lastKey = sortKey
+		page.Profiles = append(page.Profiles, fp)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return model.SearchPage{}, err
 	}
 
-	return results, nil
+	return page, nil
 }
 
 const GetStaticticsQuery = `
@@ -1223,182 +2031,174 @@ func (r *ProfileRepo) GetProfileStats(profileID int) (model.ProfileStats, error)
 	return stats, nil
 }
 
-const GetRecommendationsQuery = `
-SELECT 
-    bp.profile_id,
-    bp.firstname,
-    bp.lastname,
-    bp.is_male,
-    bp.height,
-    bp.birthday,
-    bp.description,
-    bp.goal,
+// rankingFeatures selects everything the ranker scores a profile on. The
+// list-valued signals come back as JSON arrays so one row covers a profile.
+const rankingFeatures = `
+SELECT
+    p.profile_id,
+    p.is_male,
+    p.goal,
+    p.height,
+    p.birthday,
     l.country,
     l.city,
-    l.district,
-    liked.profile_id AS liked_by_profile_id,
-    s.path AS avatar,
-    i.description AS interest,
-    pr.preference_description,
-    pr.preference_value,
-    param.parameter_description,
-    param.parameter_value,
-    CASE WHEN sbs.sub_id IS NOT NULL THEN TRUE ELSE FALSE END AS premium_status,
-    sbs.border
-FROM profiles bp
-JOIN users bu ON bu.profile_id = bp.profile_id
-LEFT JOIN profile_interests pi ON pi.profile_id = bp.profile_id
-LEFT JOIN interests i ON i.interest_id = pi.interest_id
-LEFT JOIN profile_preferences pp ON pp.profile_id = bp.profile_id
-LEFT JOIN preferences pr ON pr.preference_id = pp.preference_id
-LEFT JOIN profile_parameter ppar ON ppar.profile_id = bp.profile_id
-LEFT JOIN parameters param ON param.parameter_id = ppar.parameter_id
-LEFT JOIN locations l ON l.location_id = bp.location_id
-LEFT JOIN static s ON bp.profile_id = s.profile_id
-LEFT JOIN likes liked ON liked.liked_profile_id = bp.profile_id
-LEFT JOIN subscriptions sbs ON sbs.user_id = bp.profile_id
-LEFT JOIN blacklist bl ON bl.user_id = bu.user_id
-WHERE 
-    bl.user_id IS NULL 
-    AND bp.profile_id != $1 
+    (
+        SELECT MAX(s.last_active_at) FROM sessions s
+        JOIN users su ON su.user_id = s.user_id
+        WHERE su.profile_id = p.profile_id
+    ) AS last_active,
+    COALESCE((
+        SELECT json_agg(DISTINCT i.description) FROM profile_interests pi
+        JOIN interests i ON i.interest_id = pi.interest_id
+        WHERE pi.profile_id = p.profile_id
+    ), '[]')::text AS interests,
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'preference_description', pr.preference_description,
+            'preference_value', pr.preference_value
+        )) FROM profile_preferences pp
+        JOIN preferences pr ON pr.preference_id = pp.preference_id
+        WHERE pp.profile_id = p.profile_id
+    ), '[]')::text AS preferences,
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'preference_description', param.parameter_description,
+            'preference_value', param.parameter_value
+        )) FROM profile_parameter ppar
+        JOIN parameters param ON param.parameter_id = ppar.parameter_id
+        WHERE ppar.profile_id = p.profile_id
+    ), '[]')::text AS parameters
+FROM profiles p
+LEFT JOIN locations l ON l.location_id = p.location_id
+`
+
+const (
+	GetRankingFeaturesQuery = rankingFeatures + `
+WHERE p.profile_id = $1;
+`
+	// GetRecommendationCandidatesQuery picks the pool the ranker works on:
+	// everyone not blacklisted, blocked, unmatched or swiped yet, most
+	// recently active first.
+	GetRecommendationCandidatesQuery = rankingFeatures + `
+JOIN users u ON u.profile_id = p.profile_id
+WHERE p.profile_id != $1
+    AND NOT EXISTS (SELECT 1 FROM blacklist bl WHERE bl.user_id = u.user_id)
+    AND ` + notUnmatched + `
+    AND ` + notBlocked + `
     AND NOT EXISTS (
-        SELECT 1 FROM likes l2
-        WHERE l2.profile_id = $1 AND l2.liked_profile_id = bp.profile_id
+        SELECT 1 FROM likes sw
+        WHERE sw.profile_id = $1 AND sw.liked_profile_id = p.profile_id
     )
-    AND (
-        SELECT COUNT(*) FROM profile_interests pi1
-        WHERE pi1.profile_id = $1 AND pi1.interest_id IN (
-            SELECT pi2.interest_id FROM profile_interests pi2 WHERE pi2.profile_id = bp.profile_id
-        )
-    ) * 1.0 / NULLIF((
-        SELECT COUNT(*) FROM profile_interests pi3 WHERE pi3.profile_id = $1
-    ), 0) >= 0.7
-    AND (
-        (
-            SELECT COUNT(*) FROM profile_preferences  pp1
-            WHERE pp1.profile_id = $1 AND pp1.preference_id IN (
-                SELECT pp2.preference_id FROM profile_preferences pp2 WHERE pp2.profile_id = bp.profile_id
-            )
-        ) * 1.0 / NULLIF((
-            SELECT COUNT(*) FROM profile_preferences pp3 WHERE pp3.profile_id = $1
-        ), 0)
-        +
-        (
-            SELECT COUNT(*) FROM profile_preferences pp4
-            WHERE pp4.profile_id = bp.profile_id AND pp4.preference_id IN (
-                SELECT pp5.preference_id FROM profile_preferences pp5 WHERE pp5.profile_id = $1
-            )
-        ) * 1.0 / NULLIF((
-            SELECT COUNT(*) FROM profile_preferences pp6 WHERE pp6.profile_id = bp.profile_id
-        ), 0)
-    ) / 2.0 >= 0.5
-LIMIT 1;
+ORDER BY last_active DESC NULLS LAST, p.profile_id
+LIMIT $2;
 `
+)
 
-func (pr *ProfileRepo) GetRecomendations(profileId int) (model.Profile, error) {
-	var profile model.Profile
-	var birth sql.NullTime
-	var interest sql.NullString
-	var preferenceDesc sql.NullString
-	var preferenceValue sql.NullString
-	var likedByProfileId sql.NullInt64
-	var photo sql.NullString
-	var country, city, district sql.NullString
+func scanRankingFeatures(row pgx.Row) (model.RankingFeatures, error) {
+	var f model.RankingFeatures
+	var goal, height sql.NullInt64
+	var birth, lastActive sql.NullTime
+	var country, city sql.NullString
+	var interests, preferences, parameters string
+
+	if err := row.Scan(
+		&f.ProfileID,
+		&f.IsMale,
+		&goal,
+		&height,
+		&birth,
+		&country,
+		&city,
+		&lastActive,
+		&interests,
+		&preferences,
+		&parameters,
+	); err != nil {
+		return f, err
+	}
 
-	var goal sql.NullInt64
-	var paramDesc, paramValue sql.NullString
+	f.Goal = int(goal.Int64)
+	f.Height = int(height.Int64)
+	f.Birthday = birth.Time
+	f.LastActive = lastActive.Time
+	f.Country = country.String
+	f.City = city.String
 
-	var premiumStatus sql.NullBool
-	var premiumBorder sql.NullInt64
+	if err := json.Unmarshal([]byte(interests), &f.Interests); err != nil {
+		return f, fmt.Errorf("failed to decode interests: %w", err)
+	}
+	if err := json.Unmarshal([]byte(preferences), &f.Preferences); err != nil {
+		return f, fmt.Errorf("failed to decode preferences: %w", err)
+	}
+	if err := json.Unmarshal([]byte(parameters), &f.Parameters); err != nil {
+		return f, fmt.Errorf("failed to decode parameters: %w", err)
+	}
 
-	ctx := context.Background()
-	rows, err := pr.DB.Query(ctx, GetRecommendationsQuery, profileId)
-	fmt.Println(err)
+	return f, nil
+}
 
+func (pr *ProfileRepo) GetRankingFeatures(profileId int) (model.RankingFeatures, error) {
+	rows, err := pr.DB.Query(context.Background(), GetRankingFeaturesQuery, profileId)
 	if err != nil {
-		return profile, err
+		return model.RankingFeatures{}, err
 	}
 	defer rows.Close()
 
-	for rows.Next() {
-		if err := rows.Scan(
-			&profile.ProfileId,
-			&profile.FirstName,
-			&profile.LastName,
-			&profile.IsMale,
-			&profile.Height,
-			&birth,
-			&profile.Description,
-			&goal,
-			&country,
-			&city,
-			&district,
-			&likedByProfileId,
-			&photo,
-			&interest,
-			&preferenceDesc,
-			&preferenceValue,
-			&paramDesc,
-			&paramValue,
-			&premiumStatus,
-			&premiumBorder,
-		); err != nil {
-			return profile, err
-		}
-		fmt.Println(err)
-
-		if birth.Valid {
-			profile.Birthday = birth.Time
+	if !rows.Next() {
+		if rows.Err() != nil {
+			return model.RankingFeatures{}, rows.Err()
 		}
+		return model.RankingFeatures{}, model.ErrProfileNotFound
+	}
+	return scanRankingFeatures(rows)
+}
 
-		if country.Valid && city.Valid && district.Valid {
-			profile.Location = fmt.Sprintf("%s@%s@%s", country.String, city.String, district.String)
-		}
+func (pr *ProfileRepo) GetRecommendationCandidates(profileId int, limit int) ([]model.RankingFeatures, error) {
+	rows, err := pr.DB.Query(context.Background(), GetRecommendationCandidatesQuery, profileId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-		if likedByProfileId.Valid && !slices.Contains(profile.LikedBy, int(likedByProfileId.Int64)) {
-			profile.LikedBy = append(profile.LikedBy, int(likedByProfileId.Int64))
+	var candidates []model.RankingFeatures
+	for rows.Next() {
+		f, err := scanRankingFeatures(rows)
+		if err != nil {
+			return nil, err
 		}
+		candidates = append(candidates, f)
+	}
 
-		if interest.Valid && !slices.Contains(profile.Interests, interest.String) {
-			profile.Interests = append(profile.Interests, interest.String)
-		}
-		if goal.Valid {
-			profile.Goal = int(goal.Int64)
-		}
+	return candidates, rows.Err()
+}
 
-		if paramDesc.Valid && paramValue.Valid {
-			param := model.Preference{
-				Description: paramDesc.String,
-				Value:       paramValue.String,
-			}
-			if !slices.Contains(profile.Parameters, param) {
-				profile.Parameters = append(profile.Parameters, param)
-			}
-		}
+const recommendationsKeyFormat = "recommendations_for_user:%d"
 
-		if preferenceDesc.Valid && preferenceValue.Valid {
-			pref := model.Preference{
-				Description: preferenceDesc.String,
-				Value:       preferenceValue.String,
-			}
-			if !slices.Contains(profile.Preferences, pref) {
-				profile.Preferences = append(profile.Preferences, pref)
-			}
-		}
-		if photo.Valid && !slices.Contains(profile.Photos, photo.String) {
-			profile.Photos = append(profile.Photos, photo.String)
-		}
-		if premiumStatus.Valid && premiumStatus.Bool {
-			profile.Premium.Status = true
-			if premiumBorder.Valid {
-				profile.Premium.Border = int(premiumBorder.Int64)
-			}
-		}
+// SaveRecommendations keeps the ranked list a viewer is paging through, so
+// later pages are cut from the same order as the first one.
+func (pr *ProfileRepo) SaveRecommendations(profileId int, ranked []ranking.Scored) error {
+	data, err := json.Marshal(ranked)
+	if err != nil {
+		return err
 	}
+	key := fmt.Sprintf(recommendationsKeyFormat, profileId)
+	return pr.Client.Set(context.Background(), key, data, 30*time.Minute).Err()
+}
 
-	if rows.Err() != nil {
-		return profile, rows.Err()
+// GetSavedRecommendations returns the list stored by SaveRecommendations and
+// false once it has expired.
+func (pr *ProfileRepo) GetSavedRecommendations(profileId int) ([]ranking.Scored, bool, error) {
+	key := fmt.Sprintf(recommendationsKeyFormat, profileId)
+	data, err := pr.Client.Get(context.Background(), key).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
 	}
 
-	return profile, nil
+	var ranked []ranking.Scored
+	if err := json.Unmarshal(data, &ranked); err != nil {
+		return nil, false, err
+	}
+	return ranked, true, nil
 }
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/geo"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGazetteerLookup(t *testing.T) {
+	moscow, ok := geo.Lookup(" россия ", "МОСКВА")
+	assert.True(t, ok)
+	assert.InDelta(t, 55.75, moscow.Latitude, 0.01)
+
+	english, ok := geo.Lookup("Russia", "Moscow")
+	assert.True(t, ok)
+	assert.Equal(t, moscow, english)
+
+	_, ok = geo.Lookup("Russia", "Atlantis")
+	assert.False(t, ok)
+}
+
+func TestDistanceKm(t *testing.T) {
+	moscow, _ := geo.Lookup("Russia", "Moscow")
+	spb, _ := geo.Lookup("Russia", "Saint Petersburg")
+
+	assert.InDelta(t, 634, geo.DistanceKm(moscow, spb), 5)
+	assert.InDelta(t, geo.DistanceKm(moscow, spb), geo.DistanceKm(spb, moscow), 1e-9)
+	assert.Zero(t, geo.DistanceKm(moscow, moscow))
+}
+
+func TestApproxKm(t *testing.T) {
+	assert.Equal(t, 2, geo.ApproxKm(0))
+	assert.Equal(t, 2, geo.ApproxKm(1.3))
+	assert.Equal(t, 4, geo.ApproxKm(3.2))
+	assert.Equal(t, 15, geo.ApproxKm(11))
+	assert.Equal(t, 640, geo.ApproxKm(634))
+	assert.Equal(t, 0, geo.ApproxKm(math.NaN()))
+}
+
+func TestRadiusFilterUsesApproxKm(t *testing.T) {
+	// a client-chosen radius must not reveal more than the rounded distance
+	assert.Contains(t, repository.GetProfilesQuery, "approx.km <= $4")
+	assert.NotContains(t, repository.GetProfilesQuery, "dist.km <= $4")
+	assert.Contains(t, repository.SearchProfilesQuery, "approx.km <= $13")
+	assert.NotContains(t, repository.SearchProfilesQuery, "dist.km <= $13")
+}
+
+func TestSnapAndValid(t *testing.T) {
+	snapped := geo.Snap(model.Coordinates{Latitude: 55.75583, Longitude: 37.61729})
+	assert.InDelta(t, 55.76, snapped.Latitude, 1e-9)
+	assert.InDelta(t, 37.62, snapped.Longitude, 1e-9)
+
+	assert.True(t, geo.Valid(snapped))
+	assert.False(t, geo.Valid(model.Coordinates{Latitude: 91}))
+	assert.False(t, geo.Valid(model.Coordinates{Longitude: -181}))
+}
+
+func TestSetCoordinates(t *testing.T) {
+	mockDB := new(MockDB)
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	snapped := mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) == 3 && args[0] == 1 &&
+			math.Abs(args[1].(float64)-55.76) < 1e-9 && math.Abs(args[2].(float64)-37.62) < 1e-9
+	})
+	mockDB.On("Exec", mock.Anything, repository.SetProfileCoordinatesQuery, snapped).
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	mockDB.On("Exec", mock.Anything, repository.ClearProfileCoordinatesQuery, []interface{}{2}).
+		Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+
+	assert.NoError(t, repo.SetCoordinates(1, &model.Coordinates{Latitude: 55.75583, Longitude: 37.61729}))
+	assert.ErrorIs(t, repo.SetCoordinates(2, nil), model.ErrProfileNotFound)
+	assert.ErrorIs(t, repo.SetCoordinates(1, &model.Coordinates{Latitude: 120}), model.ErrInvalidCoordinates)
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessImage_Variants(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2000, 1000))
+	for y := 0; y < 1000; y++ {
+		for x := 0; x < 2000; x++ {
+			src.Set(x, y, color.NRGBA{R: 200, A: uint8(x % 256)})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, src))
+
+	variants, err := usecase.ProcessImage(buf.Bytes())
+	require.NoError(t, err)
+
+	expected := map[string]image.Point{
+		model.VariantThumb: {160, 80},
+		model.VariantCard:  {600, 300},
+		model.VariantFull:  {1600, 800},
+	}
+	for name, size := range expected {
+		cfg, format, err := image.DecodeConfig(bytes.NewReader(variants[name]))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, size, image.Point{cfg.Width, cfg.Height}, name)
+	}
+}
+
+func TestProcessImage_StripsExifAndAppliesOrientation(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 40, 20)), nil))
+
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x01,
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, 0x06, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := append([]byte{0xFF, 0xE1, 0x00, byte(len(payload) + 2)}, payload...)
+
+	original := buf.Bytes()
+	withExif := append(append(append([]byte{}, original[:2]...), segment...), original[2:]...)
+
+	variants, err := usecase.ProcessImage(withExif)
+	require.NoError(t, err)
+
+	full := variants[model.VariantFull]
+	assert.False(t, bytes.Contains(full, []byte("Exif")))
+
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(full))
+	require.NoError(t, err)
+	assert.Equal(t, 20, cfg.Width)
+	assert.Equal(t, 40, cfg.Height)
+}
+
+func TestProcessImage_RejectsNonImage(t *testing.T) {
+	_, err := usecase.ProcessImage([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+	assert.ErrorIs(t, err, model.ErrUnsupportedImage)
+}
+
+func TestVariantPath(t *testing.T) {
+	assert.Equal(t, "/1_2_me.jpg", usecase.VariantPath("/1_2_me.png", model.VariantFull))
+	assert.Equal(t, "/1_2_me.jpg", usecase.VariantPath("/1_2_me.webp", model.VariantFull))
+	assert.Equal(t, "/1_2_me.jpg", usecase.VariantPath("/1_2_me.jpg", model.VariantFull))
+	assert.Equal(t, "/1_2_me_thumb.jpg", usecase.VariantPath("/1_2_me.png", model.VariantThumb))
+	// the variants of a stored full path are the ones uploaded with it
+	assert.Equal(t, "/1_2_me_card.jpg", usecase.VariantPath(usecase.VariantPath("/1_2_me.png", model.VariantFull), model.VariantCard))
+}
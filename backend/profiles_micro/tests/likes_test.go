@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCountIncomingLikes(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("QueryRow", mock.Anything, repository.CountIncomingLikesQuery, []interface{}{4}).
+		Return(MockRowResult(3))
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	count, err := repo.CountIncomingLikes(4)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestGetIncomingLikes_Empty(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("Query", mock.Anything, repository.GetIncomingLikesQuery, []interface{}{4, 0, 11}).
+		Return(&MockRows{}, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likes, err := repo.GetIncomingLikes(4, 0, 11)
+	assert.NoError(t, err)
+	assert.Empty(t, likes)
+	mockDB.AssertExpectations(t)
+}
+
+func profileRow(id int, name string, photo string) []interface{} {
+	return []interface{}{
+		id, name, "", true, 170,
+		sql.NullTime{}, "", sql.NullInt64{},
+		sql.NullString{}, sql.NullString{}, sql.NullString{},
+		sql.NullInt64{},
+		sql.NullString{String: photo, Valid: true},
+		sql.NullString{}, sql.NullString{}, sql.NullString{},
+		sql.NullString{}, sql.NullString{},
+		sql.NullBool{}, sql.NullInt64{},
+	}
+}
+
+func TestGetIncomingLikes_LoadsProfilesInOneQuery(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockDB := new(MockDB)
+	mockDB.On("Query", mock.Anything, repository.GetIncomingLikesQuery, []interface{}{4, 0, 11}).
+		Return(&MockRows{data: [][]interface{}{
+			{12, 3, 1, createdAt},
+			{10, 2, 3, createdAt},
+		}}, nil).Once()
+	mockDB.On("Query", mock.Anything, repository.GetProfilesByIdsQuery, []interface{}{[]int{3, 2}}).
+		Return(&MockRows{data: [][]interface{}{
+			profileRow(2, "Bob", "/images/b1.jpg"),
+			profileRow(2, "Bob", "/images/b2.jpg"),
+			profileRow(3, "Carol", "/images/c.jpg"),
+		}}, nil).Once()
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likes, err := repo.GetIncomingLikes(4, 0, 11)
+	assert.NoError(t, err)
+	assert.Len(t, likes, 2)
+	assert.Equal(t, 12, likes[0].LikeID)
+	assert.Equal(t, "Carol", likes[0].Profile.FirstName)
+	assert.Equal(t, "Bob", likes[1].Profile.FirstName)
+	assert.Equal(t, []string{"/images/b1.jpg", "/images/b2.jpg"}, likes[1].Profile.Photos)
+	mockDB.AssertExpectations(t)
+	mockDB.AssertNotCalled(t, "Query", mock.Anything, repository.GetProfileByIdQuery, mock.Anything)
+}
+
+func TestSetLike_RefusesBlockedPair(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("QueryRow", mock.Anything, repository.IsPairBlockedQuery, []interface{}{1, 2}).
+		Return(&valuesRow{values: []interface{}{true}})
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	for _, status := range []int{1, 3} {
+		likeID, err := repo.SetLike(1, 2, status)
+		assert.ErrorIs(t, err, model.ErrBlocked)
+		assert.Zero(t, likeID)
+	}
+	mockDB.AssertNotCalled(t, "QueryRow", mock.Anything, repository.CreateLikeQuery, mock.Anything)
+	mockDB.AssertNotCalled(t, "Exec", mock.Anything, repository.CreateMatchQuery, mock.Anything)
+}
+
+func TestSetLike_RefusesUnmatchedPair(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("QueryRow", mock.Anything, repository.IsPairBlockedQuery, []interface{}{1, 2}).
+		Return(&valuesRow{values: []interface{}{false}})
+	mockDB.On("QueryRow", mock.Anything, repository.IsPairUnmatchedQuery, []interface{}{1, 2}).
+		Return(&valuesRow{values: []interface{}{true}})
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	for _, status := range []int{1, 3} {
+		likeID, err := repo.SetLike(1, 2, status)
+		assert.ErrorIs(t, err, model.ErrUnmatched)
+		assert.Zero(t, likeID)
+	}
+	mockDB.AssertNotCalled(t, "QueryRow", mock.Anything, repository.CreateLikeQuery, mock.Anything)
+	mockDB.AssertNotCalled(t, "Exec", mock.Anything, repository.CreateMatchQuery, mock.Anything)
+}
+
+func TestSetLike_DislikeMarksEndedMatch(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("QueryRow", mock.Anything, repository.CreateLikeQuery, []interface{}{1, 2, 2}).
+		Return(&valuesRow{values: []interface{}{7}})
+	mockDB.On("QueryRow", mock.Anything, repository.CheckLikeExistsQuery, []interface{}{2, 1}).
+		Return(&valuesRow{err: pgx.ErrNoRows})
+	mockDB.On("Exec", mock.Anything, repository.DeleteMatchQuery, []interface{}{1, 2}).
+		Return(pgconn.NewCommandTag("DELETE 2"), nil)
+	mockDB.On("Exec", mock.Anything, repository.MarkLikeRemovedMatchQuery, []interface{}{7}).
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likeID, err := repo.SetLike(1, 2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, likeID)
+	mockDB.AssertExpectations(t)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -43,6 +44,12 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 			*d = row[i].(sql.NullString)
 		case *sql.NullBool:
 			*d = row[i].(sql.NullBool)
+		case *sql.NullFloat64:
+			*d = row[i].(sql.NullFloat64)
+		case *float64:
+			*d = row[i].(float64)
+		case *time.Time:
+			*d = row[i].(time.Time)
 		default:
 			return fmt.Errorf("unsupported scan type %T", d)
 		}
@@ -203,3 +210,52 @@ func (m *MockTx) QueryRow(ctx context.Context, query string, args ...interface{}
 	called := m.Called(callArgs...)
 	return called.Get(0).(pgx.Row)
 }
+
+func (m *MockTx) Begin(ctx context.Context) (pgx.Tx, error) {
+	return m, nil
+}
+
+func (m *MockTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return nil
+}
+
+func (m *MockTx) LargeObjects() pgx.LargeObjects {
+	return pgx.LargeObjects{}
+}
+
+func (m *MockTx) Prepare(ctx context.Context, name, sql string) (*pgconn.StatementDescription, error) {
+	return nil, nil
+}
+
+func (m *MockTx) Conn() *pgx.Conn {
+	return nil
+}
+
+// valuesRow scans a fixed list of values into the destinations in order.
+type valuesRow struct {
+	values []interface{}
+	err    error
+}
+
+func (r *valuesRow) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	for i := range dest {
+		switch d := dest[i].(type) {
+		case *int:
+			*d = r.values[i].(int)
+		case *bool:
+			*d = r.values[i].(bool)
+		case *time.Time:
+			*d = r.values[i].(time.Time)
+		default:
+			return fmt.Errorf("unsupported scan type %T", d)
+		}
+	}
+	return nil
+}
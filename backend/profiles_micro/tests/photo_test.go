@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStorePhoto_LimitReached(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("QueryRow", mock.Anything, repository.LockProfilePhotosQuery, 1).
+		Return(&valuesRow{values: []interface{}{1}})
+	tx.On("Exec", mock.Anything, repository.UploadPhotoQuery,
+		1, "/a.png", "/a_thumb.jpg", "/a_card.jpg", model.MaxPhotosFree,
+	).Return(pgconn.NewCommandTag("INSERT 0 0"), nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	err := repo.StorePhoto(1, "/a.png", "/a_thumb.jpg", "/a_card.jpg", model.MaxPhotosFree)
+	assert.ErrorIs(t, err, model.ErrPhotoLimitReached)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestStorePhoto_Success(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("QueryRow", mock.Anything, repository.LockProfilePhotosQuery, 1).
+		Return(&valuesRow{values: []interface{}{1}})
+	tx.On("Exec", mock.Anything, repository.UploadPhotoQuery,
+		1, "/a.png", "/a_thumb.jpg", "/a_card.jpg", model.MaxPhotosPremium,
+	).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	err := repo.StorePhoto(1, "/a.png", "/a_thumb.jpg", "/a_card.jpg", model.MaxPhotosPremium)
+	assert.NoError(t, err)
+	tx.AssertExpectations(t)
+}
+
+func TestSetPrimaryPhoto(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("Exec", mock.Anything, repository.ClearPrimaryPhotoQuery, 1, "/a.png").
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.SetPrimaryPhotoQuery, 1, "/a.png").
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.ClearPrimaryPhotoQuery, 1, "/missing.png").
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.SetPrimaryPhotoQuery, 1, "/missing.png").
+		Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+	tx.On("Commit", mock.Anything).Return(nil).Once()
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.NoError(t, repo.SetPrimaryPhoto(1, "/a.png"))
+	// the cleared primary is rolled back with the failed switch
+	assert.ErrorIs(t, repo.SetPrimaryPhoto(1, "/missing.png"), model.ErrPhotoNotFound)
+	tx.AssertExpectations(t)
+}
@@ -1,12 +1,17 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -51,65 +56,65 @@ func TestGetProfileById(t *testing.T) {
 	assert.Equal(t, 5, profile.Premium.Border)
 }
 
-func TestGetRecommendations(t *testing.T) {
+func TestGetRecommendationCandidates(t *testing.T) {
 	mockDB := new(MockDB)
+	lastActive := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
 
 	rows := &MockRows{
 		data: [][]interface{}{
 			{
-				1,
-				"Alice",
-				"Smith",
-				true,
-				170,
-				sql.NullTime{Time: time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
-				"Test Description",
+				2, false,
 				sql.NullInt64{Int64: 1, Valid: true},
-				sql.NullString{String: "USA", Valid: true},
-				sql.NullString{String: "NYC", Valid: true},
-				sql.NullString{String: "Brooklyn", Valid: true},
-				sql.NullInt64{Int64: 2, Valid: true},
-				sql.NullString{String: "/img/avatar.jpg", Valid: true},
-				sql.NullString{String: "Music", Valid: true},
-				sql.NullString{String: "Smoking", Valid: true},
-				sql.NullString{String: "Never", Valid: true},
-				sql.NullString{String: "Height", Valid: true},
-				sql.NullString{String: "170", Valid: true},
-				sql.NullBool{Bool: true, Valid: true},
-				sql.NullInt64{Int64: 3, Valid: true},
+				sql.NullInt64{Int64: 165, Valid: true},
+				sql.NullTime{Time: time.Date(1996, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				sql.NullString{String: "Russia", Valid: true},
+				sql.NullString{String: "Moscow", Valid: true},
+				sql.NullTime{Time: lastActive, Valid: true},
+				`["Music","Travel"]`,
+				`[{"preference_description":"smoking","preference_value":"never"}]`,
+				`[{"preference_description":"bodyType","preference_value":"slim"}]`,
+			},
+			{
+				3, true,
+				sql.NullInt64{}, sql.NullInt64{}, sql.NullTime{},
+				sql.NullString{}, sql.NullString{}, sql.NullTime{},
+				`[]`, `[]`, `[]`,
 			},
 		},
 	}
 
-	mockDB.On("Query", mock.Anything, repository.GetRecommendationsQuery, []interface{}{1}).Return(rows, nil)
+	mockDB.On("Query", mock.Anything, repository.GetRecommendationCandidatesQuery, []interface{}{1, 500}).Return(rows, nil)
 
 	repo := &repository.ProfileRepo{DB: mockDB}
 
-	profile, err := repo.GetRecomendations(1)
+	candidates, err := repo.GetRecommendationCandidates(1, 500)
 
 	assert.NoError(t, err)
-	assert.Equal(t, 1, profile.ProfileId)
-	assert.Equal(t, "Alice", profile.FirstName)
-	assert.Equal(t, "Smith", profile.LastName)
-	assert.Equal(t, true, profile.IsMale)
-	assert.Equal(t, 170, profile.Height)
-	assert.Equal(t, time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC), profile.Birthday)
-	assert.Equal(t, "Test Description", profile.Description)
-	assert.Equal(t, 1, profile.Goal)
-	assert.Equal(t, "USA@NYC@Brooklyn", profile.Location)
-	assert.Contains(t, profile.LikedBy, 2)
-	assert.Contains(t, profile.Interests, "Music")
-	assert.Contains(t, profile.Preferences, model.Preference{
-		Description: "Smoking",
-		Value:       "Never",
-	})
-	assert.Contains(t, profile.Parameters, model.Preference{
-		Description: "Height",
-		Value:       "170",
-	})
-	assert.Contains(t, profile.Photos, "/img/avatar.jpg")
-	assert.True(t, profile.Premium.Status)
-	assert.Equal(t, 3, profile.Premium.Border)
+	assert.Len(t, candidates, 2)
+	assert.Equal(t, 2, candidates[0].ProfileID)
+	assert.Equal(t, 1, candidates[0].Goal)
+	assert.Equal(t, 165, candidates[0].Height)
+	assert.Equal(t, "Moscow", candidates[0].City)
+	assert.Equal(t, lastActive, candidates[0].LastActive)
+	assert.Equal(t, []string{"Music", "Travel"}, candidates[0].Interests)
+	assert.Contains(t, candidates[0].Preferences, model.Preference{Description: "smoking", Value: "never"})
+	assert.Contains(t, candidates[0].Parameters, model.Preference{Description: "bodyType", Value: "slim"})
+
+	assert.Equal(t, 3, candidates[1].ProfileID)
+	assert.True(t, candidates[1].Birthday.IsZero())
+	assert.Empty(t, candidates[1].Interests)
+	mockDB.AssertExpectations(t)
+}
+
+func TestGetRankingFeaturesNotFound(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("Query", mock.Anything, repository.GetRankingFeaturesQuery, []interface{}{7}).Return(&MockRows{}, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	_, err := repo.GetRankingFeatures(7)
+
+	assert.ErrorIs(t, err, model.ErrProfileNotFound)
 }
 
 func TestGetProfileStats(t *testing.T) {
@@ -150,15 +155,18 @@ func TestSearchProfiles(t *testing.T) {
 	mockDB := new(MockDB)
 
 	searchParams := model.SearchProfileRequest{
-		IsMale:    "Any",
-		AgeMin:    18,
-		AgeMax:    30,
-		HeightMin: 160,
-		HeightMax: 190,
-		Goal:      1,
-		Country:   "USA",
-		City:      "NYC",
-		Input:     "Ali",
+		IsMale:        "Any",
+		AgeMin:        18,
+		AgeMax:        30,
+		HeightMin:     160,
+		HeightMax:     190,
+		Goal:          1,
+		Country:       "USA",
+		City:          "NYC",
+		Input:         "Ali",
+		Mutual:        true,
+		MaxDistanceKm: 50,
+		SortBy:        model.SortByDistance,
 		Preferences: []model.Preference{
 			{
 				Description: "Height",
@@ -175,6 +183,9 @@ func TestSearchProfiles(t *testing.T) {
 				"Alice Smith",
 				25,
 				1,
+				sql.NullFloat64{Float64: 3.4, Valid: true},
+				3.4,
+				2,
 			},
 			{
 				2,
@@ -182,6 +193,9 @@ func TestSearchProfiles(t *testing.T) {
 				"Bob Johnson",
 				28,
 				1,
+				sql.NullFloat64{},
+				math.Inf(1),
+				2,
 			},
 		},
 	}
@@ -198,13 +212,25 @@ func TestSearchProfiles(t *testing.T) {
 		searchParams.City,
 		searchParams.Preferences,
 		searchParams.Input,
+		true,
+		50,
+		model.SortByDistance,
+		[]string{},
+		[]string{},
+		0,
+		float64(0),
+		model.SearchPageSize + 1,
+		(*time.Time)(nil),
 	}).Return(rows, nil)
 
 	repo := &repository.ProfileRepo{DB: mockDB}
 
-	results, err := repo.SearchProfiles(1, searchParams)
+	page, err := repo.SearchProfiles(1, searchParams)
 
 	assert.NoError(t, err)
+	assert.Empty(t, page.NextCursor)
+	assert.Equal(t, 2, page.Total)
+	results := page.Profiles
 	assert.Len(t, results, 2)
 
 	assert.Equal(t, 1, results[0].IDUser)
@@ -212,7 +238,156 @@ func TestSearchProfiles(t *testing.T) {
 	assert.Equal(t, "Alice Smith", results[0].Fullname)
 	assert.Equal(t, 25, results[0].Age)
 	assert.Equal(t, 1, results[0].Goal)
+	assert.Equal(t, 4, results[0].Distance)
 
 	assert.Equal(t, 2, results[1].IDUser)
 	assert.Equal(t, "Bob Johnson", results[1].Fullname)
+	assert.Zero(t, results[1].Distance)
+}
+
+func TestSearchProfiles_NextPage(t *testing.T) {
+	mockDB := new(MockDB)
+
+	cursor := repository.EncodeSearchCursor(-0.8, 7)
+	searchParams := model.SearchProfileRequest{
+		Input:        "Ali",
+		SortBy:       model.SortByRelevance,
+		InterestsAll: []string{"Music", " music ", "Travel"},
+		InterestsAny: nil,
+		Cursor:       cursor,
+		Limit:        1,
+	}
+
+	rows := &MockRows{
+		data: [][]interface{}{
+			{3, "/img/ava3.jpg", "Alina Frost", 24, 1, sql.NullFloat64{}, -0.6, 3},
+			{4, "/img/ava4.jpg", "Alisa Brown", 26, 1, sql.NullFloat64{}, -0.5, 3},
+		},
+	}
+
+	mockDB.On("Query", mock.Anything, repository.SearchProfilesQuery, mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) == 20 &&
+			assert.ObjectsAreEqual([]string{"music", "travel"}, args[14]) &&
+			assert.ObjectsAreEqual([]string{}, args[15]) &&
+			args[16] == 7 && args[17] == -0.8 && args[18] == 2
+	})).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	page, err := repo.SearchProfiles(1, searchParams)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Profiles, 1)
+	assert.Equal(t, 3, page.Profiles[0].IDUser)
+	assert.Equal(t, 3, page.Total)
+
+	key, id, err := repository.DecodeSearchCursor(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, -0.6, key)
+	assert.Equal(t, 3, id)
+}
+
+func TestSearchProfiles_InvalidCursor(t *testing.T) {
+	repo := &repository.ProfileRepo{DB: new(MockDB)}
+
+	_, err := repo.SearchProfiles(1, model.SearchProfileRequest{Cursor: "not a cursor"})
+	assert.ErrorIs(t, err, model.ErrInvalidCursor)
+
+	key, id, err := repository.DecodeSearchCursor(repository.EncodeSearchCursor(math.Inf(1), 12))
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(key, 1))
+	assert.Equal(t, 12, id)
+}
+
+// feedRow is a GetProfilesQuery row with only the fields the feed order uses.
+func feedRow(profileID int, km sql.NullFloat64, feedKey float64) []interface{} {
+	return []interface{}{
+		profileID, "Name", "Surname", true, 170,
+		sql.NullTime{}, sql.NullString{}, 1,
+		sql.NullString{}, sql.NullString{}, sql.NullString{}, sql.NullString{},
+		sql.NullString{}, sql.NullString{}, sql.NullString{}, sql.NullString{}, sql.NullString{},
+		sql.NullBool{}, sql.NullInt64{},
+		km, feedKey,
+	}
+}
+
+func TestGetProfilesByUserId_PagesNearestFirst(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer redisServer.Close()
+	client := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+
+	// the radius feed continues after profile 4 at 5 km
+	redisServer.Set("profiles_for_user:1:50", repository.EncodeSearchCursor(5, 4))
+
+	rows := &MockRows{data: [][]interface{}{
+		feedRow(2, sql.NullFloat64{Float64: 31.2, Valid: true}, 35),
+		feedRow(9, sql.NullFloat64{Float64: 4.6, Valid: true}, 5),
+		feedRow(3, sql.NullFloat64{Float64: 8.1, Valid: true}, 9),
+	}}
+
+	mutual := model.MutualFeedFilter
+	model.MutualFeedFilter = false
+	defer func() { model.MutualFeedFilter = mutual }()
+
+	mockDB := new(MockDB)
+	mockDB.On("Query", mock.Anything, repository.GetProfilesQuery, []interface{}{
+		1, 4, model.PageSize, 50, float64(5), false,
+	}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB, Client: client}
+
+	profiles, err := repo.GetProfilesByUserId(1, 50)
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 3)
+	assert.Equal(t, 9, profiles[0].ProfileId)
+	assert.Equal(t, 3, profiles[1].ProfileId)
+	assert.Equal(t, 2, profiles[2].ProfileId)
+	assert.Equal(t, 35, profiles[2].Distance)
+
+	assert.Eventually(t, func() bool {
+		cursor, err := client.Get(context.Background(), "profiles_for_user:1:50").Result()
+		return err == nil && cursor == repository.EncodeSearchCursor(35, 2)
+	}, time.Second, 10*time.Millisecond)
+	// the feed without a radius keeps its own position
+	assert.False(t, redisServer.Exists("profiles_for_user:1:0"))
+}
+
+func TestGetProfilesByUserId_MutualFilter(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer redisServer.Close()
+
+	mutual := model.MutualFeedFilter
+	model.MutualFeedFilter = true
+	defer func() { model.MutualFeedFilter = mutual }()
+
+	// the filter runs before LIMIT, so a strict candidate never leaves a page short
+	assert.Contains(t, repository.GetProfilesQuery, "AND (NOT $6 OR")
+	assert.Less(t,
+		strings.Index(repository.GetProfilesQuery, "NOT $6"),
+		strings.Index(repository.GetProfilesQuery, "LIMIT $3"),
+	)
+
+	page := &MockRows{data: [][]interface{}{
+		feedRow(2, sql.NullFloat64{Float64: 3, Valid: true}, 3),
+		feedRow(4, sql.NullFloat64{Float64: 5, Valid: true}, 5),
+	}}
+
+	mockDB := new(MockDB)
+	mockDB.On("Query", mock.Anything, repository.GetProfilesQuery, []interface{}{
+		1, 0, model.PageSize, 0, float64(0), true,
+	}).Return(page, nil).Once()
+
+	repo := &repository.ProfileRepo{
+		DB:     mockDB,
+		Client: redis.NewClient(&redis.Options{Addr: redisServer.Addr()}),
+	}
+
+	profiles, err := repo.GetProfilesByUserId(1, 0)
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 2)
+	assert.Equal(t, 2, profiles[0].ProfileId)
+	assert.Equal(t, 4, profiles[1].ProfileId)
+	mockDB.AssertExpectations(t)
 }
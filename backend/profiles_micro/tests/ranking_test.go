@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/ranking"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+var rankingNow = time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+func rankingViewer() model.RankingFeatures {
+	return model.RankingFeatures{
+		ProfileID:   1,
+		IsMale:      true,
+		Goal:        2,
+		Height:      180,
+		Birthday:    time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC),
+		Country:     "Russia",
+		City:        "Moscow",
+		LastActive:  rankingNow,
+		Interests:   []string{"Music", "Travel", "Chess"},
+		Preferences: []model.Preference{{Description: "smoking", Value: "never"}},
+		Parameters:  []model.Preference{{Description: "smoking", Value: "never"}},
+	}
+}
+
+func TestRankOrdersByCompatibility(t *testing.T) {
+	good := model.RankingFeatures{
+		ProfileID:   2,
+		Goal:        2,
+		Height:      170,
+		Birthday:    time.Date(1996, 1, 1, 0, 0, 0, 0, time.UTC),
+		Country:     "Russia",
+		City:        "Moscow",
+		LastActive:  rankingNow.Add(-time.Hour),
+		Interests:   []string{"music", "Travel"},
+		Preferences: []model.Preference{{Description: "smoking", Value: "never"}},
+		Parameters:  []model.Preference{{Description: "smoking", Value: "never"}},
+	}
+	poor := model.RankingFeatures{
+		ProfileID:   3,
+		Goal:        1,
+		Height:      150,
+		Birthday:    time.Date(1975, 1, 1, 0, 0, 0, 0, time.UTC),
+		Country:     "France",
+		City:        "Paris",
+		LastActive:  rankingNow.Add(-60 * 24 * time.Hour),
+		Interests:   []string{"Football"},
+		Parameters:  []model.Preference{{Description: "smoking", Value: "often"}},
+		Preferences: []model.Preference{{Description: "smoking", Value: "often"}},
+	}
+
+	ranked := ranking.Rank(rankingViewer(), []model.RankingFeatures{poor, good}, rankingNow)
+
+	assert.Len(t, ranked, 2)
+	assert.Equal(t, 2, ranked[0].ProfileID)
+	assert.Equal(t, 3, ranked[1].ProfileID)
+	assert.Greater(t, ranked[0].Score, 0.8)
+	assert.Less(t, ranked[1].Score, 0.2)
+	assert.LessOrEqual(t, len(ranked[0].Reasons), ranking.MaxReasons)
+	assert.Equal(t, ranking.ReasonInterests, ranked[0].Reasons[0])
+	assert.Empty(t, ranked[1].Reasons)
+}
+
+func TestRankSparseProfiles(t *testing.T) {
+	viewer := model.RankingFeatures{ProfileID: 1}
+	older := model.RankingFeatures{ProfileID: 2, LastActive: rankingNow.Add(-72 * time.Hour)}
+	recent := model.RankingFeatures{ProfileID: 3, LastActive: rankingNow.Add(-time.Hour)}
+	unknown := model.RankingFeatures{ProfileID: 4}
+
+	ranked := ranking.Rank(viewer, []model.RankingFeatures{unknown, older, recent, viewer}, rankingNow)
+
+	assert.Len(t, ranked, 3)
+	assert.Equal(t, []int{3, 2, 4}, []int{ranked[0].ProfileID, ranked[1].ProfileID, ranked[2].ProfileID})
+	assert.Zero(t, ranked[2].Score)
+	assert.Equal(t, []string{ranking.ReasonActive}, ranked[0].Reasons)
+}
+
+func TestScoreDampsLowCoverage(t *testing.T) {
+	viewer := rankingViewer()
+	onlyGoal := model.RankingFeatures{ProfileID: 2, Goal: 2}
+	full := viewer
+	full.ProfileID = 3
+
+	sparse := ranking.DefaultWeights.Score(viewer, onlyGoal, rankingNow)
+	dense := ranking.DefaultWeights.Score(viewer, full, rankingNow)
+
+	assert.Greater(t, sparse.Score, 0.0)
+	assert.Less(t, sparse.Score, dense.Score)
+	assert.InDelta(t, 1.0, dense.Score, 1e-9)
+}
+
+func TestSavedRecommendations_KeepOrder(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer redisServer.Close()
+
+	repo := &repository.ProfileRepo{Client: redis.NewClient(&redis.Options{Addr: redisServer.Addr()})}
+
+	_, ok, err := repo.GetSavedRecommendations(1)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ranked := []ranking.Scored{
+		{ProfileID: 7, Score: 0.9, Reasons: []string{"2 shared interests"}},
+		{ProfileID: 3, Score: 0.4},
+	}
+	assert.NoError(t, repo.SaveRecommendations(1, ranked))
+
+	saved, ok, err := repo.GetSavedRecommendations(1)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, ranked, saved)
+	assert.Greater(t, redisServer.TTL("recommendations_for_user:1"), time.Duration(0))
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRewindLike_RemovesMatch(t *testing.T) {
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tx := new(MockTx)
+	tx.On("QueryRow", mock.Anything, repository.LastRewindableLikeQuery, 1, float64(600)).
+		Return(&valuesRow{values: []interface{}{7, 2, 1, true, false, createdAt}})
+	tx.On("Exec", mock.Anything, repository.DeleteLikeQuery, 7).
+		Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.DeleteMatchQuery, 1, 2).
+		Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	rewound, err := repo.RewindLike(1, 10*time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, model.RewoundLike{LikedProfileID: 2, Status: 1, MatchRemoved: true, CreatedAt: createdAt}, rewound)
+	tx.AssertExpectations(t)
+}
+
+func TestRewindLike_KeepsMatchesItDidNotCreate(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("QueryRow", mock.Anything, repository.LastRewindableLikeQuery, 1, float64(600)).
+		Return(&valuesRow{values: []interface{}{8, 3, 2, false, false, time.Now()}})
+	tx.On("Exec", mock.Anything, repository.DeleteLikeQuery, 8).
+		Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	rewound, err := repo.RewindLike(1, 10*time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, rewound.MatchRemoved)
+	tx.AssertNotCalled(t, "Exec", mock.Anything, repository.DeleteMatchQuery, 1, 3)
+}
+
+func TestRewindLike_RefusesDislikeThatEndedMatch(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("QueryRow", mock.Anything, repository.LastRewindableLikeQuery, 1, float64(600)).
+		Return(&valuesRow{values: []interface{}{9, 4, 2, false, true, time.Now()}})
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	_, err := repo.RewindLike(1, 10*time.Minute)
+	assert.ErrorIs(t, err, model.ErrRewindEndedMatch)
+	tx.AssertNotCalled(t, "Exec", mock.Anything, repository.DeleteLikeQuery, 9)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestRewindLike_NothingToRewind(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("QueryRow", mock.Anything, repository.LastRewindableLikeQuery, 1, float64(600)).
+		Return(&valuesRow{err: pgx.ErrNoRows})
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	_, err := repo.RewindLike(1, 10*time.Minute)
+	assert.ErrorIs(t, err, model.ErrNothingToRewind)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnmatch_RecordsPair(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("Exec", mock.Anything, repository.DeleteMatchQuery, 1, 2).
+		Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.InsertUnmatchQuery, 1, 2).
+		Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.NoError(t, repo.Unmatch(1, 2))
+	tx.AssertExpectations(t)
+}
+
+func TestUnmatch_NotMatched(t *testing.T) {
+	tx := new(MockTx)
+	tx.On("Exec", mock.Anything, repository.DeleteMatchQuery, 1, 2).
+		Return(pgconn.NewCommandTag("DELETE 0"), nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	mockDB := new(MockDB)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.ErrorIs(t, repo.Unmatch(1, 2), model.ErrNotMatched)
+	tx.AssertNotCalled(t, "Exec", mock.Anything, repository.InsertUnmatchQuery, 1, 2)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -15,6 +16,16 @@ func (pss *ProfileServiceServer) DeleteImage(ctx context.Context, req *profiles.
 		pss.Logger.Error("DeleteImage", &logrus.Fields{"error": err})
 		return nil, err
 	}
+	for _, variant := range model.PhotoVariants {
+		if variant.Name == model.VariantFull {
+			continue
+		}
+		err = pss.StaticRepo.DeleteImage(int(req.GetUserId()), VariantPath(req.GetFilename(), variant.Name))
+		if err != nil {
+			pss.Logger.Error("DeleteImage", &logrus.Fields{"error": err, "variant": variant.Name})
+			return nil, err
+		}
+	}
 	pss.Logger.Info("DeleteImage: static image deleted")
 	err = pss.ProfilesRepo.DeletePhoto(int(req.GetUserId()), req.GetFilename())
 	if err != nil {
@@ -2,22 +2,127 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/ranking"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (pss *ProfileServiceServer) GetRecommendations(ctx context.Context, req *profiles.GetProfileRequest) (*profiles.GetProfileResponse, error) {
+	pss.Logger.Info("GetRecommendations", &logrus.Fields{"profile_id": req.GetProfileId()})
 
-	pss.Logger.Info("v", "user_id", req.GetProfileId())
-	profile, err := pss.ProfilesRepo.GetRecomendations(int(req.GetProfileId()))
+	ranked, err := pss.rankRecommendations(int(req.GetProfileId()))
 	if err != nil {
 		pss.Logger.Error("GetRecommendations", "user_id", req.GetProfileId(), "error", err)
-	} else {
-		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetProfileId(), "profile": profile})
+		return nil, err
+	}
+	if len(ranked) == 0 {
+		return &profiles.GetProfileResponse{Profile: &profiles.Profile{}}, nil
+	}
+
+	profile, err := pss.ProfilesRepo.GetProfileById(ranked[0].ProfileID)
+	if err != nil {
+		pss.Logger.Error("GetRecommendations", "user_id", req.GetProfileId(), "error", err)
+		return nil, err
+	}
+
+	return &profiles.GetProfileResponse{Profile: profileToProto(profile)}, nil
+}
+
+func (pss *ProfileServiceServer) GetRecommendationsPage(ctx context.Context, req *profiles.GetRecommendationsPageRequest) (*profiles.GetRecommendationsPageResponse, error) {
+	pss.Logger.Info("GetRecommendationsPage", &logrus.Fields{"profile_id": req.GetProfileId(), "offset": req.GetOffset()})
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = model.PageSize
+	}
+	limit = min(limit, model.RecommendationsLimit)
+	offset := max(int(req.GetOffset()), 0)
+
+	ranked, err := pss.pagedRecommendations(int(req.GetProfileId()), offset)
+	if err != nil {
+		pss.Logger.Error("GetRecommendationsPage", &logrus.Fields{"error": err})
+		return nil, err
+	}
+
+	resp := &profiles.GetRecommendationsPageResponse{}
+	if offset >= len(ranked) {
+		return resp, nil
+	}
+
+	end := min(offset+limit, len(ranked))
+	page := ranked[offset:end]
+	ids := make([]int, 0, len(page))
+	for _, r := range page {
+		ids = append(ids, r.ProfileID)
+	}
+	byID, err := pss.ProfilesRepo.GetProfilesByIds(ids)
+	if err != nil {
+		pss.Logger.Error("GetRecommendationsPage", &logrus.Fields{"error": err})
+		return nil, err
+	}
+
+	for _, r := range page {
+		profile, ok := byID[r.ProfileID]
+		if !ok {
+			continue
+		}
+		resp.Items = append(resp.Items, &profiles.Recommendation{
+			Profile: profileToProto(profile),
+			Score:   r.Score,
+			Reasons: r.Reasons,
+		})
+	}
+	resp.NextOffset = int32(end)
+	resp.HasMore = end < len(ranked)
+
+	return resp, nil
+}
+
+// pagedRecommendations ranks afresh for the first page and keeps the result,
+// so that later offsets index the same order instead of a ranking that has
+// moved on with activity since. An expired list is ranked again.
+func (pss *ProfileServiceServer) pagedRecommendations(profileId int, offset int) ([]ranking.Scored, error) {
+	if offset > 0 {
+		ranked, ok, err := pss.ProfilesRepo.GetSavedRecommendations(profileId)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return ranked, nil
+		}
+	}
+
+	ranked, err := pss.rankRecommendations(profileId)
+	if err != nil {
+		return nil, err
+	}
+	if err := pss.ProfilesRepo.SaveRecommendations(profileId, ranked); err != nil {
+		pss.Logger.Warn("pagedRecommendations", &logrus.Fields{"profile_id": profileId, "error": err})
+	}
+	return ranked, nil
+}
+
+// rankRecommendations scores the candidate pool against the profile. A
+// profile without any features still gets candidates ordered by activity.
+func (pss *ProfileServiceServer) rankRecommendations(profileId int) ([]ranking.Scored, error) {
+	viewer, err := pss.ProfilesRepo.GetRankingFeatures(profileId)
+	if err != nil {
+		return nil, err
 	}
 
+	candidates, err := pss.ProfilesRepo.GetRecommendationCandidates(profileId, model.RecommendationPoolSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return ranking.Rank(viewer, candidates, time.Now()), nil
+}
+
+func profileToProto(profile model.Profile) *profiles.Profile {
 	likedBy := []int32{}
 	for _, like := range profile.LikedBy {
 		likedBy = append(likedBy, int32(like))
@@ -39,7 +144,7 @@ func (pss *ProfileServiceServer) GetRecommendations(ctx context.Context, req *pr
 		})
 	}
 
-	var prof *profiles.Profile = &profiles.Profile{
+	prof := &profiles.Profile{
 		ProfileId:   int32(profile.ProfileId),
 		FirstName:   profile.FirstName,
 		LastName:    profile.LastName,
@@ -54,6 +159,7 @@ func (pss *ProfileServiceServer) GetRecommendations(ctx context.Context, req *pr
 		Parametres:  params,
 		Photos:      profile.Photos,
 		LikedBy:     likedBy,
+		DistanceKm:  int32(profile.Distance),
 	}
 	if profile.Premium.Status {
 		prof.Premium = &profiles.Premium{
@@ -61,7 +167,5 @@ func (pss *ProfileServiceServer) GetRecommendations(ctx context.Context, req *pr
 			Border: int32(profile.Premium.Border),
 		}
 	}
-
-	return &profiles.GetProfileResponse{Profile: prof}, err
-
+	return prof
 }
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) GetIncomingLikes(ctx context.Context, req *profiles.GetIncomingLikesRequest) (*profiles.GetIncomingLikesResponse, error) {
+	pss.Logger.Info("GetIncomingLikes", &logrus.Fields{"profile_id": req.GetProfileId(), "cursor": req.GetCursor()})
+
+	total, err := pss.ProfilesRepo.CountIncomingLikes(int(req.GetProfileId()))
+	if err != nil {
+		pss.Logger.Error("GetIncomingLikes", &logrus.Fields{"error": err})
+		return nil, err
+	}
+
+	resp := &profiles.GetIncomingLikesResponse{Total: int32(total)}
+	if req.GetCountOnly() || total == 0 {
+		return resp, nil
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = model.PageSize
+	}
+	limit = min(limit, model.IncomingLikesLimit)
+
+	// one extra row tells whether another page exists
+	likes, err := pss.ProfilesRepo.GetIncomingLikes(int(req.GetProfileId()), int(req.GetCursor()), limit+1)
+	if err != nil {
+		pss.Logger.Error("GetIncomingLikes", &logrus.Fields{"error": err})
+		return nil, err
+	}
+	if len(likes) > limit {
+		likes = likes[:limit]
+		resp.HasMore = true
+	}
+
+	for _, like := range likes {
+		var prefs []*profiles.Preference
+		for _, preference := range like.Profile.Preferences {
+			prefs = append(prefs, &profiles.Preference{
+				Description: preference.Description,
+				Value:       preference.Value,
+			})
+		}
+		resp.Likes = append(resp.Likes, &profiles.IncomingLike{
+			LikeId: int32(like.LikeID),
+			Profile: &profiles.Profile{
+				ProfileId:   int32(like.Profile.ProfileId),
+				FirstName:   like.Profile.FirstName,
+				LastName:    like.Profile.LastName,
+				IsMale:      like.Profile.IsMale,
+				Height:      int32(like.Profile.Height),
+				Birthday:    timestamppb.New(like.Profile.Birthday),
+				Description: like.Profile.Description,
+				Location:    like.Profile.Location,
+				Interests:   like.Profile.Interests,
+				Preferences: prefs,
+				Photos:      like.Profile.Photos,
+				Goal:        int32(like.Profile.Goal),
+			},
+			Status:    int32(like.Status),
+			CreatedAt: timestamppb.New(like.CreatedAt),
+		})
+	}
+	if len(likes) > 0 {
+		resp.NextCursor = int32(likes[len(likes)-1].LikeID)
+	}
+
+	return resp, nil
+}
@@ -13,7 +13,7 @@ func (pss *ProfileServiceServer) GetProfiles(
 	req *profiles.GetProfilesRequest,
 ) (*profiles.GetProfilesResponse, error) {
 	pss.Logger.Info("GetProfiles", "forUserId", req.GetForUserId())
-	result, err := pss.ProfilesRepo.GetProfilesByUserId(int(req.GetForUserId()))
+	result, err := pss.ProfilesRepo.GetProfilesByUserId(int(req.GetForUserId()), int(req.GetMaxDistanceKm()))
 	if err != nil {
 		pss.Logger.Error("GetProfiles", "forUserId", req.GetForUserId(), "error", err)
 	} else {
@@ -59,6 +59,7 @@ func (pss *ProfileServiceServer) GetProfiles(
 			Parametres:  params,
 			Photos:      profile.Photos,
 			LikedBy:     likedBy,
+			DistanceKm:  int32(profile.Distance),
 			Premium: &profiles.Premium{
 				Status: profile.Premium.Status,
 				Border: int32(profile.Premium.Border),
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+// ProcessImage sniffs the uploaded bytes, decodes them and re-encodes every
+// photo variant as a plain JPEG. Re-encoding drops EXIF, GPS and any other
+// metadata the original file carried.
+func ProcessImage(data []byte) (map[string][]byte, error) {
+	contentType := http.DetectContentType(data)
+
+	var (
+		decodeConfig func([]byte) (image.Config, error)
+		decode       func([]byte) (image.Image, error)
+	)
+	switch contentType {
+	case "image/jpeg":
+		decodeConfig = func(b []byte) (image.Config, error) { return jpeg.DecodeConfig(bytes.NewReader(b)) }
+		decode = func(b []byte) (image.Image, error) { return jpeg.Decode(bytes.NewReader(b)) }
+	case "image/png":
+		decodeConfig = func(b []byte) (image.Config, error) { return png.DecodeConfig(bytes.NewReader(b)) }
+		decode = func(b []byte) (image.Image, error) { return png.Decode(bytes.NewReader(b)) }
+	case "image/webp":
+		decodeConfig = func(b []byte) (image.Config, error) { return webp.DecodeConfig(bytes.NewReader(b)) }
+		decode = func(b []byte) (image.Image, error) { return webp.Decode(bytes.NewReader(b)) }
+	default:
+		return nil, model.ErrUnsupportedImage
+	}
+
+	cfg, err := decodeConfig(data)
+	if err != nil {
+		return nil, model.ErrUnsupportedImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > model.MaxImagePixels {
+		return nil, model.ErrImageTooLarge
+	}
+
+	src, err := decode(data)
+	if err != nil {
+		return nil, model.ErrUnsupportedImage
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	variants := make(map[string][]byte, len(model.PhotoVariants))
+	// Largest variant first so that every smaller one is scaled from the
+	// previous result instead of from the full-size original.
+	for i := len(model.PhotoVariants) - 1; i >= 0; i-- {
+		v := model.PhotoVariants[i]
+
+		scaled := scaleToFit(src, v.MaxSide)
+		src = scaled
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, orient(scaled, orientation), &jpeg.Options{Quality: v.Quality}); err != nil {
+			return nil, err
+		}
+		variants[v.Name] = buf.Bytes()
+	}
+
+	return variants, nil
+}
+
+// VariantPath returns the storage path of a photo variant. Every variant is
+// a JPEG, so the original extension is swapped for .jpg; the full variant
+// keeps the original name otherwise.
+func VariantPath(original, variant string) string {
+	base := strings.TrimSuffix(original, path.Ext(original))
+	if variant == model.VariantFull {
+		return base + ".jpg"
+	}
+	return base + "_" + variant + ".jpg"
+}
+
+// scaleToFit draws src onto a white RGBA canvas no larger than maxSide on
+// either side. Images are never upscaled; transparency is flattened.
+func scaleToFit(src image.Image, maxSide int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > maxSide || h > maxSide {
+		if w >= h {
+			h = max(1, h*maxSide/w)
+			w = maxSide
+		} else {
+			w = max(1, w*maxSide/h)
+			h = maxSide
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
+
+// orient applies an EXIF orientation (1-8) to img.
+func orient(img *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			dst.SetRGBA(dx, dy, img.RGBAAt(x, y))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the orientation tag from the EXIF block of a JPEG.
+// It returns 1 when the tag is missing or the block is malformed.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		size := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if size < 2 || pos+2+size > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+size]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		pos += 2 + size
+	}
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			value := int(order.Uint16(tiff[entry+8:]))
+			if value < 1 || value > 8 {
+				return 1
+			}
+			return value
+		}
+	}
+	return 1
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (pss *ProfileServiceServer) ReorderProfileImages(ctx context.Context, req *profiles.ReorderProfileImagesRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("ReorderProfileImages", &logrus.Fields{"user_id": req.GetUserId(), "paths": req.GetPaths()})
+	err := pss.ProfilesRepo.ReorderPhotos(int(req.GetUserId()), req.GetPaths())
+	if errors.Is(err, model.ErrInvalidPhotoOrder) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		pss.Logger.Error("ReorderProfileImages", &logrus.Fields{"error": err})
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (pss *ProfileServiceServer) SetPrimaryProfileImage(ctx context.Context, req *profiles.SetPrimaryProfileImageRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("SetPrimaryProfileImage", &logrus.Fields{"user_id": req.GetUserId(), "path": req.GetPath()})
+	err := pss.ProfilesRepo.SetPrimaryPhoto(int(req.GetUserId()), req.GetPath())
+	if errors.Is(err, model.ErrPhotoNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.Error("SetPrimaryProfileImage", &logrus.Fields{"error": err})
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
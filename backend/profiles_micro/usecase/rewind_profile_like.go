@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) RewindProfileLike(
+	ctx context.Context,
+	req *profiles.RewindProfileLikeRequest,
+) (*profiles.RewindProfileLikeResponse, error) {
+	pss.Logger.Info("RewindProfileLike", &logrus.Fields{"profile_id": req.GetProfileId(), "window": req.GetWindowSeconds()})
+	if req.GetWindowSeconds() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	rewound, err := pss.ProfilesRepo.RewindLike(
+		int(req.GetProfileId()),
+		time.Duration(req.GetWindowSeconds())*time.Second,
+	)
+	if errors.Is(err, model.ErrNothingToRewind) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, model.ErrRewindEndedMatch) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		pss.Logger.Error("RewindProfileLike", &logrus.Fields{"error": err})
+		return nil, err
+	}
+
+	return &profiles.RewindProfileLikeResponse{
+		LikedProfileId: int32(rewound.LikedProfileID),
+		Status:         int32(rewound.Status),
+		MatchRemoved:   rewound.MatchRemoved,
+		CreatedAt:      timestamppb.New(rewound.CreatedAt),
+	}, nil
+}
@@ -2,9 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (pss *ProfileServiceServer) SearchProfile(
@@ -13,16 +16,26 @@ func (pss *ProfileServiceServer) SearchProfile(
 ) (*profiles.SearchProfileResponse, error) {
 
 	params := model.SearchProfileRequest{
-		IsMale:      req.GetIsMale(),
-		Input:       req.GetInput(),
-		AgeMin:      int(req.GetAgeMin()),
-		AgeMax:      int(req.GetAgeMax()),
-		HeightMin:   int(req.GetHeightMin()),
-		HeightMax:   int(req.GetHeightMax()),
-		Goal:        int(req.GetGoal()),
-		Country:     req.GetCountry(),
-		City:        req.GetCity(),
-		Preferences: make([]model.Preference, 0, len(req.GetParametres())),
+		IsMale:        req.GetIsMale(),
+		Input:         req.GetInput(),
+		AgeMin:        int(req.GetAgeMin()),
+		AgeMax:        int(req.GetAgeMax()),
+		HeightMin:     int(req.GetHeightMin()),
+		HeightMax:     int(req.GetHeightMax()),
+		Goal:          int(req.GetGoal()),
+		Country:       req.GetCountry(),
+		City:          req.GetCity(),
+		Mutual:        req.GetMutual(),
+		MaxDistanceKm: int(req.GetMaxDistanceKm()),
+		SortBy:        req.GetSortBy(),
+		InterestsAll:  req.GetInterestsAll(),
+		InterestsAny:  req.GetInterestsAny(),
+		Cursor:        req.GetCursor(),
+		Limit:         int(req.GetLimit()),
+		Preferences:   make([]model.Preference, 0, len(req.GetParametres())),
+	}
+	if req.GetCreatedAfter() != nil {
+		params.CreatedAfter = req.GetCreatedAfter().AsTime()
 	}
 	for _, p := range req.GetParametres() {
 		params.Preferences = append(params.Preferences, model.Preference{
@@ -31,20 +44,27 @@ func (pss *ProfileServiceServer) SearchProfile(
 		})
 	}
 
-	foundProfiles, err := pss.ProfilesRepo.SearchProfiles(int(req.GetIDUser()), params)
+	page, err := pss.ProfilesRepo.SearchProfiles(int(req.GetIDUser()), params)
+	if errors.Is(err, model.ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &profiles.SearchProfileResponse{}
+	resp := &profiles.SearchProfileResponse{
+		NextCursor: page.NextCursor,
+		Total:      int32(page.Total),
+	}
 
-	for _, p := range foundProfiles {
+	for _, p := range page.Profiles {
 		resp.Profiles = append(resp.Profiles, &profiles.FoundProfile{
 			IDUser:   int32(p.IDUser),
 			FirstImg: p.FirstImg,
 			Fullname: p.Fullname,
 			Age:      int32(p.Age),
 			Goal:     int32(p.Goal),
+			Distance: int32(p.Distance),
 		})
 	}
 
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (pss *ProfileServiceServer) SetProfileCoordinates(ctx context.Context, req *profiles.SetProfileCoordinatesRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("SetProfileCoordinates", &logrus.Fields{"profile_id": req.GetProfileId(), "clear": req.GetClear()})
+
+	var pos *model.Coordinates
+	if !req.GetClear() {
+		pos = &model.Coordinates{Latitude: req.GetLatitude(), Longitude: req.GetLongitude()}
+	}
+
+	err := pss.ProfilesRepo.SetCoordinates(int(req.GetProfileId()), pos)
+	switch {
+	case errors.Is(err, model.ErrInvalidCoordinates):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrProfileNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		pss.Logger.Error("SetProfileCoordinates", &logrus.Fields{"error": err})
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -2,9 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (pss *ProfileServiceServer) SetProfileLike(
@@ -21,6 +25,9 @@ func (pss *ProfileServiceServer) SetProfileLike(
 		int(req.GetTo()),
 		int(req.GetStatus()),
 	)
+	if errors.Is(err, model.ErrBlocked) || errors.Is(err, model.ErrUnmatched) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		pss.Logger.Error("SetProfileLike", "error", err)
 	} else {
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (pss *ProfileServiceServer) UnmatchProfile(ctx context.Context, req *profiles.UnmatchProfileRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("UnmatchProfile", &logrus.Fields{"profile_id": req.GetProfileId(), "unmatched_profile_id": req.GetUnmatchedProfileId()})
+	if req.GetProfileId() == req.GetUnmatchedProfileId() {
+		return nil, status.Error(codes.InvalidArgument, "cannot unmatch yourself")
+	}
+
+	err := pss.ProfilesRepo.Unmatch(int(req.GetProfileId()), int(req.GetUnmatchedProfileId()))
+	switch {
+	case errors.Is(err, model.ErrNotMatched):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		pss.Logger.Error("UnmatchProfile", &logrus.Fields{"error": err})
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
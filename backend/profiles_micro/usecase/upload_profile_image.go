@@ -2,9 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -13,19 +17,58 @@ func (pss *ProfileServiceServer) UploadProfileImage(
 	req *profiles.UploadProfileImageRequest,
 ) (*emptypb.Empty, error) {
 	pss.Logger.Info("UploadProfileImage")
-	filenameWithPath := "/" + req.GetFilename()
-	err := pss.StaticRepo.UploadImage(req.GetFile(), filenameWithPath, req.GetContentType())
 	pss.Logger.WithFields(&logrus.Fields{
 		"user_id":      req.GetUserId(),
 		"filename":     req.GetFilename(),
 		"content_type": req.GetContentType(),
 	})
+
+	count, premium, err := pss.ProfilesRepo.GetPhotoQuota(int(req.GetUserId()))
 	if err != nil {
 		pss.Logger.Error("UploadProfileImage", err)
 		return nil, err
 	}
+	limit := model.MaxPhotosFree
+	if premium {
+		limit = model.MaxPhotosPremium
+	}
+	if count >= limit {
+		pss.Logger.Info("UploadProfileImage: photo limit reached", "user_id", req.GetUserId(), "limit", limit)
+		return nil, status.Error(codes.ResourceExhausted, model.ErrPhotoLimitReached.Error())
+	}
+
+	variants, err := ProcessImage(req.GetFile())
+	if err != nil {
+		pss.Logger.Error("UploadProfileImage", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	paths := make(map[string]string, len(model.PhotoVariants))
+	for _, variant := range model.PhotoVariants {
+		paths[variant.Name] = VariantPath(req.GetFilename(), variant.Name)
+		err = pss.StaticRepo.UploadImage(variants[variant.Name], "/"+paths[variant.Name], model.PhotoContentType)
+		if err != nil {
+			pss.Logger.Error("UploadProfileImage", err)
+			return nil, err
+		}
+	}
 	pss.Logger.Info("UploadProfileImage: image uploaded")
-	err = pss.ProfilesRepo.StorePhoto(int(req.GetUserId()), req.GetFilename())
+
+	err = pss.ProfilesRepo.StorePhoto(
+		int(req.GetUserId()),
+		paths[model.VariantFull],
+		paths[model.VariantThumb],
+		paths[model.VariantCard],
+		limit,
+	)
+	if errors.Is(err, model.ErrPhotoLimitReached) {
+		for _, path := range paths {
+			if delErr := pss.StaticRepo.DeleteImage(int(req.GetUserId()), "/"+path); delErr != nil {
+				pss.Logger.Error("UploadProfileImage", delErr)
+			}
+		}
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
 	pss.Logger.Info("error", err)
 	return &emptypb.Empty{}, err
 }
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type AttachmentStorage interface {
+	UploadAttachment(fileBytes []byte, filename, contentType string) error
+	GetAttachment(filename string) ([]byte, error)
+	DeleteAttachment(filename string) error
+}
+
+type AttachmentRepo struct {
+	Client     *minio.Client
+	BucketName string
+}
+
+// chat attachments live in their own private bucket, unlike profile photos,
+// and are only served through the backend after a participant check
+func NewAttachmentRepo() (*AttachmentRepo, error) {
+	endpoint := "minio:9000"
+	accessKeyID := os.Getenv("MINIO_ROOT_USER")
+	secretAccessKey := os.Getenv("MINIO_ROOT_PASSWORD")
+	useSSL := false
+
+	minioClient, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		fmt.Println("Error connecting to storage:", err)
+		return &AttachmentRepo{}, err
+	}
+
+	bucketName := "chat-attachments"
+	ctx := context.Background()
+	exists, err := minioClient.BucketExists(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		err = minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &AttachmentRepo{
+		Client:     minioClient,
+		BucketName: bucketName,
+	}, nil
+}
+
+func (ar *AttachmentRepo) UploadAttachment(fileBytes []byte, filename, contentType string) error {
+	_, err := ar.Client.PutObject(context.Background(), ar.BucketName, filename,
+		bytes.NewReader(fileBytes),
+		int64(len(fileBytes)),
+		minio.PutObjectOptions{ContentType: contentType},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload attachment to minio: %w", err)
+	}
+	return nil
+}
+
+func (ar *AttachmentRepo) GetAttachment(filename string) ([]byte, error) {
+	obj, err := ar.Client.GetObject(context.Background(), ar.BucketName, filename, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment %s: %w", filename, err)
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attachment %s: %w", filename, err)
+	}
+	return data, nil
+}
+
+func (ar *AttachmentRepo) DeleteAttachment(filename string) error {
+	err := ar.Client.RemoveObject(context.Background(), ar.BucketName, filename, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment %s: %w", filename, err)
+	}
+	return nil
+}
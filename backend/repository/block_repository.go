@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+type BlockRepository interface {
+	Block(blockerID int, blockedID int) (bool, error)
+	Unblock(blockerID int, blockedID int) (bool, error)
+	GetBlocked(blockerID int) ([]model.BlockedProfile, error)
+	IsBlocked(firstID int, secondID int) (bool, error)
+}
+
+type BlockRepo struct {
+	DB *sql.DB
+}
+
+func NewBlockRepo() (*BlockRepo, error) {
+	cfg := InitPostgresConfig()
+	db, err := InitPostgresConnection(cfg)
+	if err != nil {
+		fmt.Println("Error connecting to database:", err)
+		return &BlockRepo{}, err
+	}
+	return &BlockRepo{
+		DB: db,
+	}, nil
+}
+
+const (
+	BlockProfileQuery = `
+		INSERT INTO profile_blocks (blocker_id, blocked_id)
+		VALUES ($1, $2)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING;
+	`
+
+	UnblockProfileQuery = `
+		DELETE FROM profile_blocks
+		WHERE blocker_id = $1 AND blocked_id = $2;
+	`
+
+	GetBlockedProfilesQuery = `
+		SELECT
+			b.blocked_id,
+			p.firstname,
+			p.lastname,
+			COALESCE(s.thumb_path, s.path, '') AS avatar,
+			b.created_at
+		FROM profile_blocks b
+		JOIN profiles p ON p.profile_id = b.blocked_id
+		LEFT JOIN "static" s ON s.profile_id = b.blocked_id AND s.is_primary
+		WHERE b.blocker_id = $1
+		ORDER BY b.created_at DESC, b.blocked_id;
+	`
+
+	IsBlockedQuery = `
+		SELECT EXISTS (
+			SELECT 1 FROM profile_blocks
+			WHERE (blocker_id = $1 AND blocked_id = $2)
+			   OR (blocker_id = $2 AND blocked_id = $1)
+		);
+	`
+)
+
+// Block reports whether a new block was added; blocking twice is a no-op.
+func (br *BlockRepo) Block(blockerID int, blockedID int) (bool, error) {
+	res, err := br.DB.ExecContext(context.Background(), BlockProfileQuery, blockerID, blockedID)
+	if err != nil {
+		return false, err
+	}
+	added, err := res.RowsAffected()
+	return added > 0, err
+}
+
+func (br *BlockRepo) Unblock(blockerID int, blockedID int) (bool, error) {
+	res, err := br.DB.ExecContext(context.Background(), UnblockProfileQuery, blockerID, blockedID)
+	if err != nil {
+		return false, err
+	}
+	removed, err := res.RowsAffected()
+	return removed > 0, err
+}
+
+func (br *BlockRepo) GetBlocked(blockerID int) ([]model.BlockedProfile, error) {
+	rows, err := br.DB.QueryContext(context.Background(), GetBlockedProfilesQuery, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blocked := []model.BlockedProfile{}
+	for rows.Next() {
+		var b model.BlockedProfile
+		var firstName, lastName string
+		if err := rows.Scan(&b.ProfileId, &firstName, &lastName, &b.Avatar, &b.BlockedAt); err != nil {
+			return nil, err
+		}
+		b.Name = firstName + " " + lastName
+		blocked = append(blocked, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return blocked, nil
+}
+
+// IsBlocked is true when either profile has blocked the other.
+func (br *BlockRepo) IsBlocked(firstID int, secondID int) (bool, error) {
+	var blocked bool
+	err := br.DB.QueryRowContext(context.Background(), IsBlockedQuery, firstID, secondID).Scan(&blocked)
+	return blocked, err
+}
@@ -16,12 +16,21 @@ import (
 type ChatRepository interface {
 	GetChats(userID int) ([]model.Chat, error)
 	GetChatParticipants(chatID int) (int, int, error)
+	IsChatClosed(chatID int) (bool, error)
+	GetChatPeers(userID int) ([]int, error)
 	CreateChat(firstProfileID, secondProfileID int) (int, error)
-	DeleteChat(firstID int, secondID int) error
+	DeleteChat(firstID int, secondID int) ([]string, error)
+	CloseChat(firstID int, secondID int) (int, error)
+	ReopenChat(firstID int, secondID int) (int, error)
 
 	GetMessages(chatID int) ([]model.Message, error)
-	DeleteMessage(messageID int, chatID int) error
-	CreateMessage(chatID int, userID int, content string, status int) (int, error)
+	GetMessagesPage(chatID int, beforeMessageID int, limit int) ([]model.Message, error)
+	DeleteMessage(messageID int, chatID int) ([]string, error)
+	EditMessage(messageID int, chatID int, userID int, content string) (model.Message, error)
+	CreateMessage(chatID int, userID int, content string, status int, attachmentIDs []int) (int, error)
+	CreateAttachment(chatID int, userID int, path string, contentType string) (int, error)
+	GetAttachment(attachmentID int) (model.AttachmentFile, error)
+	DeleteUnboundAttachments(olderThan time.Duration) ([]string, error)
 	GetMessagesFromCache(chatID int, userID int) ([]model.Message, error)
 	UpdateMessageStatus(chatID int, userID int) error
 
@@ -84,6 +93,43 @@ func (cr *ChatRepo) GetChatParticipants(chatID int) (int, int, error) {
 	return firstID, secondID, nil
 }
 
+const IsChatClosedQuery = `
+		SELECT closed_at IS NOT NULL FROM chats WHERE chat_id = $1;`
+
+func (cr *ChatRepo) IsChatClosed(chatID int) (bool, error) {
+	var closed bool
+	err := cr.DB.QueryRowContext(context.Background(), IsChatClosedQuery, chatID).Scan(&closed)
+	return closed, err
+}
+
+const GetChatPeersQuery = `
+		SELECT 
+			CASE WHEN first_profile_id = $1 THEN second_profile_id ELSE first_profile_id END
+		FROM chats WHERE (first_profile_id = $1 OR second_profile_id = $1) AND closed_at IS NULL;`
+
+func (cr *ChatRepo) GetChatPeers(userID int) ([]int, error) {
+	rows, err := cr.DB.QueryContext(context.Background(), GetChatPeersQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var peers []int
+	for rows.Next() {
+		var peerID int
+		if err := rows.Scan(&peerID); err != nil {
+			return nil, err
+		}
+		peers = append(peers, peerID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
 const (
 	GetChatsQuery = `
 	SELECT DISTINCT ON (c.chat_id) 
@@ -99,6 +145,7 @@ LEFT JOIN blacklist b1 ON b1.user_id = u1.user_id
 LEFT JOIN blacklist b2 ON b2.user_id = u2.user_id
 WHERE 
     (c.first_profile_id = $1 OR c.second_profile_id = $1)
+    AND c.closed_at IS NULL
     AND b1.user_id IS NULL
     AND b2.user_id IS NULL;
 	`
@@ -111,7 +158,7 @@ WHERE
 		s.path AS avatar
 	FROM profiles p
 	LEFT JOIN "static" s 
-	ON p.profile_id = s.profile_id 
+	ON p.profile_id = s.profile_id AND s.is_primary
 	WHERE p.profile_id = $1;
 	`
 )
@@ -171,6 +218,13 @@ func (cr *ChatRepo) GetChats(userID int) ([]model.Chat, error) {
 			chat.IsRead = true
 		}
 
+		presence, err := getPresence(cr.Ctx, cr.Client, reqID, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		chat.IsOnline = presence.IsOnline
+		chat.LastSeen = presence.LastSeen
+
 		chats = append(chats, chat)
 	}
 
@@ -213,15 +267,106 @@ func (cr *ChatRepo) CreateChat(firstProfileID, secondProfileID int) (int, error)
 	return chatID, nil
 }
 
+// DeleteChatBetweenUsersQuery returns the storage paths of the attachments
+// the cascade removes; the select still sees them in the statement snapshot.
 const DeleteChatBetweenUsersQuery = `
-	DELETE FROM chats
-	WHERE (first_profile_id = $1 AND second_profile_id = $2)
-	   OR (first_profile_id = $2 AND second_profile_id = $1);
+	WITH deleted AS (
+		DELETE FROM chats
+		WHERE (first_profile_id = $1 AND second_profile_id = $2)
+		   OR (first_profile_id = $2 AND second_profile_id = $1)
+		RETURNING chat_id
+	)
+	SELECT a.path
+	FROM message_attachments a
+	JOIN deleted d ON d.chat_id = a.chat_id;
+`
+
+// DeleteChat deletes the chat between two profiles and returns the storage
+// paths of its attachments, which the caller has to remove.
+func (cr *ChatRepo) DeleteChat(firstID int, secondID int) ([]string, error) {
+	rows, err := cr.DB.QueryContext(context.Background(), DeleteChatBetweenUsersQuery, firstID, secondID)
+	if err != nil {
+		return nil, err
+	}
+	return scanAttachmentPaths(rows)
+}
+
+func scanAttachmentPaths(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, rows.Err()
+}
+
+const CloseChatBetweenUsersQuery = `
+	UPDATE chats
+	SET closed_at = CURRENT_TIMESTAMP
+	WHERE ((first_profile_id = $1 AND second_profile_id = $2)
+	    OR (first_profile_id = $2 AND second_profile_id = $1))
+	  AND closed_at IS NULL
+	RETURNING chat_id;
+`
+
+// CloseChat closes the chat between two profiles and drops both message
+// caches. It returns the id of the closed chat, or 0 if there was none.
+func (cr *ChatRepo) CloseChat(firstID int, secondID int) (int, error) {
+	var chatID int
+	err := cr.DB.QueryRowContext(context.Background(), CloseChatBetweenUsersQuery, firstID, secondID).Scan(&chatID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = cr.Client.Del(cr.Ctx,
+		fmt.Sprintf("chat:%d:messages_user%d", chatID, firstID),
+		fmt.Sprintf("chat:%d:messages_user%d", chatID, secondID),
+	).Err()
+	if err != nil {
+		return chatID, err
+	}
+
+	return chatID, nil
+}
+
+// ReopenChatBetweenUsersQuery only reopens the chat of a pair that is still
+// matched and where neither side blocks the other, so an unmatched chat stays
+// closed.
+const ReopenChatBetweenUsersQuery = `
+	UPDATE chats c
+	SET closed_at = NULL
+	WHERE ((c.first_profile_id = $1 AND c.second_profile_id = $2)
+	    OR (c.first_profile_id = $2 AND c.second_profile_id = $1))
+	  AND c.closed_at IS NOT NULL
+	  AND EXISTS (
+	      SELECT 1 FROM matches m
+	      WHERE (m.profile_id = $1 AND m.matched_profile_id = $2)
+	         OR (m.profile_id = $2 AND m.matched_profile_id = $1)
+	  )
+	  AND NOT EXISTS (
+	      SELECT 1 FROM profile_blocks pb
+	      WHERE (pb.blocker_id = $1 AND pb.blocked_id = $2)
+	         OR (pb.blocker_id = $2 AND pb.blocked_id = $1)
+	  )
+	RETURNING c.chat_id;
 `
 
-func (cr *ChatRepo) DeleteChat(firstID int, secondID int) error {
-	_, err := cr.DB.ExecContext(context.Background(), DeleteChatBetweenUsersQuery, firstID, secondID)
-	return err
+// ReopenChat returns the id of the reopened chat, or 0 if it stays closed.
+func (cr *ChatRepo) ReopenChat(firstID int, secondID int) (int, error) {
+	var chatID int
+	err := cr.DB.QueryRowContext(context.Background(), ReopenChatBetweenUsersQuery, firstID, secondID).Scan(&chatID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return chatID, err
 }
 
 const GetMessagesQuery = `
@@ -259,16 +404,179 @@ func (cr *ChatRepo) GetMessages(chatID int) ([]model.Message, error) {
 	return messages, nil
 }
 
+const GetMessagesPageQuery = `
+	SELECT 
+		message_id,
+		user_id,
+		content,
+		status,
+		created_at,
+		edited_at IS NOT NULL
+	FROM messages
+	WHERE chat_id = $1 AND ($2 = 0 OR message_id < $2)
+	ORDER BY message_id DESC
+	LIMIT $3;
+`
+
+func (cr *ChatRepo) GetMessagesPage(chatID int, beforeMessageID int, limit int) ([]model.Message, error) {
+	rows, err := cr.DB.QueryContext(context.Background(), GetMessagesPageQuery, chatID, beforeMessageID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []model.Message
+	for rows.Next() {
+		var message model.Message
+		if err := rows.Scan(&message.MessageID, &message.SenderID, &message.Text, &message.Status, &message.CreatedAt, &message.Edited); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return messages, nil
+	}
+
+	// rows are ordered by message_id DESC
+	attachments, err := cr.getAttachmentsInRange(chatID, messages[len(messages)-1].MessageID, messages[0].MessageID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range messages {
+		messages[i].Attachments = attachments[messages[i].MessageID]
+	}
+
+	return messages, nil
+}
+
+const GetAttachmentsInRangeQuery = `
+	SELECT 
+		attachment_id,
+		message_id,
+		content_type
+	FROM message_attachments
+	WHERE chat_id = $1 AND message_id BETWEEN $2 AND $3
+	ORDER BY attachment_id ASC;
+`
+
+func (cr *ChatRepo) getAttachmentsInRange(chatID int, fromMessageID int, toMessageID int) (map[int][]model.Attachment, error) {
+	rows, err := cr.DB.QueryContext(context.Background(), GetAttachmentsInRangeQuery, chatID, fromMessageID, toMessageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := make(map[int][]model.Attachment)
+	for rows.Next() {
+		var attachment model.Attachment
+		var messageID int
+		if err := rows.Scan(&attachment.AttachmentID, &messageID, &attachment.ContentType); err != nil {
+			return nil, err
+		}
+		attachment.URL = fmt.Sprintf(model.AttachmentURLFormat, attachment.AttachmentID)
+		attachments[messageID] = append(attachments[messageID], attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+const (
+	InsertAttachmentQuery = `
+		INSERT INTO message_attachments (chat_id, user_id, path, content_type)
+		VALUES ($1, $2, $3, $4)
+		RETURNING attachment_id;
+	`
+
+	GetAttachmentQuery = `
+		SELECT chat_id, path, content_type
+		FROM message_attachments
+		WHERE attachment_id = $1;
+	`
+
+	BindAttachmentQuery = `
+		UPDATE message_attachments
+		SET message_id = $1
+		WHERE attachment_id = $2 AND chat_id = $3 AND user_id = $4 AND message_id IS NULL
+		RETURNING content_type;
+	`
+)
+
+func (cr *ChatRepo) CreateAttachment(chatID int, userID int, path string, contentType string) (int, error) {
+	var attachmentID int
+	err := cr.DB.QueryRowContext(context.Background(),
+		InsertAttachmentQuery, chatID, userID, path, contentType).Scan(&attachmentID)
+	if err != nil {
+		return 0, err
+	}
+	return attachmentID, nil
+}
+
+func (cr *ChatRepo) GetAttachment(attachmentID int) (model.AttachmentFile, error) {
+	var file model.AttachmentFile
+	err := cr.DB.QueryRowContext(context.Background(), GetAttachmentQuery, attachmentID).Scan(
+		&file.ChatID,
+		&file.Path,
+		&file.ContentType,
+	)
+	if err == sql.ErrNoRows {
+		return model.AttachmentFile{}, model.ErrAttachmentNotFound
+	} else if err != nil {
+		return model.AttachmentFile{}, err
+	}
+	return file, nil
+}
+
+// DeleteUnboundAttachmentsQuery removes uploads that were never sent; the
+// bind query only takes rows with no message, so a row is either bound or
+// deleted here, never both.
+const DeleteUnboundAttachmentsQuery = `
+	DELETE FROM message_attachments
+	WHERE message_id IS NULL
+	  AND created_at < CURRENT_TIMESTAMP - $1 * INTERVAL '1 second'
+	RETURNING path;
+`
+
+// DeleteUnboundAttachments deletes uploads that were not attached to a
+// message within olderThan and returns their storage paths.
+func (cr *ChatRepo) DeleteUnboundAttachments(olderThan time.Duration) ([]string, error) {
+	rows, err := cr.DB.QueryContext(context.Background(), DeleteUnboundAttachmentsQuery, int(olderThan.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	return scanAttachmentPaths(rows)
+}
+
 const (
+	// DeleteMessageQuery returns the storage paths of the message's
+	// attachments, like DeleteChatBetweenUsersQuery.
 	DeleteMessageQuery = `
-		DELETE FROM messages
-		WHERE chat_id = $1 AND message_id = $2;
+		WITH deleted AS (
+			DELETE FROM messages
+			WHERE chat_id = $1 AND message_id = $2
+			RETURNING message_id
+		)
+		SELECT a.path
+		FROM message_attachments a
+		JOIN deleted d ON d.message_id = a.message_id;
 	`
 
 	GetLastMessageQuery = `
-		SELECT content FROM messages
-		WHERE chat_id = $1
-		ORDER BY created_at DESC
+		SELECT m.content, EXISTS (
+			SELECT 1 FROM message_attachments a
+			WHERE a.message_id = m.message_id
+		)
+		FROM messages m
+		WHERE m.chat_id = $1
+		ORDER BY m.created_at DESC, m.message_id DESC
 		LIMIT 1;
 	`
 
@@ -285,10 +593,13 @@ const (
 	`
 )
 
-func (cr *ChatRepo) DeleteMessage(messageID int, chatID int) error {
+// DeleteMessage deletes the message and returns the storage paths of its
+// attachments, which the caller has to remove. The paths also come back when
+// updating the caches fails after the commit.
+func (cr *ChatRepo) DeleteMessage(messageID int, chatID int) ([]string, error) {
 	tx, err := cr.DB.BeginTx(context.Background(), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer tx.Rollback()
 
@@ -300,40 +611,46 @@ func (cr *ChatRepo) DeleteMessage(messageID int, chatID int) error {
 		&deletedMsg.CreatedAt,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = tx.ExecContext(context.Background(), DeleteMessageQuery, chatID, messageID)
+	rows, err := tx.QueryContext(context.Background(), DeleteMessageQuery, chatID, messageID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	paths, err := scanAttachmentPaths(rows)
+	if err != nil {
+		return nil, err
 	}
 
 	var lastMsg string
-	err = tx.QueryRowContext(context.Background(), GetLastMessageQuery, chatID).Scan(&lastMsg)
+	var hasAttachments bool
+	err = tx.QueryRowContext(context.Background(), GetLastMessageQuery, chatID).Scan(&lastMsg, &hasAttachments)
 	if err == sql.ErrNoRows {
 		lastMsg = ""
 	} else if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = tx.ExecContext(context.Background(), UpdateLastMessageQuery, lastMsg, chatID)
+	_, err = tx.ExecContext(context.Background(), UpdateLastMessageQuery,
+		lastMessagePreview(lastMsg, hasAttachments), chatID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return err
+		return nil, err
 	}
 
 	firstID, secondID, err := cr.GetChatParticipants(chatID)
 	if err != nil {
-		return err
+		return paths, err
 	}
 	var receiverID int
 	for _, uid := range []int{firstID, secondID} {
 		existingMessages, err := cr.GetMessagesFromCache(chatID, uid)
 		if err != nil {
-			return err
+			return paths, err
 		}
 
 		var updated []model.Message
@@ -350,7 +667,7 @@ func (cr *ChatRepo) DeleteMessage(messageID int, chatID int) error {
 		}
 
 		if err := cr.updateMessageCache(chatID, uid, updated); err != nil {
-			return err
+			return paths, err
 		}
 
 	}
@@ -358,10 +675,150 @@ func (cr *ChatRepo) DeleteMessage(messageID int, chatID int) error {
 	channel := fmt.Sprintf("user:%d chat:%d messages", receiverID, chatID)
 	err = cr.Client.Publish(context.Background(), channel, "new").Err()
 	if err != nil {
-		return err
+		return paths, err
 	}
 
-	return nil
+	return paths, nil
+}
+
+const (
+	GetEditedMessageQuery = `
+		SELECT m.user_id, m.content, EXISTS (
+			SELECT 1 FROM message_attachments a
+			WHERE a.message_id = m.message_id
+		)
+		FROM messages m
+		WHERE m.chat_id = $1 AND m.message_id = $2
+		FOR UPDATE;
+	`
+
+	InsertMessageEditQuery = `
+		INSERT INTO message_edits (message_id, previous_content)
+		VALUES ($1, $2);
+	`
+
+	// UpdateMessageContentQuery changes nothing once the chat is closed.
+	UpdateMessageContentQuery = `
+		UPDATE messages m
+		SET content = $1, edited_at = CURRENT_TIMESTAMP
+		FROM chats c
+		WHERE m.message_id = $2
+		  AND c.chat_id = m.chat_id
+		  AND c.closed_at IS NULL
+		RETURNING m.message_id, m.user_id, m.content, m.status, m.created_at;
+	`
+
+	UpdateLastMessageIfLatestQuery = `
+		UPDATE chats
+		SET last_message = $1
+		WHERE chat_id = $2
+		AND (
+			SELECT message_id FROM messages
+			WHERE chat_id = $2
+			ORDER BY created_at DESC, message_id DESC
+			LIMIT 1
+		) = $3;
+	`
+)
+
+func (cr *ChatRepo) EditMessage(messageID int, chatID int, userID int, content string) (model.Message, error) {
+	tx, err := cr.DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return model.Message{}, err
+	}
+	defer tx.Rollback()
+
+	var ownerID int
+	var previousContent string
+	var hasAttachments bool
+	err = tx.QueryRowContext(context.Background(), GetEditedMessageQuery, chatID, messageID).Scan(&ownerID, &previousContent, &hasAttachments)
+	if err == sql.ErrNoRows {
+		return model.Message{}, model.ErrMessageNotFound
+	} else if err != nil {
+		return model.Message{}, err
+	}
+	if ownerID != userID {
+		return model.Message{}, model.ErrMessageNotOwned
+	}
+
+	_, err = tx.ExecContext(context.Background(), InsertMessageEditQuery, messageID, previousContent)
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	var edited model.Message
+	err = tx.QueryRowContext(context.Background(), UpdateMessageContentQuery, content, messageID).Scan(
+		&edited.MessageID,
+		&edited.SenderID,
+		&edited.Text,
+		&edited.Status,
+		&edited.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return model.Message{}, model.ErrChatClosed
+	} else if err != nil {
+		return model.Message{}, err
+	}
+	edited.Edited = true
+
+	_, err = tx.ExecContext(context.Background(), UpdateLastMessageIfLatestQuery,
+		lastMessagePreview(content, hasAttachments), chatID, messageID)
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.Message{}, err
+	}
+
+	firstID, secondID, err := cr.GetChatParticipants(chatID)
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	for _, uid := range []int{firstID, secondID} {
+		existingMessages, err := cr.GetMessagesFromCache(chatID, uid)
+		if err != nil {
+			return model.Message{}, err
+		}
+
+		changed := false
+		for i := range existingMessages {
+			if existingMessages[i].MessageID == messageID {
+				existingMessages[i].Text = edited.Text
+				existingMessages[i].Edited = true
+				changed = true
+			}
+		}
+
+		if changed {
+			if err := cr.updateMessageCache(chatID, uid, existingMessages); err != nil {
+				return model.Message{}, err
+			}
+		}
+	}
+
+	receiverID := firstID
+	if userID == firstID {
+		receiverID = secondID
+	}
+
+	event, err := json.Marshal(map[string]interface{}{
+		"type":    "edited",
+		"chat_id": chatID,
+		"message": edited,
+	})
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	channel := fmt.Sprintf("user:%d chat:%d messages", receiverID, chatID)
+	err = cr.Client.Publish(context.Background(), channel, event).Err()
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	return edited, nil
 }
 
 const (
@@ -373,12 +830,24 @@ const (
 	UpdateChatLastMessageQuery = `
 		UPDATE chats
 	SET last_message = $1, last_sender = $2
-	WHERE chat_id = $3;
+	WHERE chat_id = $3 AND closed_at IS NULL;
 
 	`
 )
 
-func (cr *ChatRepo) CreateMessage(chatID int, userID int, content string, status int) (int, error) {
+// lastMessagePreview builds chats.last_message: messages with attachments
+// start with the placeholder so the chat list still shows them.
+func lastMessagePreview(content string, hasAttachments bool) string {
+	if !hasAttachments {
+		return content
+	}
+	if content == "" {
+		return model.AttachmentPlaceholder
+	}
+	return model.AttachmentPlaceholder + " " + content
+}
+
+func (cr *ChatRepo) CreateMessage(chatID int, userID int, content string, status int, attachmentIDs []int) (int, error) {
 	tx, err := cr.DB.BeginTx(context.Background(), nil)
 	if err != nil {
 		return 0, err
@@ -391,10 +860,30 @@ func (cr *ChatRepo) CreateMessage(chatID int, userID int, content string, status
 		return 0, err
 	}
 
-	_, err = tx.ExecContext(context.Background(), UpdateChatLastMessageQuery, content, userID, chatID)
+	var attachments []model.Attachment
+	for _, attachmentID := range attachmentIDs {
+		attachment := model.Attachment{
+			AttachmentID: attachmentID,
+			URL:          fmt.Sprintf(model.AttachmentURLFormat, attachmentID),
+		}
+		err = tx.QueryRowContext(context.Background(),
+			BindAttachmentQuery, messageID, attachmentID, chatID, userID).Scan(&attachment.ContentType)
+		if err == sql.ErrNoRows {
+			return 0, model.ErrAttachmentNotFound
+		} else if err != nil {
+			return 0, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	res, err := tx.ExecContext(context.Background(), UpdateChatLastMessageQuery,
+		lastMessagePreview(content, len(attachments) > 0), userID, chatID)
 	if err != nil {
 		return 0, err
 	}
+	if updated, err := res.RowsAffected(); err == nil && updated == 0 {
+		return 0, model.ErrChatClosed
+	}
 
 	if err := tx.Commit(); err != nil {
 		return 0, err
@@ -418,11 +907,12 @@ func (cr *ChatRepo) CreateMessage(chatID int, userID int, content string, status
 	}
 
 	message := model.Message{
-		MessageID: messageID,
-		SenderID:  userID,
-		Text:      content,
-		Status:    status,
-		CreatedAt: time.Now(),
+		MessageID:   messageID,
+		SenderID:    userID,
+		Text:        content,
+		Status:      status,
+		CreatedAt:   time.Now(),
+		Attachments: attachments,
 	}
 	existingMessages = append(existingMessages, message)
 
@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
@@ -14,7 +16,10 @@ type ComplaintRepository interface {
 	CreateComplaint(complaint_by int, complaint_on int, ComplaintType string, text string) error
 	GetAllComplaints(ctx context.Context) ([]model.ComplaintWithLogins, error)
 	FindComplaint(complaint_by int, name_by string, complaint_on int, name_on string, complaint_type string, status int) ([]model.ComplaintWithLogins, error)
-	HandleComplaint(complaint_id int, new_status int) error
+	HandleComplaint(complaint_id int, admin_id int, new_status int, reason string, note string) error
+	AssignComplaint(complaint_id int, admin_id int, assignee_id int) error
+	AddComplaintNote(complaint_id int, author_id int, note string) (model.ComplaintNote, error)
+	GetComplaintHistory(complaint_id int) (model.ComplaintHistory, error)
 	GetStatistics(useFrom bool, from time.Time, useTo bool, to time.Time) (model.ComplaintStats, error)
 	DeleteComplaint(complaint_id int) error
 }
@@ -46,16 +51,23 @@ const (
 		RETURNING comp_type
 	`
 
+	// InsertComplaintQuery also opens the audit trail of the new complaint.
 	InsertComplaintQuery = `
-		INSERT INTO complaints (
-			complaint_by,
-			complaint_on,
-			complaint_type,
-			complaint_text,
-			status,
-			created_at
+		WITH inserted AS (
+			INSERT INTO complaints (
+				complaint_by,
+				complaint_on,
+				complaint_type,
+				complaint_text,
+				status,
+				created_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING complaint_id, complaint_by, status, created_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO complaint_audit_log (complaint_id, actor_id, action, to_status, created_at)
+		SELECT complaint_id, complaint_by, 'created', status, created_at
+		FROM inserted
 	`
 )
 
@@ -81,7 +93,7 @@ func (r *ComplaintRepo) CreateComplaint(complaintBy int, complaintOn int, compla
 		complaintOn,
 		compTypeID,
 		text,
-		1,
+		model.ComplaintPending,
 		time.Now(),
 	)
 	if err != nil {
@@ -253,15 +265,20 @@ func (cr *ComplaintRepo) DeleteComplaint(complaint_id int) error {
 }
 
 const (
-	GetTargetUserQuery = `
-		SELECT complaint_on
+	LockComplaintQuery = `
+		SELECT complaint_on, status, assigned_to
 		FROM complaints
 		WHERE complaint_id = $1
+		FOR UPDATE
 	`
 
+	// Going back to pending (1) reopens the case, so the resolution is cleared.
 	UpdateComplaintQuery = `
 		UPDATE complaints
-		SET status = $1, closed_at = CURRENT_TIMESTAMP
+		SET status = $1,
+		    closed_at = CASE WHEN $1 = 1 THEN NULL ELSE CURRENT_TIMESTAMP END,
+		    resolved_by = CASE WHEN $1 = 1 THEN NULL ELSE $3::bigint END,
+		    resolution_reason = CASE WHEN $1 = 1 THEN NULL ELSE NULLIF($4, '') END
 		WHERE complaint_id = $2
 	`
 
@@ -272,9 +289,53 @@ const (
 				SELECT 1 FROM blacklist WHERE user_id = $1
 			)
 		`
+
+	AssignComplaintQuery = `
+		UPDATE complaints
+		SET assigned_to = NULLIF($2, 0),
+		    assigned_at = CASE WHEN $2 = 0 THEN NULL ELSE CURRENT_TIMESTAMP END
+		WHERE complaint_id = $1
+	`
+
+	InsertComplaintNoteQuery = `
+		INSERT INTO complaint_notes (complaint_id, author_id, body)
+		SELECT $1, $2, $3
+		WHERE EXISTS (SELECT 1 FROM complaints WHERE complaint_id = $1)
+		RETURNING note_id, created_at
+	`
+
+	InsertComplaintAuditQuery = `
+		INSERT INTO complaint_audit_log (
+			complaint_id, actor_id, action, from_status, to_status, assignee_id, reason_code, note
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, ''))
+	`
+
+	GetComplaintCaseQuery = `
+		SELECT complaint_id, status, assigned_to, resolved_by, COALESCE(resolution_reason, '')
+		FROM complaints
+		WHERE complaint_id = $1
+	`
+
+	GetComplaintNotesQuery = `
+		SELECT note_id, author_id, body, created_at
+		FROM complaint_notes
+		WHERE complaint_id = $1
+		ORDER BY created_at, note_id
+	`
+
+	GetComplaintAuditQuery = `
+		SELECT audit_id, actor_id, action, from_status, to_status, assignee_id,
+		       COALESCE(reason_code, ''), COALESCE(note, ''), created_at
+		FROM complaint_audit_log
+		WHERE complaint_id = $1
+		ORDER BY audit_id
+	`
 )
 
-func (cr *ComplaintRepo) HandleComplaint(complaint_id int, new_status int) error {
+// HandleComplaint moves a complaint to new_status and records who did it and
+// why. Moves missing from model.ComplaintTransitions are refused.
+func (cr *ComplaintRepo) HandleComplaint(complaint_id int, admin_id int, new_status int, reason string, note string) error {
 	ctx := context.Background()
 	tx, err := cr.DB.BeginTx(ctx, nil)
 	if err != nil {
@@ -282,26 +343,210 @@ func (cr *ComplaintRepo) HandleComplaint(complaint_id int, new_status int) error
 	}
 	defer tx.Rollback()
 
-	var targetUserID int
-	err = tx.QueryRowContext(ctx, GetTargetUserQuery, complaint_id).Scan(&targetUserID)
+	var targetUserID, status int
+	var assignedTo sql.NullInt64
+	err = tx.QueryRowContext(ctx, LockComplaintQuery, complaint_id).Scan(&targetUserID, &status, &assignedTo)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrComplaintNotFound
+	}
 	if err != nil {
 		return err
 	}
-	_, err = tx.ExecContext(ctx, UpdateComplaintQuery, new_status, complaint_id)
+	if !slices.Contains(model.ComplaintTransitions[status], new_status) {
+		return model.ErrComplaintTransition
+	}
+
+	_, err = tx.ExecContext(ctx, UpdateComplaintQuery, new_status, complaint_id, admin_id, reason)
 	if err != nil {
 		return err
 	}
 
-	if new_status == 2 {
+	if new_status == model.ComplaintApproved {
 		_, err = tx.ExecContext(ctx, BlockUserQuery, targetUserID)
 		if err != nil {
 			return err
 		}
 	}
 
+	_, err = tx.ExecContext(ctx, InsertComplaintAuditQuery,
+		complaint_id, admin_id, model.ComplaintStatusChanged, status, new_status, nil, reason, note,
+	)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
+// AssignComplaint hands a complaint to assignee_id, or drops the assignment
+// when it is 0.
+func (cr *ComplaintRepo) AssignComplaint(complaint_id int, admin_id int, assignee_id int) error {
+	ctx := context.Background()
+	tx, err := cr.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var targetUserID, status int
+	var assignedTo sql.NullInt64
+	err = tx.QueryRowContext(ctx, LockComplaintQuery, complaint_id).Scan(&targetUserID, &status, &assignedTo)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrComplaintNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if int(assignedTo.Int64) == assignee_id {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, AssignComplaintQuery, complaint_id, assignee_id)
+	if err != nil {
+		return err
+	}
+
+	action := model.ComplaintAssigned
+	assignee := sql.NullInt64{Int64: int64(assignee_id), Valid: true}
+	if assignee_id == 0 {
+		action = model.ComplaintUnassigned
+		assignee = assignedTo
+	}
+	_, err = tx.ExecContext(ctx, InsertComplaintAuditQuery,
+		complaint_id, admin_id, action, nil, nil, assignee, "", "",
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (cr *ComplaintRepo) AddComplaintNote(complaint_id int, author_id int, note string) (model.ComplaintNote, error) {
+	ctx := context.Background()
+	tx, err := cr.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return model.ComplaintNote{}, err
+	}
+	defer tx.Rollback()
+
+	added := model.ComplaintNote{AuthorID: &author_id, Note: note}
+	err = tx.QueryRowContext(ctx, InsertComplaintNoteQuery, complaint_id, author_id, note).Scan(&added.NoteID, &added.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ComplaintNote{}, model.ErrComplaintNotFound
+	}
+	if err != nil {
+		return model.ComplaintNote{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, InsertComplaintAuditQuery,
+		complaint_id, author_id, model.ComplaintNoteAdded, nil, nil, nil, "", note,
+	)
+	if err != nil {
+		return model.ComplaintNote{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.ComplaintNote{}, err
+	}
+	return added, nil
+}
+
+// GetComplaintHistory returns a complaint's state with its notes and the
+// whole audit trail, oldest first.
+func (cr *ComplaintRepo) GetComplaintHistory(complaint_id int) (model.ComplaintHistory, error) {
+	ctx := context.Background()
+
+	var history model.ComplaintHistory
+	var assignedTo, resolvedBy sql.NullInt64
+	err := cr.DB.QueryRowContext(ctx, GetComplaintCaseQuery, complaint_id).Scan(
+		&history.ComplaintID,
+		&history.Status,
+		&assignedTo,
+		&resolvedBy,
+		&history.ResolutionReason,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ComplaintHistory{}, model.ErrComplaintNotFound
+	}
+	if err != nil {
+		return model.ComplaintHistory{}, err
+	}
+	history.AssignedTo = nullableInt(assignedTo)
+	history.ResolvedBy = nullableInt(resolvedBy)
+
+	history.Notes, err = cr.getComplaintNotes(ctx, complaint_id)
+	if err != nil {
+		return model.ComplaintHistory{}, err
+	}
+	history.Events, err = cr.getComplaintAudit(ctx, complaint_id)
+	if err != nil {
+		return model.ComplaintHistory{}, err
+	}
+	return history, nil
+}
+
+func (cr *ComplaintRepo) getComplaintNotes(ctx context.Context, complaint_id int) ([]model.ComplaintNote, error) {
+	rows, err := cr.DB.QueryContext(ctx, GetComplaintNotesQuery, complaint_id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notes := []model.ComplaintNote{}
+	for rows.Next() {
+		var note model.ComplaintNote
+		var authorID sql.NullInt64
+		if err := rows.Scan(&note.NoteID, &authorID, &note.Note, &note.CreatedAt); err != nil {
+			return nil, err
+		}
+		note.AuthorID = nullableInt(authorID)
+		notes = append(notes, note)
+	}
+	return notes, rows.Err()
+}
+
+func (cr *ComplaintRepo) getComplaintAudit(ctx context.Context, complaint_id int) ([]model.ComplaintAuditEntry, error) {
+	rows, err := cr.DB.QueryContext(ctx, GetComplaintAuditQuery, complaint_id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []model.ComplaintAuditEntry{}
+	for rows.Next() {
+		var event model.ComplaintAuditEntry
+		var fromStatus, toStatus, assigneeID sql.NullInt64
+		err := rows.Scan(
+			&event.AuditID,
+			&event.ActorID,
+			&event.Action,
+			&fromStatus,
+			&toStatus,
+			&assigneeID,
+			&event.Reason,
+			&event.Note,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		event.FromStatus = nullableInt(fromStatus)
+		event.ToStatus = nullableInt(toStatus)
+		event.AssigneeID = nullableInt(assigneeID)
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func nullableInt(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int64)
+	return &i
+}
+
 const getStatisticsQuery = `
 	SELECT
 		COUNT(*) AS total_complaints,
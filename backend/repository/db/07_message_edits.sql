@@ -0,0 +1,13 @@
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP;
+
+CREATE TABLE message_edits (
+    edit_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    message_id BIGINT NOT NULL,
+    previous_content TEXT NOT NULL,
+    edited_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (message_id) REFERENCES messages(message_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_edits_message_id ON message_edits(message_id);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON message_edits TO app_user;
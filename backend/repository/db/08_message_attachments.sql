@@ -0,0 +1,17 @@
+CREATE TABLE message_attachments (
+    attachment_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    chat_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    message_id BIGINT,
+    path TEXT NOT NULL CHECK (LENGTH(path) <= 255),
+    content_type TEXT NOT NULL CHECK (LENGTH(content_type) <= 255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (chat_id) REFERENCES chats(chat_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (message_id) REFERENCES messages(message_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_attachments_message_id ON message_attachments(message_id);
+CREATE INDEX IF NOT EXISTS idx_message_attachments_chat_id ON message_attachments(chat_id);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON message_attachments TO app_user;
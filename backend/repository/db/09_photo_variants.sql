@@ -0,0 +1,3 @@
+ALTER TABLE static
+    ADD COLUMN IF NOT EXISTS thumb_path TEXT CHECK (LENGTH(thumb_path) <= 255),
+    ADD COLUMN IF NOT EXISTS card_path TEXT CHECK (LENGTH(card_path) <= 255);
@@ -0,0 +1,22 @@
+ALTER TABLE static
+    ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS is_primary BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE static s
+SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY profile_id ORDER BY id) - 1 AS position
+    FROM static
+) ordered
+WHERE s.id = ordered.id;
+
+UPDATE static
+SET is_primary = TRUE
+WHERE position = 0
+  AND NOT EXISTS (
+      SELECT 1 FROM static other
+      WHERE other.profile_id = static.profile_id AND other.is_primary
+  );
+
+CREATE INDEX IF NOT EXISTS idx_static_profile_position ON static(profile_id, position);
+CREATE INDEX IF NOT EXISTS idx_static_primary ON static(profile_id) WHERE is_primary;
@@ -0,0 +1,12 @@
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS user_agent TEXT CHECK (LENGTH(user_agent) <= 512),
+    ADD COLUMN IF NOT EXISTS ip TEXT CHECK (LENGTH(ip) <= 64),
+    ADD COLUMN IF NOT EXISTS device TEXT CHECK (LENGTH(device) <= 128),
+    ADD COLUMN IF NOT EXISTS last_active_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+-- Rows written before sessions were tracked individually cannot be matched
+-- to a live session, so they would only clutter the device list.
+DELETE FROM sessions WHERE token = 'session_data';
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_token ON sessions(token);
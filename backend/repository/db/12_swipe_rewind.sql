@@ -0,0 +1,8 @@
+-- created_match remembers whether a swipe produced a match so that rewinding
+-- it can roll the match back; implied_by marks the reverse like a superlike
+-- inserts on behalf of the other profile and disappears together with it.
+ALTER TABLE likes
+    ADD COLUMN IF NOT EXISTS created_match BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS implied_by BIGINT REFERENCES likes(like_id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_likes_recent_by_liker ON likes(profile_id, created_at DESC) WHERE implied_by IS NULL;
@@ -0,0 +1,27 @@
+-- locations carry the coordinates of their city, taken from the gazetteer
+-- bundled with the profiles service. A profile may override them with a
+-- position reported by the client, stored snapped to a ~1 km grid.
+ALTER TABLE locations
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+
+ALTER TABLE profiles
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT profiles_coordinates_pair CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+-- Locations created before this migration; new ones are filled in on insert.
+UPDATE locations l
+SET latitude = c.latitude, longitude = c.longitude
+FROM (VALUES
+    ('США', 'Нью-Йорк', 40.7128, -74.0060),
+    ('Канада', 'Торонто', 43.6532, -79.3832),
+    ('Великобритания', 'Лондон', 51.5074, -0.1278),
+    ('Германия', 'Берлин', 52.5200, 13.4050),
+    ('Франция', 'Париж', 48.8566, 2.3522),
+    ('Россия', 'Москва', 55.7558, 37.6173),
+    ('Россия', 'Санкт-Петербург', 59.9343, 30.3351)
+) AS c(country, city, latitude, longitude)
+WHERE l.latitude IS NULL
+  AND LOWER(TRIM(l.country)) = LOWER(c.country)
+  AND LOWER(TRIM(l.city)) = LOWER(c.city);
@@ -0,0 +1,18 @@
+-- unmatches remembers pairs that broke their match so that neither profile is
+-- offered to the other again, even if one of the old swipes gets rewound.
+-- The pair is stored with the smaller profile id first.
+CREATE TABLE IF NOT EXISTS unmatches (
+    first_profile_id BIGINT NOT NULL,
+    second_profile_id BIGINT NOT NULL,
+    unmatched_by BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (first_profile_id, second_profile_id),
+    CHECK (first_profile_id < second_profile_id),
+    FOREIGN KEY (first_profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (second_profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (unmatched_by) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- A closed chat disappears from both chat lists and takes no new messages.
+-- Its messages are kept for moderation.
+ALTER TABLE chats ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
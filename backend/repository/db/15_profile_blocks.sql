@@ -0,0 +1,13 @@
+-- profile_blocks is the personal block list of every profile. Unlike the
+-- moderators' blacklist it only hides the two profiles from each other.
+CREATE TABLE IF NOT EXISTS profile_blocks (
+    blocker_id BIGINT NOT NULL,
+    blocked_id BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_blocks_blocked ON profile_blocks(blocked_id);
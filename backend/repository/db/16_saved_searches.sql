@@ -0,0 +1,17 @@
+-- saved_searches keeps named /profiles/search requests. The alerts worker
+-- looks for profiles created after last_checked_at and moves it forward.
+CREATE TABLE IF NOT EXISTS saved_searches (
+    saved_search_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (LENGTH(name) <= 100),
+    params JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT LOCALTIMESTAMP,
+    last_checked_at TIMESTAMP NOT NULL DEFAULT LOCALTIMESTAMP,
+    UNIQUE (profile_id, name),
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_last_checked ON saved_searches(last_checked_at);
+
+INSERT INTO notification_types (type_description) VALUES ('saved_search')
+ON CONFLICT (type_description) DO NOTHING;
@@ -0,0 +1,15 @@
+-- payload holds the typed event behind a notification (kind, actor, chat,
+-- deep link); content stays as the English fallback.
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS payload JSONB;
+
+-- Older message and match notifications only name the actor in content.
+UPDATE notifications n
+SET payload = jsonb_build_object(
+    'kind', nt.type_description,
+    'actorId', (substring(n.content FROM '[0-9]+'))::bigint
+)
+FROM notification_types nt
+WHERE n.notification_type = nt.notif_type
+  AND n.payload IS NULL
+  AND nt.type_description IN ('message', 'match')
+  AND n.content ~ '[0-9]+';
@@ -0,0 +1,13 @@
+-- notification_settings holds muted notification types and chats and the
+-- quiet hours of a profile. A profile without a row gets every notification.
+CREATE TABLE IF NOT EXISTS notification_settings (
+    profile_id BIGINT PRIMARY KEY,
+    settings JSONB NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Flowers used to live only in the Redis cache; they are stored like any
+-- other notification now.
+INSERT INTO notification_types (type_description) VALUES ('flowers')
+ON CONFLICT (type_description) DO NOTHING;
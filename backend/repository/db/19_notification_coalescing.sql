@@ -0,0 +1,13 @@
+-- Unread notifications with the same coalesce_key (one chat, one actor)
+-- fold into a single row: event_count grows and updated_at moves forward.
+-- Reading a notification releases its key for a fresh row.
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS coalesce_key TEXT,
+    ADD COLUMN IF NOT EXISTS event_count INT NOT NULL DEFAULT 1 CHECK (event_count > 0),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE notifications SET updated_at = created_at WHERE created_at IS NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_unread_coalesce
+    ON notifications(user_id, notification_type, coalesce_key)
+    WHERE read_at IS NULL AND coalesce_key IS NOT NULL;
@@ -0,0 +1,24 @@
+-- notification_outbox holds outbound messages (email, webhook) until a
+-- sender accepts them. Failed sends are retried at next_attempt_at with a
+-- growing delay and marked 'failed' after the last attempt.
+CREATE TABLE IF NOT EXISTS notification_outbox (
+    outbox_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    channel TEXT NOT NULL CHECK (channel IN ('email', 'webhook')),
+    recipient TEXT NOT NULL CHECK (LENGTH(recipient) <= 255),
+    subject TEXT NOT NULL CHECK (LENGTH(subject) <= 255),
+    body TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_outbox_pending
+    ON notification_outbox(next_attempt_at) WHERE status = 'pending';
+
+-- digested_at marks unread notifications already summed up in a digest.
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS digested_at TIMESTAMP;
@@ -0,0 +1,62 @@
+-- Complaints become moderation cases: a case can be assigned to an admin,
+-- collects internal notes and is resolved with a reason code.
+ALTER TABLE complaints
+    ADD COLUMN IF NOT EXISTS assigned_to BIGINT REFERENCES users(user_id) ON DELETE SET NULL ON UPDATE CASCADE,
+    ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS resolved_by BIGINT REFERENCES users(user_id) ON DELETE SET NULL ON UPDATE CASCADE,
+    ADD COLUMN IF NOT EXISTS resolution_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_complaints_assigned_to ON complaints(assigned_to) WHERE assigned_to IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS complaint_notes (
+    note_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    complaint_id BIGINT NOT NULL,
+    author_id BIGINT,
+    body TEXT NOT NULL CHECK (LENGTH(body) BETWEEN 1 AND 2000),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (complaint_id) REFERENCES complaints(complaint_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users(user_id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_complaint_notes_complaint ON complaint_notes(complaint_id, created_at);
+
+-- complaint_audit_log is append-only. It has no foreign keys, so the trail
+-- outlives deleted complaints and users.
+CREATE TABLE IF NOT EXISTS complaint_audit_log (
+    audit_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    complaint_id BIGINT NOT NULL,
+    actor_id BIGINT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('created', 'status_changed', 'assigned', 'unassigned', 'note_added')),
+    from_status INT,
+    to_status INT,
+    assignee_id BIGINT,
+    reason_code TEXT,
+    note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_complaint_audit_log_complaint ON complaint_audit_log(complaint_id, audit_id);
+
+CREATE OR REPLACE FUNCTION forbid_audit_log_change()
+RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'complaint_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS complaint_audit_log_append_only ON complaint_audit_log;
+CREATE TRIGGER complaint_audit_log_append_only
+BEFORE UPDATE OR DELETE ON complaint_audit_log
+FOR EACH ROW
+EXECUTE FUNCTION forbid_audit_log_change();
+
+-- existing complaints start their trail with the status they have now
+INSERT INTO complaint_audit_log (complaint_id, actor_id, action, to_status, created_at)
+SELECT c.complaint_id, c.complaint_by, 'created', c.status, COALESCE(c.created_at, CURRENT_TIMESTAMP)
+FROM complaints c
+WHERE NOT EXISTS (
+    SELECT 1 FROM complaint_audit_log a WHERE a.complaint_id = c.complaint_id
+);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON complaint_notes TO app_user;
+GRANT SELECT, INSERT ON complaint_audit_log TO app_user;
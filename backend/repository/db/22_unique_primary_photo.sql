@@ -0,0 +1,14 @@
+-- A profile has at most one primary photo. Duplicates left by concurrent
+-- uploads keep the photo that comes first in the gallery.
+UPDATE static s
+SET is_primary = FALSE
+WHERE s.is_primary
+  AND EXISTS (
+      SELECT 1 FROM static other
+      WHERE other.profile_id = s.profile_id
+        AND other.is_primary
+        AND (other.position, other.id) < (s.position, s.id)
+  );
+
+DROP INDEX IF EXISTS idx_static_primary;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_static_primary ON static(profile_id) WHERE is_primary;
@@ -0,0 +1,4 @@
+-- removed_match marks a dislike that ended a match. The like it replaced is
+-- gone, so rewinding such a dislike could not bring the match back.
+ALTER TABLE likes
+    ADD COLUMN IF NOT EXISTS removed_match BOOLEAN NOT NULL DEFAULT FALSE;
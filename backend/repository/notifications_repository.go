@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -20,6 +21,11 @@ type NotificationsRepository interface {
 
 	GetCurrentNotifications(userID int) ([]model.NotificationSend, error)
 	AddNotification(userID int, notif model.NotificationSend) error
+	StoreNotification(userID int, notif model.NotificationSend) error
+	DeleteLatestNotification(userID int, notifType string, actorID int) error
+
+	GetNotificationSettings(userID int) (model.NotificationSettings, error)
+	UpdateNotificationSettings(userID int, settings model.NotificationSettings) error
 }
 
 type RedisClient interface {
@@ -29,6 +35,8 @@ type RedisClient interface {
 	Close() error
 	Ping(ctx context.Context) *redis.StatusCmd
 	Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd
+	Watch(ctx context.Context, fn func(*redis.Tx) error, keys ...string) error
+	redis.Scripter
 }
 
 type NotificationsRepo struct {
@@ -69,17 +77,63 @@ func (nr *NotificationsRepo) CloseRepo() error {
 	return nr.Client.Close()
 }
 
+// pushNotificationScript puts ARGV[1] at the head of the cached list after
+// removing the cached entries with its notification id (ARGV[2]), so a fold
+// replaces its entry in one step. ARGV[3] is the list's TTL in seconds.
+var pushNotificationScript = redis.NewScript(`
+local id = tonumber(ARGV[2])
+for _, item in ipairs(redis.call('LRANGE', KEYS[1], 0, 99)) do
+    local ok, cached = pcall(cjson.decode, item)
+    if ok and type(cached) == 'table' and cached['notificationID'] == id then
+        redis.call('LREM', KEYS[1], 0, item)
+    end
+end
+redis.call('LPUSH', KEYS[1], ARGV[1])
+redis.call('LTRIM', KEYS[1], 0, 99)
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+// notificationsCacheRetries bounds how often a cache update is retried after
+// a concurrent write to the same list.
+const notificationsCacheRetries = 5
+
+// updateCache reads the cached list of redisKey and queues the writes update
+// makes of it in one transaction. The key is watched, so a concurrent write
+// makes the update start over from a fresh read.
+func (nr *NotificationsRepo) updateCache(redisKey string, update func(items []string, pipe redis.Pipeliner)) error {
+	for i := 0; i < notificationsCacheRetries; i++ {
+		err := nr.Client.Watch(nr.Ctx, func(tx *redis.Tx) error {
+			items, err := tx.LRange(nr.Ctx, redisKey, 0, 99).Result()
+			if err != nil && err != redis.Nil {
+				return err
+			}
+			_, err = tx.TxPipelined(nr.Ctx, func(pipe redis.Pipeliner) error {
+				update(items, pipe)
+				return nil
+			})
+			return err
+		}, redisKey)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+	return redis.TxFailedErr
+}
+
 const GetNotificationQuery = `
 SELECT
     n.notification_id,
     nt.type_description,
     n.content,
-	n.created_at,
+    n.payload,
+    n.event_count,
+	COALESCE(n.updated_at, n.created_at),
 	n.read_at
 FROM notifications n
 JOIN notification_types nt ON n.notification_type = nt.notif_type
 WHERE n.user_id = $1
-ORDER BY n.created_at DESC;
+ORDER BY COALESCE(n.updated_at, n.created_at) DESC;
 `
 
 func (nr *NotificationsRepo) GetNotifications(userID int) ([]model.NotificationSend, error) {
@@ -90,13 +144,22 @@ func (nr *NotificationsRepo) GetNotifications(userID int) ([]model.NotificationS
 	defer rows.Close()
 
 	var notifications []model.NotificationSend
-	var CreatedAt time.Time
 	for rows.Next() {
 		var notif model.NotificationSend
+		var UpdatedAt sql.NullTime
 		var ReadAt sql.NullTime
-		if err := rows.Scan(&notif.NotificationID, &notif.NotifType, &notif.Content, &CreatedAt, &ReadAt); err != nil {
+		var payload []byte
+		if err := rows.Scan(&notif.NotificationID, &notif.NotifType, &notif.Content, &payload, &notif.Count, &UpdatedAt, &ReadAt); err != nil {
 			return nil, err
 		}
+		notif.UpdatedAt = UpdatedAt.Time
+		// rows written before payloads existed have none
+		if len(payload) > 0 {
+			notif.Payload = &model.NotificationPayload{}
+			if err := notif.Payload.UnmarshalJSON(payload); err != nil {
+				return nil, err
+			}
+		}
 		if !ReadAt.Valid {
 			notif.Read = 0
 		} else {
@@ -130,37 +193,28 @@ func (nr *NotificationsRepo) MarkNotifications(userID int, notifType string) err
 	redisKey := fmt.Sprintf("CACHE:user:%dnotifications", userID)
 	fmt.Println(redisKey)
 
-	items, err := nr.Client.LRange(nr.Ctx, redisKey, 0, 99).Result()
-	if err != nil {
-		if err == redis.Nil {
-			return nil
+	return nr.updateCache(redisKey, func(items []string, pipe redis.Pipeliner) {
+		notifications := make([]string, 0, len(items))
+		for _, item := range items {
+			var notif model.NotificationSend
+			if err := json.Unmarshal([]byte(item), &notif); err != nil {
+				continue
+			}
+			if notif.NotifType == notifType {
+				notif.Read = 1
+			}
+			updated, err := json.Marshal(notif)
+			if err != nil {
+				continue
+			}
+			notifications = append(notifications, string(updated))
 		}
-		return err
-	}
 
-	notifications := make([]string, 0, len(items))
-	for _, item := range items {
-		var notif model.NotificationSend
-		if err := json.Unmarshal([]byte(item), &notif); err != nil {
-			continue
-		}
-		if notif.NotifType == notifType {
-			notif.Read = 1
+		pipe.Del(nr.Ctx, redisKey)
+		if len(notifications) > 0 {
+			pipe.RPush(nr.Ctx, redisKey, notifications)
 		}
-		updated, err := json.Marshal(notif)
-		if err != nil {
-			continue
-		}
-		notifications = append(notifications, string(updated))
-	}
-
-	pipe := nr.Client.TxPipeline()
-	pipe.Del(nr.Ctx, redisKey)
-	if len(notifications) > 0 {
-		pipe.RPush(nr.Ctx, redisKey, notifications)
-	}
-	_, err = pipe.Exec(nr.Ctx)
-	return err
+	})
 }
 
 const DeleteNotification = `
@@ -206,50 +260,192 @@ func (nr *NotificationsRepo) GetCurrentNotifications(userID int) ([]model.Notifi
 	return notifications, nil
 }
 
+// AddNotificationQuery clears digested_at when it folds, so the next digest
+// covers the events that came after the last one.
 const AddNotificationQuery = `
-INSERT INTO notifications (user_id, notification_type, content)
+INSERT INTO notifications (user_id, notification_type, content, payload, coalesce_key)
 VALUES (
     $1,
     (SELECT notif_type FROM notification_types WHERE type_description = $2),
-    $3
+    $3,
+    $4,
+    $5
 )
-RETURNING notification_id;
+ON CONFLICT (user_id, notification_type, coalesce_key)
+    WHERE read_at IS NULL AND coalesce_key IS NOT NULL
+DO UPDATE SET
+    content = EXCLUDED.content,
+    payload = EXCLUDED.payload,
+    event_count = notifications.event_count + 1,
+    updated_at = CURRENT_TIMESTAMP,
+    digested_at = NULL
+RETURNING notification_id, event_count, updated_at;
 `
 
-func (nr *NotificationsRepo) AddNotification(userID int, notif model.NotificationSend) error {
+// coalesceKey groups the unread notifications that fold into one entry:
+// messages per chat, saved-search alerts per search, everything else per actor.
+func coalesceKey(notif model.NotificationSend) sql.NullString {
+	if notif.Payload == nil {
+		return sql.NullString{}
+	}
+	switch {
+	case notif.Payload.ChatID != 0:
+		return sql.NullString{String: fmt.Sprintf("chat:%d", notif.Payload.ChatID), Valid: true}
+	case notif.Payload.SearchID != 0:
+		return sql.NullString{String: fmt.Sprintf("search:%d", notif.Payload.SearchID), Valid: true}
+	case notif.Payload.ActorID != 0:
+		return sql.NullString{String: fmt.Sprintf("actor:%d", notif.Payload.ActorID), Valid: true}
+	}
+	return sql.NullString{}
+}
+
+// insertNotification saves notif or folds it into the matching unread one,
+// and returns the stored entry.
+func (nr *NotificationsRepo) insertNotification(userID int, notif model.NotificationSend) (model.NotificationSend, error) {
+	var payload []byte
+	if notif.Payload != nil {
+		var err error
+		if payload, err = notif.Payload.MarshalJSON(); err != nil {
+			return notif, err
+		}
+	}
 
-	var notifID int
 	err := nr.DB.QueryRowContext(
 		context.Background(),
 		AddNotificationQuery,
 		userID,
 		notif.NotifType,
 		notif.Content,
-	).Scan(&notifID)
+		payload,
+		coalesceKey(notif),
+	).Scan(&notif.NotificationID, &notif.Count, &notif.UpdatedAt)
+	return notif, err
+}
+
+// StoreNotification only saves the notification, so the user sees it the
+// next time the list is loaded.
+func (nr *NotificationsRepo) StoreNotification(userID int, notif model.NotificationSend) error {
+	_, err := nr.insertNotification(userID, notif)
+	return err
+}
+
+// AddNotification saves notif and pushes it to the user's clients. A folded
+// notification replaces its entry in the cache and is pushed as an update.
+func (nr *NotificationsRepo) AddNotification(userID int, notif model.NotificationSend) error {
+	notif, err := nr.insertNotification(userID, notif)
 	if err != nil {
 		return err
 	}
 
-	notif.NotificationID = notifID
-
 	redisKey := fmt.Sprintf("CACHE:user:%dnotifications", userID)
 	jsonNotif, err := json.Marshal(notif)
 	if err != nil {
 		return err
 	}
 
-	pipe := nr.Client.TxPipeline()
-	pipe.LPush(nr.Ctx, redisKey, jsonNotif)
-	pipe.LTrim(nr.Ctx, redisKey, 0, 99)
-	pipe.Expire(nr.Ctx, redisKey, 30*time.Hour)
-	if _, err := pipe.Exec(nr.Ctx); err != nil {
+	err = pushNotificationScript.Run(nr.Ctx, nr.Client, []string{redisKey},
+		jsonNotif, notif.NotificationID, int((30 * time.Hour).Seconds()),
+	).Err()
+	if err != nil {
 		return err
 	}
 
+	var event interface{} = "new"
+	if notif.Count > 1 {
+		frame, err := json.Marshal(map[string]interface{}{
+			"type":         "notification_updated",
+			"notification": notif,
+		})
+		if err != nil {
+			return err
+		}
+		event = frame
+	}
+
 	channel := fmt.Sprintf("user:%d notifications", userID)
-	if err := nr.Client.Publish(context.Background(), channel, "new").Err(); err != nil {
+	if err := nr.Client.Publish(context.Background(), channel, event).Err(); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+const DeleteLatestNotificationQuery = `
+DELETE FROM notifications
+WHERE notification_id = (
+    SELECT n.notification_id
+    FROM notifications n
+    JOIN notification_types nt ON n.notification_type = nt.notif_type
+    WHERE n.user_id = $1 AND nt.type_description = $2 AND n.payload->>'actorId' = $3::text
+    ORDER BY n.created_at DESC, n.notification_id DESC
+    LIMIT 1
+)
+RETURNING notification_id;
+`
+
+// DeleteLatestNotification removes the newest notification of a type caused
+// by actorID, e.g. when the event behind it is undone, and tells the user's
+// clients to drop it.
+func (nr *NotificationsRepo) DeleteLatestNotification(userID int, notifType string, actorID int) error {
+	var notificationID int
+	err := nr.DB.QueryRowContext(context.Background(), DeleteLatestNotificationQuery, userID, notifType, actorID).
+		Scan(&notificationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	redisKey := fmt.Sprintf("CACHE:user:%dnotifications", userID)
+	if err := nr.Client.Del(nr.Ctx, redisKey).Err(); err != nil {
+		return err
+	}
+
+	frame, err := json.Marshal(map[string]interface{}{
+		"type":     "notification_deleted",
+		"notif_id": notificationID,
+	})
+	if err != nil {
+		return err
+	}
+	channel := fmt.Sprintf("user:%d notifications", userID)
+	return nr.Client.Publish(context.Background(), channel, frame).Err()
+}
+
+const GetNotificationSettingsQuery = `
+SELECT settings FROM notification_settings WHERE profile_id = $1;
+`
+
+const UpdateNotificationSettingsQuery = `
+INSERT INTO notification_settings (profile_id, settings)
+VALUES ($1, $2)
+ON CONFLICT (profile_id) DO UPDATE
+SET settings = EXCLUDED.settings, updated_at = CURRENT_TIMESTAMP;
+`
+
+func (nr *NotificationsRepo) GetNotificationSettings(userID int) (model.NotificationSettings, error) {
+	settings := model.NotificationSettings{MutedTypes: []string{}, MutedChats: []int{}}
+
+	var raw []byte
+	err := nr.DB.QueryRowContext(context.Background(), GetNotificationSettingsQuery, userID).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return settings, nil
+	}
+	if err != nil {
+		return settings, err
+	}
+	if err := settings.UnmarshalJSON(raw); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+func (nr *NotificationsRepo) UpdateNotificationSettings(userID int, settings model.NotificationSettings) error {
+	raw, err := settings.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_, err = nr.DB.ExecContext(context.Background(), UpdateNotificationSettingsQuery, userID, raw)
+	return err
+}
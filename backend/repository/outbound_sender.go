@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+)
+
+// Sender delivers outbound messages of one channel.
+type Sender interface {
+	Send(ctx context.Context, msg model.OutboundMessage) error
+}
+
+var errHeaderInjection = errors.New("line break in mail header")
+
+type SMTPSender struct {
+	Addr string
+	From string
+	Auth smtp.Auth
+}
+
+// NewSMTPSender reads SMTP_ADDR and SMTP_FROM; SMTP_USER and SMTP_PASSWORD
+// are only needed by servers that ask for auth.
+func NewSMTPSender() (*SMTPSender, error) {
+	addr := os.Getenv("SMTP_ADDR")
+	from := os.Getenv("SMTP_FROM")
+	if addr == "" || from == "" {
+		return nil, model.ErrSenderNotConfigured
+	}
+
+	sender := &SMTPSender{Addr: addr, From: from}
+	if user := os.Getenv("SMTP_USER"); user != "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		sender.Auth = smtp.PlainAuth("", user, os.Getenv("SMTP_PASSWORD"), host)
+	}
+	return sender, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg model.OutboundMessage) error {
+	data, err := s.compose(msg)
+	if err != nil {
+		return err
+	}
+
+	host, _, err := net.SplitHostPort(s.Addr)
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.Auth != nil {
+		if err := client.Auth(s.Auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(s.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(msg.Recipient); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (s *SMTPSender) compose(msg model.OutboundMessage) ([]byte, error) {
+	for _, header := range []string{s.From, msg.Recipient, msg.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, errHeaderInjection
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.Recipient)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+
+	body := strings.ReplaceAll(msg.Body, "\r\n", "\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	buf.WriteString("\r\n")
+	return buf.Bytes(), nil
+}
+
+// WebhookSender posts messages as JSON to URL. With a Secret the body is
+// signed into X-Signature as "sha256=<hex hmac>".
+type WebhookSender struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+func NewWebhookSender() (*WebhookSender, error) {
+	url := os.Getenv("WEBHOOK_URL")
+	if url == "" {
+		return nil, model.ErrSenderNotConfigured
+	}
+	return &WebhookSender{
+		URL:    url,
+		Secret: os.Getenv("WEBHOOK_SECRET"),
+		Client: &http.Client{},
+	}, nil
+}
+
+func (s *WebhookSender) Send(ctx context.Context, msg model.OutboundMessage) error {
+	body, err := msg.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.Secret))
+		mac.Write(body)
+		req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %d", resp.StatusCode)
+	}
+	return nil
+}
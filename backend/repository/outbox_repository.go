@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+type OutboxRepository interface {
+	CreateDigests(olderThan time.Duration, kinds []string, compose func(model.Digest) []model.OutboundMessage) (int, error)
+	ClaimOutbound(limit int, lease time.Duration) ([]model.OutboundMessage, error)
+	MarkSent(outboxID int) error
+	Retry(outboxID int, lastError string, delay time.Duration) error
+	MarkFailed(outboxID int, lastError string) error
+}
+
+type OutboxRepo struct {
+	DB *sql.DB
+}
+
+func NewOutboxRepo() (*OutboxRepo, error) {
+	cfg := InitPostgresConfig()
+	db, err := InitPostgresConnection(cfg)
+	if err != nil {
+		fmt.Println("Error connecting to database:", err)
+		return &OutboxRepo{}, err
+	}
+	return &OutboxRepo{
+		DB: db,
+	}, nil
+}
+
+const (
+	// ClaimDigestNotificationsQuery marks the notifications it returns, so a
+	// notification is part of one digest at most. It leaves out notifications
+	// of a muted type or chat, and those of users in their quiet hours, which
+	// a later run picks up once the quiet hours are over.
+	ClaimDigestNotificationsQuery = `
+		UPDATE notifications n
+		SET digested_at = LOCALTIMESTAMP
+		FROM notification_types nt, users u
+		WHERE nt.notif_type = n.notification_type
+		  AND u.user_id = n.user_id
+		  AND nt.type_description = ANY($2::text[])
+		  AND n.read_at IS NULL
+		  AND n.digested_at IS NULL
+		  AND COALESCE(n.updated_at, n.created_at) <= LOCALTIMESTAMP - make_interval(secs => $1)
+		  AND NOT EXISTS (
+		      SELECT 1
+		      FROM notification_settings ns
+		      LEFT JOIN LATERAL (
+		          SELECT (ns.settings->'quietHours'->>'start')::time AS starts,
+		                 (ns.settings->'quietHours'->>'end')::time AS ends,
+		                 (NOW() AT TIME ZONE (ns.settings->'quietHours'->>'timezone'))::time AS local
+		          WHERE ns.settings->'quietHours'->>'timezone' IN (SELECT name FROM pg_timezone_names)
+		      ) quiet ON TRUE
+		      WHERE ns.profile_id = n.user_id
+		        AND (
+		            ns.settings->'mutedTypes' ? nt.type_description
+		            OR ns.settings->'mutedChats' @> jsonb_build_array(n.payload->'chatId')
+		            OR CASE WHEN quiet.starts <= quiet.ends
+		                THEN quiet.local >= quiet.starts AND quiet.local < quiet.ends
+		                ELSE quiet.local >= quiet.starts OR quiet.local < quiet.ends
+		            END
+		        )
+		  )
+		RETURNING n.user_id, u.email, nt.type_description, n.event_count;
+	`
+
+	EnqueueOutboundQuery = `
+		INSERT INTO notification_outbox (user_id, channel, recipient, subject, body)
+		VALUES ($1, $2, $3, $4, $5);
+	`
+
+	// ClaimOutboundQuery counts the attempt up front and moves next_attempt_at
+	// past the lease, so a message whose backend died is sent again later.
+	ClaimOutboundQuery = `
+		UPDATE notification_outbox o
+		SET attempts = o.attempts + 1,
+		    next_attempt_at = LOCALTIMESTAMP + make_interval(secs => $2)
+		FROM (
+			SELECT outbox_id
+			FROM notification_outbox
+			WHERE status = 'pending' AND next_attempt_at <= LOCALTIMESTAMP
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		) due
+		WHERE o.outbox_id = due.outbox_id
+		RETURNING o.outbox_id, o.user_id, o.channel, o.recipient, o.subject, o.body, o.attempts;
+	`
+
+	MarkOutboundSentQuery = `
+		UPDATE notification_outbox
+		SET status = 'sent', sent_at = LOCALTIMESTAMP, last_error = NULL
+		WHERE outbox_id = $1;
+	`
+
+	RetryOutboundQuery = `
+		UPDATE notification_outbox
+		SET last_error = $2, next_attempt_at = LOCALTIMESTAMP + make_interval(secs => $3)
+		WHERE outbox_id = $1;
+	`
+
+	MarkOutboundFailedQuery = `
+		UPDATE notification_outbox
+		SET status = 'failed', last_error = $2
+		WHERE outbox_id = $1;
+	`
+)
+
+// CreateDigests claims unread notifications of kinds older than olderThan
+// and queues the messages compose makes of each user's digest. Both happen
+// in one transaction, so a failure leaves the notifications for the next run.
+func (ob *OutboxRepo) CreateDigests(olderThan time.Duration, kinds []string, compose func(model.Digest) []model.OutboundMessage) (int, error) {
+	ctx := context.Background()
+	tx, err := ob.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, ClaimDigestNotificationsQuery, olderThan.Seconds(), kinds)
+	if err != nil {
+		return 0, err
+	}
+
+	digests := map[int]*model.Digest{}
+	order := []int{}
+	for rows.Next() {
+		var userID, count int
+		var email, kind string
+		if err := rows.Scan(&userID, &email, &kind, &count); err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		digest, ok := digests[userID]
+		if !ok {
+			digest = &model.Digest{UserID: userID, Email: email}
+			digests[userID] = digest
+			order = append(order, userID)
+		}
+		switch kind {
+		case model.NotificationMatch:
+			digest.Matches += count
+		case model.NotificationMessage:
+			digest.Messages += count
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, userID := range order {
+		for _, msg := range compose(*digests[userID]) {
+			_, err := tx.ExecContext(ctx, EnqueueOutboundQuery,
+				msg.UserID, msg.Channel, msg.Recipient, msg.Subject, msg.Body,
+			)
+			if err != nil {
+				return 0, err
+			}
+			queued++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return queued, nil
+}
+
+// ClaimOutbound returns up to limit pending messages that are due.
+func (ob *OutboxRepo) ClaimOutbound(limit int, lease time.Duration) ([]model.OutboundMessage, error) {
+	rows, err := ob.DB.QueryContext(context.Background(), ClaimOutboundQuery, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []model.OutboundMessage{}
+	for rows.Next() {
+		var msg model.OutboundMessage
+		err := rows.Scan(&msg.ID, &msg.UserID, &msg.Channel, &msg.Recipient, &msg.Subject, &msg.Body, &msg.Attempts)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (ob *OutboxRepo) MarkSent(outboxID int) error {
+	_, err := ob.DB.ExecContext(context.Background(), MarkOutboundSentQuery, outboxID)
+	return err
+}
+
+// Retry puts a message back to be sent again after delay.
+func (ob *OutboxRepo) Retry(outboxID int, lastError string, delay time.Duration) error {
+	_, err := ob.DB.ExecContext(context.Background(), RetryOutboundQuery, outboxID, lastError, delay.Seconds())
+	return err
+}
+
+func (ob *OutboxRepo) MarkFailed(outboxID int, lastError string) error {
+	_, err := ob.DB.ExecContext(context.Background(), MarkOutboundFailedQuery, outboxID, lastError)
+	return err
+}
@@ -1,13 +1,13 @@
 package repository
 
 import (
-	"crypto/sha256"
-	"fmt"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/utils"
 )
 
 type PasswordHasher interface {
-	Hash(password string) string
+	Hash(password string) (string, error)
 	Compare(hashedPassword, login, password string) bool
+	NeedsRehash(hashedPassword string) bool
 }
 
 type PassHasher struct{}
@@ -16,12 +16,14 @@ func NewPassHasher() (*PassHasher, error) {
 	return &PassHasher{}, nil
 }
 
-func (ph *PassHasher) Hash(password string) string {
-	hash := sha256.New()
-	hash.Write([]byte(password))
-	return fmt.Sprintf("%x", hash.Sum(nil))
+func (ph *PassHasher) Hash(password string) (string, error) {
+	return utils.HashPassword(password)
 }
 
-func (ph *PassHasher) Compare(hashedPassword, salt, inputPassword string) bool {
-	return hashedPassword == ph.Hash(salt+"_"+inputPassword)
+func (ph *PassHasher) Compare(hashedPassword, login, inputPassword string) bool {
+	return utils.VerifyPassword(hashedPassword, login, inputPassword)
+}
+
+func (ph *PassHasher) NeedsRehash(hashedPassword string) bool {
+	return utils.PasswordNeedsRehash(hashedPassword)
 }
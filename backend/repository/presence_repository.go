@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	presenceKey = "presence:%d"
+	lastSeenKey = "last_seen:%d"
+	typingKey   = "typing:%d:%d"
+	eventsKey   = "user:%d events"
+	chatKey     = "user:%d chat:%d messages"
+)
+
+// presence:%d is a sorted set of the user's realtime connections on all
+// backend instances, each scored by the time it expires unless a heartbeat
+// renews it. Connections of an instance that died drop out on their own.
+
+type PresenceRepository interface {
+	SetOnline(userID int, connID string) (first bool, err error)
+	SetOffline(userID int, connID string) (last bool, err error)
+	Heartbeat(userID int, connID string) (restored bool, err error)
+	GetPresence(userIDs []int) ([]model.Presence, error)
+	TryTyping(chatID int, userID int) (bool, error)
+	PublishEvent(userID int, event []byte) error
+	PublishChatEvent(userID int, chatID int, event []byte) error
+}
+
+type PresenceRepo struct {
+	Client *redis.Client
+	Ctx    context.Context
+	Now    func() time.Time
+}
+
+func NewPresenceRepo(client *redis.Client) *PresenceRepo {
+	return &PresenceRepo{
+		Client: client,
+		Ctx:    context.Background(),
+		Now:    time.Now,
+	}
+}
+
+func presenceScore(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+// touchConnection drops the user's expired connections, adds or renews
+// connID and returns whether connID was new and how many are left.
+func (pr *PresenceRepo) touchConnection(userID int, connID string) (bool, int64, error) {
+	key := fmt.Sprintf(presenceKey, userID)
+	now := pr.Now()
+
+	pipe := pr.Client.TxPipeline()
+	pipe.ZRemRangeByScore(pr.Ctx, key, "-inf", presenceScore(now))
+	added := pipe.ZAdd(pr.Ctx, key, &redis.Z{Score: float64(now.Add(model.PresenceTTL).UnixMilli()), Member: connID})
+	count := pipe.ZCard(pr.Ctx, key)
+	pipe.Expire(pr.Ctx, key, model.PresenceTTL)
+	pipe.Set(pr.Ctx, fmt.Sprintf(lastSeenKey, userID), now.Unix(), model.LastSeenTTL)
+	if _, err := pipe.Exec(pr.Ctx); err != nil {
+		return false, 0, err
+	}
+	return added.Val() == 1, count.Val(), nil
+}
+
+// SetOnline adds a connection and reports whether it is the user's only live
+// one on any instance.
+func (pr *PresenceRepo) SetOnline(userID int, connID string) (bool, error) {
+	_, count, err := pr.touchConnection(userID, connID)
+	if err != nil {
+		return false, err
+	}
+	return count == 1, nil
+}
+
+// SetOffline removes a connection and reports whether the user has no live
+// connection left on any instance.
+func (pr *PresenceRepo) SetOffline(userID int, connID string) (bool, error) {
+	key := fmt.Sprintf(presenceKey, userID)
+	now := pr.Now()
+
+	pipe := pr.Client.TxPipeline()
+	pipe.ZRem(pr.Ctx, key, connID)
+	pipe.ZRemRangeByScore(pr.Ctx, key, "-inf", presenceScore(now))
+	count := pipe.ZCard(pr.Ctx, key)
+	pipe.Set(pr.Ctx, fmt.Sprintf(lastSeenKey, userID), now.Unix(), model.LastSeenTTL)
+	if _, err := pipe.Exec(pr.Ctx); err != nil {
+		return false, err
+	}
+	return count.Val() == 0, nil
+}
+
+// Heartbeat renews a connection and reports whether it had expired while no
+// other connection was live, in which case peers saw the user go offline.
+func (pr *PresenceRepo) Heartbeat(userID int, connID string) (bool, error) {
+	added, count, err := pr.touchConnection(userID, connID)
+	if err != nil {
+		return false, err
+	}
+	return added && count == 1, nil
+}
+
+func (pr *PresenceRepo) GetPresence(userIDs []int) ([]model.Presence, error) {
+	result := make([]model.Presence, 0, len(userIDs))
+	for _, userID := range userIDs {
+		presence, err := getPresence(pr.Ctx, pr.Client, userID, pr.Now())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, presence)
+	}
+	return result, nil
+}
+
+// TryTyping reports whether a typing event may be sent now; repeated
+// events from the same user in the same chat are dropped for TypingInterval.
+func (pr *PresenceRepo) TryTyping(chatID int, userID int) (bool, error) {
+	return pr.Client.SetNX(pr.Ctx, fmt.Sprintf(typingKey, chatID, userID), 1, model.TypingInterval).Result()
+}
+
+func (pr *PresenceRepo) PublishEvent(userID int, event []byte) error {
+	return pr.Client.Publish(pr.Ctx, fmt.Sprintf(eventsKey, userID), event).Err()
+}
+
+func (pr *PresenceRepo) PublishChatEvent(userID int, chatID int, event []byte) error {
+	return pr.Client.Publish(pr.Ctx, fmt.Sprintf(chatKey, userID, chatID), event).Err()
+}
+
+func getPresence(ctx context.Context, client *redis.Client, userID int, now time.Time) (model.Presence, error) {
+	presence := model.Presence{ProfileId: userID}
+
+	live, err := client.ZCount(ctx, fmt.Sprintf(presenceKey, userID), "("+presenceScore(now), "+inf").Result()
+	if err != nil {
+		return model.Presence{}, err
+	}
+	presence.IsOnline = live > 0
+
+	lastSeen, err := client.Get(ctx, fmt.Sprintf(lastSeenKey, userID)).Result()
+	if err == redis.Nil {
+		return presence, nil
+	} else if err != nil {
+		return model.Presence{}, err
+	}
+
+	ts, err := strconv.ParseInt(lastSeen, 10, 64)
+	if err != nil {
+		return model.Presence{}, err
+	}
+	presence.LastSeen = time.Unix(ts, 0)
+
+	return presence, nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const quotaKey = "quota:%s:%d:%s"
+
+// consumeQuotaScript increments a counter unless that would take it past the
+// limit (ARGV[1], negative for none) and returns {allowed, used}. The counter
+// expires at ARGV[2], the Unix time the quota resets.
+var consumeQuotaScript = redis.NewScript(`
+local used = tonumber(redis.call('GET', KEYS[1]) or '0')
+local limit = tonumber(ARGV[1])
+if limit >= 0 and used + 1 > limit then
+    return {0, used}
+end
+used = redis.call('INCR', KEYS[1])
+redis.call('EXPIREAT', KEYS[1], ARGV[2])
+return {1, used}
+`)
+
+// refundQuotaScript gives one unit back without going below zero.
+var refundQuotaScript = redis.NewScript(`
+local used = tonumber(redis.call('GET', KEYS[1]) or '0')
+if used > 0 then
+    return redis.call('DECR', KEYS[1])
+end
+return 0
+`)
+
+type QuotaRepository interface {
+	Consume(userID int, kind string, limit int, resetAt time.Time) (allowed bool, used int, err error)
+	Refund(userID int, kind string, resetAt time.Time) error
+	Used(userID int, kinds []string, resetAt time.Time) (map[string]int, error)
+}
+
+type QuotaRepo struct {
+	Client *redis.Client
+	Ctx    context.Context
+}
+
+func NewQuotaRepo(client *redis.Client) *QuotaRepo {
+	return &QuotaRepo{
+		Client: client,
+		Ctx:    context.Background(),
+	}
+}
+
+// Counters are keyed by the day they reset on, so a new day starts from zero
+// even if an old key has not expired yet.
+func quotaCounterKey(userID int, kind string, resetAt time.Time) string {
+	return fmt.Sprintf(quotaKey, kind, userID, resetAt.UTC().Format("2006-01-02"))
+}
+
+func (qr *QuotaRepo) Consume(userID int, kind string, limit int, resetAt time.Time) (bool, int, error) {
+	res, err := consumeQuotaScript.Run(qr.Ctx, qr.Client,
+		[]string{quotaCounterKey(userID, kind, resetAt)}, limit, resetAt.Unix(),
+	).Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	if len(res) != 2 {
+		return false, 0, fmt.Errorf("unexpected quota script result: %v", res)
+	}
+
+	allowed, _ := res[0].(int64)
+	used, _ := res[1].(int64)
+	return allowed == 1, int(used), nil
+}
+
+func (qr *QuotaRepo) Refund(userID int, kind string, resetAt time.Time) error {
+	return refundQuotaScript.Run(qr.Ctx, qr.Client, []string{quotaCounterKey(userID, kind, resetAt)}).Err()
+}
+
+func (qr *QuotaRepo) Used(userID int, kinds []string, resetAt time.Time) (map[string]int, error) {
+	keys := make([]string, len(kinds))
+	for i, kind := range kinds {
+		keys[i] = quotaCounterKey(userID, kind, resetAt)
+	}
+
+	values, err := qr.Client.MGet(qr.Ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]int, len(kinds))
+	for i, kind := range kinds {
+		if s, ok := values[i].(string); ok {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid quota counter %q: %w", keys[i], err)
+			}
+			used[kind] = n
+		}
+	}
+	return used, nil
+}
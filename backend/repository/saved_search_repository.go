@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+type SavedSearchRepository interface {
+	SaveSearch(profileID int, name string, params model.SearchProfileRequest) (model.SavedSearch, error)
+	GetSavedSearches(profileID int) ([]model.SavedSearch, error)
+	DeleteSavedSearch(profileID int, searchID int) (bool, error)
+	ClaimDueSearches(interval time.Duration, limit int) ([]model.SavedSearchAlert, error)
+	RestoreLastChecked(searchID int, checkedAt time.Time) error
+}
+
+type SavedSearchRepo struct {
+	DB *sql.DB
+}
+
+func NewSavedSearchRepo() (*SavedSearchRepo, error) {
+	cfg := InitPostgresConfig()
+	db, err := InitPostgresConnection(cfg)
+	if err != nil {
+		fmt.Println("Error connecting to database:", err)
+		return &SavedSearchRepo{}, err
+	}
+	return &SavedSearchRepo{
+		DB: db,
+	}, nil
+}
+
+const (
+	// Saving under an existing name replaces its params; a new name is only
+	// inserted while the profile stays under the limit.
+	SaveSearchQuery = `
+		INSERT INTO saved_searches (profile_id, name, params)
+		SELECT $1, $2, $3
+		WHERE (
+			SELECT COUNT(*) FROM saved_searches
+			WHERE profile_id = $1 AND name <> $2
+		) < $4
+		ON CONFLICT (profile_id, name) DO UPDATE
+		SET params = EXCLUDED.params, last_checked_at = LOCALTIMESTAMP
+		RETURNING saved_search_id, created_at;
+	`
+
+	GetSavedSearchesQuery = `
+		SELECT saved_search_id, name, params, created_at
+		FROM saved_searches
+		WHERE profile_id = $1
+		ORDER BY created_at, saved_search_id;
+	`
+
+	DeleteSavedSearchQuery = `
+		DELETE FROM saved_searches
+		WHERE saved_search_id = $1 AND profile_id = $2;
+	`
+
+	// ClaimDueSearchesQuery moves last_checked_at of the searches it returns,
+	// so that several backends never alert on the same window twice.
+	ClaimDueSearchesQuery = `
+		UPDATE saved_searches s
+		SET last_checked_at = LOCALTIMESTAMP
+		FROM (
+			SELECT saved_search_id, last_checked_at
+			FROM saved_searches
+			WHERE last_checked_at <= LOCALTIMESTAMP - make_interval(secs => $1)
+			ORDER BY last_checked_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		) due
+		WHERE s.saved_search_id = due.saved_search_id
+		RETURNING s.saved_search_id, s.profile_id, s.name, s.params, due.last_checked_at;
+	`
+
+	RestoreLastCheckedQuery = `
+		UPDATE saved_searches
+		SET last_checked_at = $2
+		WHERE saved_search_id = $1;
+	`
+)
+
+func (sr *SavedSearchRepo) SaveSearch(profileID int, name string, params model.SearchProfileRequest) (model.SavedSearch, error) {
+	raw, err := params.MarshalJSON()
+	if err != nil {
+		return model.SavedSearch{}, err
+	}
+
+	search := model.SavedSearch{Name: name, Params: params}
+	err = sr.DB.QueryRowContext(context.Background(), SaveSearchQuery,
+		profileID, name, raw, model.MaxSavedSearches,
+	).Scan(&search.ID, &search.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.SavedSearch{}, model.ErrTooManySavedSearches
+	}
+	if err != nil {
+		return model.SavedSearch{}, err
+	}
+	return search, nil
+}
+
+func (sr *SavedSearchRepo) GetSavedSearches(profileID int) ([]model.SavedSearch, error) {
+	rows, err := sr.DB.QueryContext(context.Background(), GetSavedSearchesQuery, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	searches := []model.SavedSearch{}
+	for rows.Next() {
+		var search model.SavedSearch
+		var raw []byte
+		if err := rows.Scan(&search.ID, &search.Name, &raw, &search.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := search.Params.UnmarshalJSON(raw); err != nil {
+			return nil, err
+		}
+		searches = append(searches, search)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+func (sr *SavedSearchRepo) DeleteSavedSearch(profileID int, searchID int) (bool, error) {
+	res, err := sr.DB.ExecContext(context.Background(), DeleteSavedSearchQuery, searchID, profileID)
+	if err != nil {
+		return false, err
+	}
+	deleted, err := res.RowsAffected()
+	return deleted > 0, err
+}
+
+// ClaimDueSearches returns up to limit searches not checked for interval.
+func (sr *SavedSearchRepo) ClaimDueSearches(interval time.Duration, limit int) ([]model.SavedSearchAlert, error) {
+	rows, err := sr.DB.QueryContext(context.Background(), ClaimDueSearchesQuery, interval.Seconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alerts := []model.SavedSearchAlert{}
+	for rows.Next() {
+		var alert model.SavedSearchAlert
+		var raw []byte
+		if err := rows.Scan(&alert.SearchID, &alert.ProfileID, &alert.Name, &raw, &alert.Since); err != nil {
+			return nil, err
+		}
+		if err := alert.Params.UnmarshalJSON(raw); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// RestoreLastChecked gives a claimed search back when its check failed.
+func (sr *SavedSearchRepo) RestoreLastChecked(searchID int, checkedAt time.Time) error {
+	_, err := sr.DB.ExecContext(context.Background(), RestoreLastCheckedQuery, searchID, checkedAt)
+	return err
+}
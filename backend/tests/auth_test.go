@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alicebob/miniredis/v2"
+	sessionpb "github.com/go-park-mail-ru/2025_1_ProVVeb/auth_micro/proto"
 	auth "github.com/go-park-mail-ru/2025_1_ProVVeb/auth_micro/server"
 	"github.com/go-redis/redis/v8"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestSessionRepo_CreateAndStoreSession(t *testing.T) {
@@ -37,9 +40,9 @@ func TestSessionRepo_CreateAndStoreSession(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
 	INSERT INTO sessions (user_id, token, created_at, expires_at)
-	VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '72 hours')
+	VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
 	RETURNING id;
-	`)).WithArgs(123, testData).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	`)).WithArgs(123, testData, session.Expires.Seconds()).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	err := repo.StoreSession(123, session.SessionId, testData, session.Expires)
 	assert.NoError(t, err)
@@ -214,9 +217,9 @@ func TestStoreSessionA(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		INSERT INTO sessions (user_id, token, created_at, expires_at)
-		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '72 hours')
+		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
 		RETURNING id;
-	`)).WithArgs(123, data).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	`)).WithArgs(123, data, ttl.Seconds()).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	err := repo.StoreSession(123, sessionID, data, ttl)
 	assert.NoError(t, err)
@@ -242,15 +245,15 @@ func TestDeleteAllSessions(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		INSERT INTO sessions (user_id, token, created_at, expires_at)
-		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '72 hours')
+		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
 		RETURNING id;
-	`)).WithArgs(userId1, data1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	`)).WithArgs(userId1, data1, (10 * time.Second).Seconds()).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		INSERT INTO sessions (user_id, token, created_at, expires_at)
-		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '72 hours')
+		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
 		RETURNING id;
-	`)).WithArgs(userId2, data2).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	`)).WithArgs(userId2, data2, (10 * time.Second).Seconds()).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	_ = repo.StoreSession(userId1, sess1, data1, 10*time.Second)
 	_ = repo.StoreSession(userId2, sess2, data2, 10*time.Second)
@@ -285,9 +288,9 @@ func TestRetrieveSessionData(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		INSERT INTO sessions (user_id, token, created_at, expires_at)
-		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '72 hours')
+		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
 		RETURNING id;
-	`)).WithArgs(123, data).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	`)).WithArgs(123, data, (5 * time.Second).Seconds()).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	err := repo.StoreSession(123, sessionID, data, 5*time.Second)
 	assert.NoError(t, err)
@@ -300,35 +303,184 @@ func TestRetrieveSessionData(t *testing.T) {
 func TestRemoveSessionEntry(t *testing.T) {
 	repo := initTestRepo(t)
 
-	sessionID := "delete_me"
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 	repo.DB = db
 
-	mock.ExpectQuery(regexp.QuoteMeta(`
-		INSERT INTO sessions (user_id, token, created_at, expires_at)
-		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '72 hours')
-		RETURNING id;
-	`)).WithArgs(123, "bye").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	current := model.Session{SessionId: "delete_me", UserId: 123, Expires: time.Minute}
+	other := model.Session{SessionId: "keep_me", UserId: 123, Expires: time.Minute}
 
-	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id FROM sessions WHERE user_id = $1;
-	`)).WithArgs(123).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(auth.StoreUserSessionQuery)).
+		WithArgs(123, auth.HashSessionId(current.SessionId), "ua", "127.0.0.1", "Unknown device", float64(60)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(auth.StoreUserSessionQuery)).
+		WithArgs(123, auth.HashSessionId(other.SessionId), "ua", "127.0.0.2", "Unknown device", float64(60)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(auth.DeleteSessionQuery)).
+		WithArgs(auth.HashSessionId(current.SessionId)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(1, 123))
+
+	assert.NoError(t, repo.StoreUserSession(current, model.SessionMeta{UserAgent: "ua", IP: "127.0.0.1", Device: "Unknown device"}))
+	assert.NoError(t, repo.StoreUserSession(other, model.SessionMeta{UserAgent: "ua", IP: "127.0.0.2", Device: "Unknown device"}))
+
+	err := repo.DeleteSession(current.SessionId)
+	assert.NoError(t, err)
+
+	_, err = repo.GetSession(current.SessionId)
+	assert.Equal(t, model.ErrSessionNotFound, err)
+
+	val, err := repo.GetSession(other.SessionId)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", val)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeSessions(t *testing.T) {
+	repo := initTestRepo(t)
+
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo.DB = db
+
+	sessions := []model.Session{
+		{SessionId: "phone", UserId: 7, Expires: time.Minute},
+		{SessionId: "laptop", UserId: 7, Expires: time.Minute},
+		{SessionId: "tablet", UserId: 7, Expires: time.Minute},
+	}
+	for i, session := range sessions {
+		mock.ExpectQuery(regexp.QuoteMeta(auth.StoreUserSessionQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(i + 1))
+		assert.NoError(t, repo.StoreUserSession(session, model.SessionMeta{}))
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(auth.RevokeSessionQuery)).
+		WithArgs(1, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	assert.NoError(t, repo.RevokeSession(7, 1))
+
+	_, err := repo.GetSession("phone")
+	assert.Equal(t, model.ErrSessionNotFound, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(auth.RevokeSessionQuery)).
+		WithArgs(1, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	assert.Equal(t, model.ErrSessionNotFound, repo.RevokeSession(7, 1))
+
+	// the laptop already has a row, so adopting it inserts nothing
+	mock.ExpectQuery(regexp.QuoteMeta(auth.AdoptSessionQuery)).
+		WithArgs(7, auth.HashSessionId("laptop"), time.Minute.Seconds()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(auth.RevokeOtherSessionsQuery)).
+		WithArgs(7, auth.HashSessionId("laptop")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	assert.NoError(t, repo.RevokeOtherSessions(7, "laptop"))
+
+	_, err = repo.GetSession("tablet")
+	assert.Equal(t, model.ErrSessionNotFound, err)
 
-	mock.ExpectExec(regexp.QuoteMeta(`
-		DELETE FROM sessions WHERE user_id = $1;
-	`)).WithArgs(123).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(auth.SessionRowExistsQuery)).
+		WithArgs(auth.HashSessionId("laptop")).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	val, err := repo.GetSession("laptop")
+	assert.NoError(t, err)
+	assert.Equal(t, "7", val)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeOtherSessions_EndsUntrackedSessions(t *testing.T) {
+	repo := initTestRepo(t)
+
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo.DB = db
 
-	_ = repo.StoreSession(123, sessionID, "bye", 5*time.Second)
+	// sessions from before the sessions table have a Redis key and nothing else
+	repo.Client.Set(repo.Ctx, "old_phone", "7", time.Minute)
+	repo.Client.Set(repo.Ctx, "old_laptop", "7", time.Minute)
+
+	mock.ExpectQuery(regexp.QuoteMeta(auth.AdoptSessionQuery)).
+		WithArgs(7, auth.HashSessionId("old_laptop"), time.Minute.Seconds()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectQuery(regexp.QuoteMeta(auth.RevokeOtherSessionsQuery)).
+		WithArgs(7, auth.HashSessionId("old_laptop")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	assert.NoError(t, repo.RevokeOtherSessions(7, "old_laptop"))
+
+	mock.ExpectQuery(regexp.QuoteMeta(auth.SessionRowExistsQuery)).
+		WithArgs(auth.HashSessionId("old_phone")).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	_, err := repo.GetSession("old_phone")
+	assert.Equal(t, model.ErrSessionNotFound, err)
+	_, err = repo.GetSession("old_phone")
+	assert.Equal(t, model.ErrSessionNotFound, err)
 
-	err := repo.Client.Set(repo.Ctx, sessionID, "123", 0).Err()
+	mock.ExpectQuery(regexp.QuoteMeta(auth.SessionRowExistsQuery)).
+		WithArgs(auth.HashSessionId("old_laptop")).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	val, err := repo.GetSession("old_laptop")
 	assert.NoError(t, err)
+	assert.Equal(t, "7", val)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeviceFromUserAgent(t *testing.T) {
+	assert.Equal(t, "Chrome on Android", auth.DeviceFromUserAgent(
+		"Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0 Mobile Safari/537.36"))
+	assert.Equal(t, "Safari on iPhone", auth.DeviceFromUserAgent(
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1"))
+	assert.Equal(t, "Unknown device", auth.DeviceFromUserAgent("curl/8.0"))
+}
+
+func TestRandStringRunes(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := auth.RandStringRunes(model.SessionIdLength)
+		assert.Regexp(t, `^[a-zA-Z0-9]+$`, id)
+		assert.Len(t, id, model.SessionIdLength)
+		assert.False(t, seen[id])
+		seen[id] = true
+	}
+}
+
+func TestRotateSession(t *testing.T) {
+	repo := initTestRepo(t)
+
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo.DB = db
 
-	err = repo.DeleteSession("123")
+	old := model.Session{SessionId: "old_session", UserId: 9, Expires: time.Minute}
+	mock.ExpectQuery(regexp.QuoteMeta(auth.StoreUserSessionQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	assert.NoError(t, repo.StoreUserSession(old, model.SessionMeta{}))
+
+	mock.ExpectQuery(regexp.QuoteMeta(auth.StoreUserSessionQuery)).
+		WithArgs(9, sqlmock.AnyArg(), "ua", "10.0.0.1", "Unknown device", model.SessionDuration.Seconds()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(auth.DeleteSessionQuery)).
+		WithArgs(auth.HashSessionId(old.SessionId)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(1, 9))
+
+	service := auth.NewSessionService(repo)
+	resp, err := service.RotateSession(context.Background(), &sessionpb.RotateSessionRequest{
+		SessionId: old.SessionId,
+		UserAgent: "ua",
+		Ip:        "10.0.0.1",
+	})
 	assert.NoError(t, err)
+	assert.Equal(t, int32(9), resp.GetUserId())
+	assert.NotEqual(t, old.SessionId, resp.GetSessionId())
+	assert.Equal(t, model.SessionDuration, resp.GetExpires().AsDuration())
 
-	_, err = repo.GetSession("123")
+	_, err = repo.GetSession(old.SessionId)
 	assert.Equal(t, model.ErrSessionNotFound, err)
+
+	val, err := repo.GetSession(resp.GetSessionId())
+	assert.NoError(t, err)
+	assert.Equal(t, "9", val)
+
+	_, err = service.RotateSession(context.Background(), &sessionpb.RotateSessionRequest{SessionId: old.SessionId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
 }
 
 func TestAttemptCheckerLogic(t *testing.T) {
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockRepo_BlockAndUnblock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.BlockRepo{DB: db}
+
+	mock.ExpectExec(regexp.QuoteMeta(repository.BlockProfileQuery)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.BlockProfileQuery)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(repository.UnblockProfileQuery)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	added, err := repo.Block(1, 2)
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = repo.Block(1, 2)
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	removed, err := repo.Unblock(1, 2)
+	assert.NoError(t, err)
+	assert.True(t, removed)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepo_GetBlockedAndIsBlocked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.BlockRepo{DB: db}
+	blockedAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetBlockedProfilesQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"blocked_id", "firstname", "lastname", "avatar", "created_at"}).
+			AddRow(2, "Ivan", "Petrov", "/static/2.jpg", blockedAt))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.IsBlockedQuery)).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	blocked, err := repo.GetBlocked(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.BlockedProfile{{
+		ProfileId: 2,
+		Name:      "Ivan Petrov",
+		Avatar:    "/static/2.jpg",
+		BlockedAt: blockedAt,
+	}}, blocked)
+
+	isBlocked, err := repo.IsBlocked(2, 1)
+	assert.NoError(t, err)
+	assert.True(t, isBlocked)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlocksUseCase_BlockClosesChat(t *testing.T) {
+	chatRepo, chatMock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	blockDB, blockMock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer blockDB.Close()
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewBlocksUseCase(
+		&repository.BlockRepo{DB: blockDB}, chatRepo, repository.NewPresenceRepo(chatRepo.Client), log,
+	)
+	assert.NoError(t, err)
+
+	events := chatRepo.Client.Subscribe(context.Background(), "user:2 events")
+	defer events.Close()
+	_, err = events.Receive(context.Background())
+	assert.NoError(t, err)
+
+	blockMock.ExpectExec(regexp.QuoteMeta(repository.BlockProfileQuery)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	chatMock.ExpectQuery(regexp.QuoteMeta(repository.CloseChatBetweenUsersQuery)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}).AddRow(9))
+
+	assert.NoError(t, uc.Block(1, 2))
+
+	msg, err := events.ReceiveMessage(context.Background())
+	assert.NoError(t, err)
+	var event map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(msg.Payload), &event))
+	assert.Equal(t, "chat_closed", event["type"])
+	assert.Equal(t, float64(9), event["chat_id"])
+
+	assert.NoError(t, blockMock.ExpectationsWereMet())
+	assert.NoError(t, chatMock.ExpectationsWereMet())
+}
+
+func TestBlocksUseCase_UnblockReopensMatchedChat(t *testing.T) {
+	chatRepo, chatMock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	blockDB, blockMock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer blockDB.Close()
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewBlocksUseCase(
+		&repository.BlockRepo{DB: blockDB}, chatRepo, repository.NewPresenceRepo(chatRepo.Client), log,
+	)
+	assert.NoError(t, err)
+
+	blockMock.ExpectExec(regexp.QuoteMeta(repository.UnblockProfileQuery)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	chatMock.ExpectQuery(regexp.QuoteMeta(repository.ReopenChatBetweenUsersQuery)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}))
+
+	assert.NoError(t, uc.Unblock(1, 2))
+
+	// nothing to reopen when the block was never there
+	blockMock.ExpectExec(regexp.QuoteMeta(repository.UnblockProfileQuery)).
+		WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.NoError(t, uc.Unblock(1, 3))
+
+	assert.NoError(t, blockMock.ExpectationsWereMet())
+	assert.NoError(t, chatMock.ExpectationsWereMet())
+}
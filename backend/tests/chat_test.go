@@ -2,11 +2,17 @@ package tests
 
 import (
 	"context"
+	"path/filepath"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
 	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 )
@@ -110,3 +116,521 @@ func TestChatRepo_UpdateMessageStatus(t *testing.T) {
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestChatRepo_GetMessagesPage(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	chatID := 1
+	beforeMessageID := 10
+	limit := 3
+
+	rows := sqlmock.NewRows([]string{"message_id", "user_id", "content", "status", "created_at", "edited"}).
+		AddRow(9, 1, "third", 2, time.Now(), false).
+		AddRow(8, 2, "second", 2, time.Now(), true).
+		AddRow(7, 1, "first", 1, time.Now(), false)
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetMessagesPageQuery)).
+		WithArgs(chatID, beforeMessageID, limit).
+		WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetAttachmentsInRangeQuery)).
+		WithArgs(chatID, 7, 9).
+		WillReturnRows(sqlmock.NewRows([]string{"attachment_id", "message_id", "content_type"}).
+			AddRow(3, 8, "image/png"))
+
+	messages, err := repo.GetMessagesPage(chatID, beforeMessageID, limit)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 3)
+	assert.Equal(t, 9, messages[0].MessageID)
+	assert.Equal(t, 7, messages[2].MessageID)
+	assert.Empty(t, messages[0].Attachments)
+	assert.Len(t, messages[1].Attachments, 1)
+	assert.Equal(t, "/messages/attachments/3", messages[1].Attachments[0].URL)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetMessagesPage_FirstPageLeavesOutCachedMessages(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+	uc, err := usecase.NewGetMessagesPageUseCase(repo, log)
+	assert.NoError(t, err)
+
+	// message 9 has not been handed to user 1 yet
+	repo.Client.Set(repo.Ctx, "chat:1:messages_user1", `[{"messageid":9,"senderid":2,"text":"unread","status":1}]`, 0)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetMessagesPageQuery)).
+		WithArgs(1, 0, model.DefaultMessagesPageSize+1).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "user_id", "content", "status", "created_at", "edited"}).
+			AddRow(9, 2, "unread", 1, time.Now(), false).
+			AddRow(8, 1, "read", 2, time.Now(), false))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetAttachmentsInRangeQuery)).
+		WithArgs(1, 8, 9).
+		WillReturnRows(sqlmock.NewRows([]string{"attachment_id", "message_id", "content_type"}))
+
+	page, fresh, err := uc.GetFirstPage(1, 1)
+	assert.NoError(t, err)
+	assert.Len(t, page.Messages, 1)
+	assert.Equal(t, 8, page.Messages[0].MessageID)
+	assert.Len(t, fresh, 1)
+	assert.Equal(t, 9, fresh[0].MessageID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_EditMessage(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	chatID := 1
+	messageID := 5
+	userID := 1
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetEditedMessageQuery)).
+		WithArgs(chatID, messageID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "content", "exists"}).AddRow(userID, "old", false))
+	mock.ExpectExec(regexp.QuoteMeta(repository.InsertMessageEditQuery)).
+		WithArgs(messageID, "old").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.UpdateMessageContentQuery)).
+		WithArgs("new", messageID).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "user_id", "content", "status", "created_at"}).
+			AddRow(messageID, userID, "new", 1, time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta(repository.UpdateLastMessageIfLatestQuery)).
+		WithArgs("new", chatID, messageID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetChatParticipantsQuery)).
+		WithArgs(chatID).
+		WillReturnRows(sqlmock.NewRows([]string{"first_profile_id", "second_profile_id"}).AddRow(1, 2))
+
+	repo.Client.Set(repo.Ctx, "chat:1:messages_user2", `[{"messageid":5,"senderid":1,"text":"old","status":1}]`, 0)
+
+	message, err := repo.EditMessage(messageID, chatID, userID, "new")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", message.Text)
+	assert.True(t, message.Edited)
+
+	cached, err := repo.GetMessagesFromCache(chatID, 2)
+	assert.NoError(t, err)
+	assert.Len(t, cached, 1)
+	assert.Equal(t, "new", cached[0].Text)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_EditMessage_KeepsAttachmentPlaceholder(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetEditedMessageQuery)).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "content", "exists"}).AddRow(1, "old", true))
+	mock.ExpectExec(regexp.QuoteMeta(repository.InsertMessageEditQuery)).
+		WithArgs(5, "old").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.UpdateMessageContentQuery)).
+		WithArgs("new", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "user_id", "content", "status", "created_at"}).
+			AddRow(5, 1, "new", 1, time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta(repository.UpdateLastMessageIfLatestQuery)).
+		WithArgs(model.AttachmentPlaceholder+" new", 1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetChatParticipantsQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"first_profile_id", "second_profile_id"}).AddRow(1, 2))
+
+	_, err := repo.EditMessage(5, 1, 1, "new")
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_DeleteMessage_KeepsAttachmentPlaceholder(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetDeletedMessageQuery)).
+		WithArgs(1, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "user_id", "content", "created_at"}).
+			AddRow(6, 1, "latest", time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.DeleteMessageQuery)).
+		WithArgs(1, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"path"}).AddRow("/chat_1/1_100.jpg"))
+	// the message before it is a photo without a caption
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetLastMessageQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"content", "exists"}).AddRow("", true))
+	mock.ExpectExec(regexp.QuoteMeta(repository.UpdateLastMessageQuery)).
+		WithArgs(model.AttachmentPlaceholder, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetChatParticipantsQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"first_profile_id", "second_profile_id"}).AddRow(1, 2))
+
+	paths, err := repo.DeleteMessage(6, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/chat_1/1_100.jpg"}, paths)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// fakeAttachmentStorage records the paths it was asked to delete.
+type fakeAttachmentStorage struct {
+	deleted []string
+}
+
+func (f *fakeAttachmentStorage) UploadAttachment(fileBytes []byte, filename, contentType string) error {
+	return nil
+}
+
+func (f *fakeAttachmentStorage) GetAttachment(filename string) ([]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeAttachmentStorage) DeleteAttachment(filename string) error {
+	f.deleted = append(f.deleted, filename)
+	return nil
+}
+
+func TestDeleteChat_RemovesAttachmentObjects(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.DeleteChatBetweenUsersQuery)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"path"}).
+			AddRow("/chat_3/1_100.jpg").
+			AddRow("/chat_3/2_200.png"))
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+	storage := &fakeAttachmentStorage{}
+	uc, err := usecase.NewDeleteChatUseCase(repo, storage, log)
+	assert.NoError(t, err)
+
+	assert.NoError(t, uc.DeleteChat(1, 2))
+	assert.Equal(t, []string{"/chat_3/1_100.jpg", "/chat_3/2_200.png"}, storage.deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSweepAttachments_RemovesUnsentUploads(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.DeleteUnboundAttachmentsQuery)).
+		WithArgs(int(model.UnboundAttachmentTTL.Seconds())).
+		WillReturnRows(sqlmock.NewRows([]string{"path"}).AddRow("/chat_3/1_100.jpg"))
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+	storage := &fakeAttachmentStorage{}
+	uc, err := usecase.NewSweepAttachmentsUseCase(repo, storage, log)
+	assert.NoError(t, err)
+
+	deleted, err := uc.Sweep(model.UnboundAttachmentTTL)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.Equal(t, []string{"/chat_3/1_100.jpg"}, storage.deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_EditMessage_NotOwner(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetEditedMessageQuery)).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "content", "exists"}).AddRow(2, "old", false))
+	mock.ExpectRollback()
+
+	_, err := repo.EditMessage(5, 1, 1, "new")
+	assert.ErrorIs(t, err, model.ErrMessageNotOwned)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_EditMessage_ClosedChat(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetEditedMessageQuery)).
+		WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "content", "exists"}).AddRow(1, "old", false))
+	mock.ExpectExec(regexp.QuoteMeta(repository.InsertMessageEditQuery)).
+		WithArgs(5, "old").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.UpdateMessageContentQuery)).
+		WithArgs("new", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id", "user_id", "content", "status", "created_at"}))
+	mock.ExpectRollback()
+
+	_, err := repo.EditMessage(5, 1, 1, "new")
+	assert.ErrorIs(t, err, model.ErrChatClosed)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// newTestPresenceRepo returns a presence repo on a fresh miniredis whose clock
+// the returned function moves forward.
+func newTestPresenceRepo(t *testing.T) (*repository.PresenceRepo, *miniredis.Miniredis, func(time.Duration)) {
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(redisServer.Close)
+
+	now := time.Now()
+	repo := repository.NewPresenceRepo(redis.NewClient(&redis.Options{Addr: redisServer.Addr()}))
+	repo.Now = func() time.Time { return now }
+
+	return repo, redisServer, func(d time.Duration) {
+		now = now.Add(d)
+		redisServer.FastForward(d)
+	}
+}
+
+func TestPresenceRepo_OnlineOffline(t *testing.T) {
+	repo, _, advance := newTestPresenceRepo(t)
+
+	first, err := repo.SetOnline(1, "phone")
+	assert.NoError(t, err)
+	assert.True(t, first)
+	presence, err := repo.GetPresence([]int{1, 2})
+	assert.NoError(t, err)
+	assert.True(t, presence[0].IsOnline)
+	assert.False(t, presence[0].LastSeen.IsZero())
+	assert.False(t, presence[1].IsOnline)
+	assert.True(t, presence[1].LastSeen.IsZero())
+
+	advance(model.PresenceTTL + time.Second)
+	presence, err = repo.GetPresence([]int{1})
+	assert.NoError(t, err)
+	assert.False(t, presence[0].IsOnline)
+
+	_, err = repo.SetOnline(1, "phone")
+	assert.NoError(t, err)
+	last, err := repo.SetOffline(1, "phone")
+	assert.NoError(t, err)
+	assert.True(t, last)
+	presence, err = repo.GetPresence([]int{1})
+	assert.NoError(t, err)
+	assert.False(t, presence[0].IsOnline)
+	assert.False(t, presence[0].LastSeen.IsZero())
+}
+
+func TestPresenceRepo_CountsConnectionsAcrossInstances(t *testing.T) {
+	phone, redisServer, _ := newTestPresenceRepo(t)
+	// a second instance sharing the same Redis
+	laptop := repository.NewPresenceRepo(redis.NewClient(&redis.Options{Addr: redisServer.Addr()}))
+	laptop.Now = phone.Now
+
+	first, err := phone.SetOnline(1, "phone")
+	assert.NoError(t, err)
+	assert.True(t, first)
+	first, err = laptop.SetOnline(1, "laptop")
+	assert.NoError(t, err)
+	assert.False(t, first)
+
+	last, err := phone.SetOffline(1, "phone")
+	assert.NoError(t, err)
+	assert.False(t, last)
+	presence, err := laptop.GetPresence([]int{1})
+	assert.NoError(t, err)
+	assert.True(t, presence[0].IsOnline)
+
+	last, err = laptop.SetOffline(1, "laptop")
+	assert.NoError(t, err)
+	assert.True(t, last)
+	assert.False(t, redisServer.Exists("presence:1"))
+}
+
+func TestPresenceRepo_HeartbeatAfterExpiryKeepsConnections(t *testing.T) {
+	repo, _, advance := newTestPresenceRepo(t)
+
+	_, err := repo.SetOnline(1, "phone")
+	assert.NoError(t, err)
+	_, err = repo.SetOnline(1, "laptop")
+	assert.NoError(t, err)
+
+	// both heartbeats are late, so every connection has expired
+	advance(model.PresenceTTL + time.Second)
+
+	restored, err := repo.Heartbeat(1, "phone")
+	assert.NoError(t, err)
+	assert.True(t, restored)
+	restored, err = repo.Heartbeat(1, "laptop")
+	assert.NoError(t, err)
+	assert.False(t, restored)
+
+	// the laptop is still connected, so closing the phone is not the last one
+	last, err := repo.SetOffline(1, "phone")
+	assert.NoError(t, err)
+	assert.False(t, last)
+	presence, err := repo.GetPresence([]int{1})
+	assert.NoError(t, err)
+	assert.True(t, presence[0].IsOnline)
+}
+
+func TestPresenceRepo_DeadInstanceExpires(t *testing.T) {
+	repo, _, advance := newTestPresenceRepo(t)
+
+	_, err := repo.SetOnline(1, "phone")
+	assert.NoError(t, err)
+	_, err = repo.SetOnline(1, "crashed")
+	assert.NoError(t, err)
+
+	// only the phone keeps sending heartbeats
+	advance(model.PresenceHeartbeat)
+	_, err = repo.Heartbeat(1, "phone")
+	assert.NoError(t, err)
+	advance(model.PresenceHeartbeat + time.Second)
+
+	last, err := repo.SetOffline(1, "phone")
+	assert.NoError(t, err)
+	assert.True(t, last)
+}
+
+func TestPresenceRepo_TryTyping(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer redisServer.Close()
+
+	repo := repository.NewPresenceRepo(redis.NewClient(&redis.Options{Addr: redisServer.Addr()}))
+
+	allowed, err := repo.TryTyping(1, 1)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = repo.TryTyping(1, 1)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	redisServer.FastForward(model.TypingInterval)
+	allowed, err = repo.TryTyping(1, 1)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+func TestChatRepo_CreateMessage_WithAttachment(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	chatID := 1
+	userID := 1
+	messageID := 11
+	attachmentID := 4
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.InsertMessageQuery)).
+		WithArgs(chatID, userID, "", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id"}).AddRow(messageID))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.BindAttachmentQuery)).
+		WithArgs(messageID, attachmentID, chatID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"content_type"}).AddRow("image/jpeg"))
+	mock.ExpectExec(regexp.QuoteMeta(repository.UpdateChatLastMessageQuery)).
+		WithArgs(model.AttachmentPlaceholder, userID, chatID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetChatParticipantsQuery)).
+		WithArgs(chatID).
+		WillReturnRows(sqlmock.NewRows([]string{"first_profile_id", "second_profile_id"}).AddRow(1, 2))
+
+	id, err := repo.CreateMessage(chatID, userID, "", 1, []int{attachmentID})
+	assert.NoError(t, err)
+	assert.Equal(t, messageID, id)
+
+	cached, err := repo.GetMessagesFromCache(chatID, 2)
+	assert.NoError(t, err)
+	assert.Len(t, cached, 1)
+	assert.Len(t, cached[0].Attachments, 1)
+	assert.Equal(t, "image/jpeg", cached[0].Attachments[0].ContentType)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_CreateMessage_ForeignAttachment(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.InsertMessageQuery)).
+		WithArgs(1, 1, "hi", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id"}).AddRow(12))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.BindAttachmentQuery)).
+		WithArgs(12, 5, 1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"content_type"}))
+	mock.ExpectRollback()
+
+	_, err := repo.CreateMessage(1, 1, "hi", 1, []int{5})
+	assert.ErrorIs(t, err, model.ErrAttachmentNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_CreateMessage_ClosedChat(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.InsertMessageQuery)).
+		WithArgs(1, 1, "hi", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id"}).AddRow(13))
+	mock.ExpectExec(regexp.QuoteMeta(repository.UpdateChatLastMessageQuery)).
+		WithArgs("hi", 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err := repo.CreateMessage(1, 1, "hi", 1, nil)
+	assert.ErrorIs(t, err, model.ErrChatClosed)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_CloseChat(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	repo.Client.Set(repo.Ctx, "chat:3:messages_user1", "[]", 0)
+	repo.Client.Set(repo.Ctx, "chat:3:messages_user2", "[]", 0)
+	repo.Client.Set(repo.Ctx, "chat:4:messages_user1", "[]", 0)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.CloseChatBetweenUsersQuery)).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}).AddRow(3))
+
+	chatID, err := repo.CloseChat(2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, chatID)
+
+	left, err := repo.Client.Exists(repo.Ctx, "chat:3:messages_user1", "chat:3:messages_user2").Result()
+	assert.NoError(t, err)
+	assert.Zero(t, left)
+	left, err = repo.Client.Exists(repo.Ctx, "chat:4:messages_user1").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), left)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_CloseChat_NoChat(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.CloseChatBetweenUsersQuery)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}))
+
+	chatID, err := repo.CloseChat(1, 2)
+	assert.NoError(t, err)
+	assert.Zero(t, chatID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"database/sql"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplaintRepo_HandleComplaintApproves(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.ComplaintRepo{DB: db}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.LockComplaintQuery)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"complaint_on", "status", "assigned_to"}).
+			AddRow(3, model.ComplaintPending, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.UpdateComplaintQuery)).
+		WithArgs(model.ComplaintApproved, 7, 1, "spam").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.BlockUserQuery)).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.InsertComplaintAuditQuery)).
+		WithArgs(7, 1, model.ComplaintStatusChanged, model.ComplaintPending, model.ComplaintApproved, nil, "spam", "sent links to 40 users").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.HandleComplaint(7, 1, model.ComplaintApproved, "spam", "sent links to 40 users")
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestComplaintRepo_HandleComplaintRefusesTransition(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.ComplaintRepo{DB: db}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.LockComplaintQuery)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"complaint_on", "status", "assigned_to"}).
+			AddRow(3, model.ComplaintApproved, nil))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.LockComplaintQuery)).
+		WithArgs(8).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	err = repo.HandleComplaint(7, 1, model.ComplaintRejected, "no_violation", "")
+	assert.ErrorIs(t, err, model.ErrComplaintTransition)
+
+	err = repo.HandleComplaint(8, 1, model.ComplaintRejected, "no_violation", "")
+	assert.ErrorIs(t, err, model.ErrComplaintNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestComplaintRepo_AssignComplaint(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.ComplaintRepo{DB: db}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.LockComplaintQuery)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"complaint_on", "status", "assigned_to"}).
+			AddRow(3, model.ComplaintPending, nil))
+	mock.ExpectExec(regexp.QuoteMeta(repository.AssignComplaintQuery)).
+		WithArgs(7, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.InsertComplaintAuditQuery)).
+		WithArgs(7, 1, model.ComplaintAssigned, nil, nil, sql.NullInt64{Int64: 2, Valid: true}, "", "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	// unassigning records who the case was taken from
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.LockComplaintQuery)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"complaint_on", "status", "assigned_to"}).
+			AddRow(3, model.ComplaintPending, 2))
+	mock.ExpectExec(regexp.QuoteMeta(repository.AssignComplaintQuery)).
+		WithArgs(7, 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.InsertComplaintAuditQuery)).
+		WithArgs(7, 2, model.ComplaintUnassigned, nil, nil, sql.NullInt64{Int64: 2, Valid: true}, "", "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.AssignComplaint(7, 1, 2))
+	assert.NoError(t, repo.AssignComplaint(7, 2, 0))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestComplaintRepo_GetComplaintHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.ComplaintRepo{DB: db}
+	at := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetComplaintCaseQuery)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"complaint_id", "status", "assigned_to", "resolved_by", "resolution_reason"}).
+			AddRow(7, model.ComplaintRejected, 2, 2, "no_violation"))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetComplaintNotesQuery)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"note_id", "author_id", "body", "created_at"}).
+			AddRow(4, 2, "checked the chat", at))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetComplaintAuditQuery)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"audit_id", "actor_id", "action", "from_status", "to_status", "assignee_id", "reason_code", "note", "created_at"}).
+			AddRow(1, 5, model.ComplaintCreated, nil, model.ComplaintPending, nil, "", "", at).
+			AddRow(2, 1, model.ComplaintAssigned, nil, nil, 2, "", "", at).
+			AddRow(3, 2, model.ComplaintStatusChanged, model.ComplaintPending, model.ComplaintRejected, nil, "no_violation", "", at))
+
+	history, err := repo.GetComplaintHistory(7)
+	assert.NoError(t, err)
+	assert.Equal(t, model.ComplaintRejected, history.Status)
+	assert.Equal(t, 2, *history.AssignedTo)
+	assert.Equal(t, "no_violation", history.ResolutionReason)
+	assert.Len(t, history.Notes, 1)
+	assert.Equal(t, "checked the chat", history.Notes[0].Note)
+	assert.Len(t, history.Events, 3)
+	assert.Nil(t, history.Events[0].FromStatus)
+	assert.Equal(t, model.ComplaintPending, *history.Events[0].ToStatus)
+	assert.Equal(t, 2, *history.Events[1].AssigneeID)
+	assert.Equal(t, model.ComplaintRejected, *history.Events[2].ToStatus)
+	assert.Equal(t, "no_violation", history.Events[2].Reason)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleComplaint_ValidatesResolution(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewHandleComplaintUseCase(&repository.ComplaintRepo{DB: db}, log)
+	assert.NoError(t, err)
+
+	err = uc.HandleComplaint(7, 1, model.ComplaintApproved, "", "")
+	assert.ErrorIs(t, err, model.ErrInvalidModeration)
+
+	err = uc.HandleComplaint(7, 1, model.ComplaintApproved, "bad_vibes", "")
+	assert.ErrorIs(t, err, model.ErrInvalidModeration)
+
+	err = uc.HandleComplaint(7, 1, 42, "spam", "")
+	assert.ErrorIs(t, err, model.ErrInvalidModeration)
+
+	err = uc.HandleComplaint(7, 1, model.ComplaintClosed, "duplicate", strings.Repeat("a", model.MaxComplaintNoteLength+1))
+	assert.ErrorIs(t, err, model.ErrInvalidModeration)
+
+	// nothing reached the database
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
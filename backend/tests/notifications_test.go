@@ -0,0 +1,315 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNotificationsRepo(t *testing.T) (*repository.NotificationsRepo, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+
+	repo := &repository.NotificationsRepo{DB: db, Client: redisClient, Ctx: context.Background()}
+	cleanup := func() {
+		db.Close()
+		redisClient.Close()
+		redisServer.Close()
+	}
+	return repo, mock, cleanup
+}
+
+func TestNotificationsRepo_AddNotificationCoalesces(t *testing.T) {
+	repo, mock, cleanup := newTestNotificationsRepo(t)
+	defer cleanup()
+
+	notif := model.NotificationSend{
+		NotifType: model.NotificationMessage,
+		Content:   "User 2 sent you a message!",
+		Payload: &model.NotificationPayload{
+			Kind:    model.NotificationMessage,
+			ActorID: 2,
+			ChatID:  7,
+			Link:    "/chats/7",
+		},
+	}
+	first := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+	key := sql.NullString{String: "chat:7", Valid: true}
+	payload := []byte(`{"kind":"message","actorId":2,"chatId":7,"link":"/chats/7"}`)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.AddNotificationQuery)).
+		WithArgs(1, model.NotificationMessage, notif.Content, payload, key).
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id", "event_count", "updated_at"}).AddRow(11, 1, first))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.AddNotificationQuery)).
+		WithArgs(1, model.NotificationMessage, notif.Content, payload, key).
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id", "event_count", "updated_at"}).AddRow(11, 2, second))
+
+	events := repo.Client.(*redis.Client).Subscribe(context.Background(), "user:1 notifications")
+	defer events.Close()
+	_, err := events.Receive(context.Background())
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.AddNotification(1, notif))
+	msg, err := events.ReceiveMessage(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "new", msg.Payload)
+
+	assert.NoError(t, repo.AddNotification(1, notif))
+	msg, err = events.ReceiveMessage(context.Background())
+	assert.NoError(t, err)
+	var frame struct {
+		Type         string                 `json:"type"`
+		Notification model.NotificationSend `json:"notification"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(msg.Payload), &frame))
+	assert.Equal(t, "notification_updated", frame.Type)
+	assert.Equal(t, 2, frame.Notification.Count)
+
+	// the cache keeps one entry with the payload untouched
+	cached, err := repo.GetCurrentNotifications(1)
+	assert.NoError(t, err)
+	notif.NotificationID = 11
+	notif.Count = 2
+	notif.UpdatedAt = second
+	assert.Equal(t, []model.NotificationSend{notif}, cached)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationsRepo_CoalesceKeys(t *testing.T) {
+	repo, mock, cleanup := newTestNotificationsRepo(t)
+	defer cleanup()
+
+	cases := []struct {
+		payload *model.NotificationPayload
+		key     sql.NullString
+	}{
+		{&model.NotificationPayload{ActorID: 2, ChatID: 7}, sql.NullString{String: "chat:7", Valid: true}},
+		// an alert folds per search even when it names the profile it found
+		{&model.NotificationPayload{ActorID: 3, SearchID: 5}, sql.NullString{String: "search:5", Valid: true}},
+		{&model.NotificationPayload{ActorID: 3}, sql.NullString{String: "actor:3", Valid: true}},
+		{&model.NotificationPayload{}, sql.NullString{}},
+		{nil, sql.NullString{}},
+	}
+	for i, c := range cases {
+		mock.ExpectQuery(regexp.QuoteMeta(repository.AddNotificationQuery)).
+			WithArgs(1, model.NotificationSavedSearch, "c", sqlmock.AnyArg(), c.key).
+			WillReturnRows(sqlmock.NewRows([]string{"notification_id", "event_count", "updated_at"}).
+				AddRow(i+1, 1, time.Now()))
+		err := repo.StoreNotification(1, model.NotificationSend{
+			NotifType: model.NotificationSavedSearch,
+			Content:   "c",
+			Payload:   c.payload,
+		})
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationsRepo_ConcurrentFoldsKeepOneEntry(t *testing.T) {
+	repo, mock, cleanup := newTestNotificationsRepo(t)
+	defer cleanup()
+	mock.MatchExpectationsInOrder(false)
+
+	notif := model.NotificationSend{
+		NotifType: model.NotificationMessage,
+		Content:   "User 2 sent you a message!",
+		Payload:   &model.NotificationPayload{Kind: model.NotificationMessage, ActorID: 2, ChatID: 7},
+	}
+	const folds = 8
+	for count := 2; count < folds+2; count++ {
+		mock.ExpectQuery(regexp.QuoteMeta(repository.AddNotificationQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"notification_id", "event_count", "updated_at"}).
+				AddRow(11, count, time.Now()))
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < folds; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, repo.AddNotification(1, notif))
+		}()
+	}
+	wg.Wait()
+
+	cached, err := repo.GetCurrentNotifications(1)
+	assert.NoError(t, err)
+	assert.Len(t, cached, 1)
+	assert.Equal(t, 11, cached[0].NotificationID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationsRepo_DeleteLatestNotification(t *testing.T) {
+	repo, mock, cleanup := newTestNotificationsRepo(t)
+	defer cleanup()
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.DeleteLatestNotificationQuery)).
+		WithArgs(1, model.NotificationMatch, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id"}).AddRow(11))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.DeleteLatestNotificationQuery)).
+		WithArgs(1, model.NotificationMatch, 3).
+		WillReturnError(sql.ErrNoRows)
+
+	events := repo.Client.(*redis.Client).Subscribe(context.Background(), "user:1 notifications")
+	defer events.Close()
+	_, err := events.Receive(context.Background())
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.DeleteLatestNotification(1, model.NotificationMatch, 2))
+	msg, err := events.ReceiveMessage(context.Background())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"notification_deleted","notif_id":11}`, msg.Payload)
+
+	// nothing to delete is not an error
+	assert.NoError(t, repo.DeleteLatestNotification(1, model.NotificationMatch, 3))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationsRepo_GetNotificationsDecodesPayload(t *testing.T) {
+	repo, mock, cleanup := newTestNotificationsRepo(t)
+	defer cleanup()
+
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetNotificationQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id", "type_description", "content", "payload", "event_count", "updated_at", "read_at"}).
+			AddRow(11, "match", "You have matched with user 2!", []byte(`{"kind":"match","actorId":2,"link":"/profiles/2"}`), 1, createdAt, nil).
+			AddRow(10, "match", "You have matched with user 3!", nil, 1, createdAt, createdAt))
+
+	notifications, err := repo.GetNotifications(1)
+	assert.NoError(t, err)
+	assert.Len(t, notifications, 2)
+	assert.Equal(t, &model.NotificationPayload{Kind: "match", ActorID: 2, Link: "/profiles/2"}, notifications[0].Payload)
+	assert.Nil(t, notifications[1].Payload)
+	assert.Equal(t, 1, notifications[1].Read)
+	assert.Equal(t, createdAt, notifications[1].UpdatedAt)
+
+	// the frame sent to clients omits a missing payload
+	raw, err := json.Marshal(notifications[1])
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), "payload")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func newTestAddNotification(t *testing.T) (*usecase.AddNotification, *repository.NotificationsRepo, sqlmock.Sqlmock, func()) {
+	repo, mock, cleanup := newTestNotificationsRepo(t)
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewAddNotificationUseCase(repo, log)
+	assert.NoError(t, err)
+	return uc, repo, mock, cleanup
+}
+
+func messageNotification(chatID int) model.NotificationSend {
+	return model.NotificationSend{
+		NotifType: model.NotificationMessage,
+		Content:   "User 2 sent you a message!",
+		Payload:   &model.NotificationPayload{Kind: model.NotificationMessage, ActorID: 2, ChatID: chatID},
+	}
+}
+
+func TestAddNotification_MutedTypeAndChat(t *testing.T) {
+	uc, repo, mock, cleanup := newTestAddNotification(t)
+	defer cleanup()
+
+	settings := []byte(`{"mutedTypes":["match"],"mutedChats":[7]}`)
+	for range 2 {
+		mock.ExpectQuery(regexp.QuoteMeta(repository.GetNotificationSettingsQuery)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"settings"}).AddRow(settings))
+	}
+
+	assert.NoError(t, uc.AddNotification(1, model.NotificationSend{NotifType: model.NotificationMatch}))
+	assert.NoError(t, uc.AddNotification(1, messageNotification(7)))
+
+	cached, err := repo.GetCurrentNotifications(1)
+	assert.NoError(t, err)
+	assert.Empty(t, cached)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddNotification_QuietHoursOnlyStores(t *testing.T) {
+	uc, repo, mock, cleanup := newTestAddNotification(t)
+	defer cleanup()
+
+	// a window that always contains the current time
+	now := time.Now().UTC()
+	settings, err := (&model.NotificationSettings{QuietHours: &model.QuietHours{
+		Start:    now.Add(-time.Hour).Format(model.QuietHoursLayout),
+		End:      now.Add(time.Hour).Format(model.QuietHoursLayout),
+		Timezone: "UTC",
+	}}).MarshalJSON()
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.GetNotificationSettingsQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"settings"}).AddRow(settings))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.AddNotificationQuery)).
+		WithArgs(1, model.NotificationMessage, "User 2 sent you a message!", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id", "event_count", "updated_at"}).AddRow(11, 1, now))
+
+	assert.NoError(t, uc.AddNotification(1, messageNotification(8)))
+
+	cached, err := repo.GetCurrentNotifications(1)
+	assert.NoError(t, err)
+	assert.Empty(t, cached)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationSettings_Update(t *testing.T) {
+	repo, mock, cleanup := newTestNotificationsRepo(t)
+	defer cleanup()
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+	uc, err := usecase.NewNotificationSettingsUseCase(repo, log)
+	assert.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(repository.UpdateNotificationSettingsQuery)).
+		WithArgs(1, []byte(`{"mutedTypes":["message"],"mutedChats":[3],"quietHours":{"start":"23:00","end":"07:30","timezone":"UTC"}}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	settings, err := uc.Update(1, model.NotificationSettings{
+		MutedTypes: []string{"message", "message"},
+		MutedChats: []int{3, 3},
+		QuietHours: &model.QuietHours{Start: "23:00", End: "07:30"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"message"}, settings.MutedTypes)
+
+	_, err = uc.Update(1, model.NotificationSettings{MutedTypes: []string{"spam"}})
+	assert.ErrorIs(t, err, model.ErrInvalidNotifSettings)
+
+	_, err = uc.Update(1, model.NotificationSettings{
+		QuietHours: &model.QuietHours{Start: "23:00", End: "07:30", Timezone: "Mars/Olympus"},
+	})
+	assert.ErrorIs(t, err, model.ErrInvalidNotifSettings)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,338 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSender struct {
+	errs map[int]error
+	sent []model.OutboundMessage
+}
+
+func (s *fakeSender) Send(ctx context.Context, msg model.OutboundMessage) error {
+	if err := s.errs[msg.ID]; err != nil {
+		return err
+	}
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+// runSMTPSink accepts one SMTP session and sends the DATA it received.
+func runSMTPSink(t *testing.T) (string, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	received := make(chan string, 1)
+	go func() {
+		defer listener.Close()
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+		reply("220 sink ready")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 sink")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				received <- data.String()
+				reply("250 queued")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return listener.Addr().String(), received
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	addr, received := runSMTPSink(t)
+	sender := &repository.SMTPSender{Addr: addr, From: "noreply@provveb.local"}
+
+	err := sender.Send(context.Background(), model.OutboundMessage{
+		Channel:   model.OutboundEmail,
+		Recipient: "user@example.com",
+		Subject:   "You have 3 unread notifications",
+		Body:      "New matches: 1\nNew messages: 2",
+	})
+	assert.NoError(t, err)
+
+	select {
+	case data := <-received:
+		assert.Contains(t, data, "To: user@example.com\r\n")
+		assert.Contains(t, data, "Subject: You have 3 unread notifications\r\n")
+		assert.Contains(t, data, "\r\n\r\nNew matches: 1\r\nNew messages: 2\r\n")
+	case <-time.After(time.Second):
+		t.Fatal("sink received no mail")
+	}
+
+	err = sender.Send(context.Background(), model.OutboundMessage{
+		Recipient: "user@example.com",
+		Subject:   "hi\r\nBcc: other@example.com",
+	})
+	assert.Error(t, err)
+}
+
+func TestWebhookSender_Send(t *testing.T) {
+	var signature string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get("X-Signature")
+		body, _ = io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"id":2`) {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	sender := &repository.WebhookSender{URL: server.URL, Secret: "secret", Client: server.Client()}
+
+	err := sender.Send(context.Background(), model.OutboundMessage{ID: 1, UserID: 5, Channel: model.OutboundWebhook})
+	assert.NoError(t, err)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), signature)
+	assert.Contains(t, string(body), `"userId":5`)
+	assert.NotContains(t, string(body), `"recipient"`)
+
+	err = sender.Send(context.Background(), model.OutboundMessage{ID: 2})
+	assert.Error(t, err)
+}
+
+// textArrayConverter lets []string args through, the way pgx takes them.
+type textArrayConverter struct{}
+
+func (textArrayConverter) ConvertValue(v interface{}) (driver.Value, error) {
+	if arr, ok := v.([]string); ok {
+		return arr, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v)
+}
+
+func newTestOutbound(t *testing.T, sender repository.Sender) (*usecase.Outbound, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(textArrayConverter{}))
+	assert.NoError(t, err)
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewOutboundUseCase(
+		&repository.OutboxRepo{DB: db},
+		map[string]repository.Sender{model.OutboundEmail: sender},
+		log,
+	)
+	assert.NoError(t, err)
+	return uc, mock, func() { db.Close() }
+}
+
+func TestOutbound_DispatchRetriesWithBackoff(t *testing.T) {
+	sender := &fakeSender{errs: map[int]error{
+		2: errors.New("connection refused"),
+		3: errors.New("connection refused"),
+	}}
+	uc, mock, cleanup := newTestOutbound(t, sender)
+	defer cleanup()
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.ClaimOutboundQuery)).
+		WithArgs(model.OutboxBatchSize, model.OutboxLease.Seconds()).
+		WillReturnRows(sqlmock.NewRows([]string{"outbox_id", "user_id", "channel", "recipient", "subject", "body", "attempts"}).
+			AddRow(1, 5, model.OutboundEmail, "a@example.com", "s", "b", 1).
+			AddRow(2, 6, model.OutboundEmail, "b@example.com", "s", "b", 3).
+			AddRow(3, 7, model.OutboundEmail, "c@example.com", "s", "b", model.OutboxMaxAttempts).
+			AddRow(4, 8, model.OutboundWebhook, "d@example.com", "s", "b", 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.MarkOutboundSentQuery)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// third attempt failed: the delay doubled twice
+	mock.ExpectExec(regexp.QuoteMeta(repository.RetryOutboundQuery)).
+		WithArgs(2, "connection refused", (4 * model.OutboxBaseBackoff).Seconds()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.MarkOutboundFailedQuery)).
+		WithArgs(3, "connection refused").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.RetryOutboundQuery)).
+		WithArgs(4, model.ErrSenderNotConfigured.Error(), model.OutboxBaseBackoff.Seconds()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	sent, err := uc.Dispatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Len(t, sender.sent, 1)
+	assert.Equal(t, "a@example.com", sender.sent[0].Recipient)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbound_CreateDigests(t *testing.T) {
+	uc, mock, cleanup := newTestOutbound(t, &fakeSender{})
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.ClaimDigestNotificationsQuery)).
+		WithArgs(model.DigestDelay.Seconds(), model.DigestKinds).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "email", "type_description", "event_count"}).
+			AddRow(5, "a@example.com", model.NotificationMatch, 1).
+			AddRow(5, "a@example.com", model.NotificationMessage, 3).
+			AddRow(6, "b@example.com", model.NotificationMessage, 1).
+			AddRow(5, "a@example.com", model.NotificationMatch, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.EnqueueOutboundQuery)).
+		WithArgs(5, model.OutboundEmail, "a@example.com", "You have 5 unread notifications", "New matches: 2\nNew messages: 3").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.EnqueueOutboundQuery)).
+		WithArgs(6, model.OutboundEmail, "b@example.com", "You have 1 unread notifications", "New messages: 1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	queued, err := uc.CreateDigests(model.DigestDelay)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, queued)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbound_DigestWebhookHasNoAddress(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(textArrayConverter{}))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewOutboundUseCase(
+		&repository.OutboxRepo{DB: db},
+		map[string]repository.Sender{
+			model.OutboundEmail:   &fakeSender{},
+			model.OutboundWebhook: &fakeSender{},
+		},
+		log,
+	)
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(repository.ClaimDigestNotificationsQuery)).
+		WithArgs(model.DigestDelay.Seconds(), model.DigestKinds).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "email", "type_description", "event_count"}).
+			AddRow(5, "a@example.com", model.NotificationMatch, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.EnqueueOutboundQuery)).
+		WithArgs(5, model.OutboundEmail, "a@example.com", "You have 1 unread notifications", "New matches: 1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(repository.EnqueueOutboundQuery)).
+		WithArgs(5, model.OutboundWebhook, "", "You have 1 unread notifications", "New matches: 1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	queued, err := uc.CreateDigests(model.DigestDelay)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, queued)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutbound_DigestsFoldedNotificationAgain(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(textArrayConverter{}))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer redisServer.Close()
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	defer redisClient.Close()
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewOutboundUseCase(
+		&repository.OutboxRepo{DB: db},
+		map[string]repository.Sender{model.OutboundEmail: &fakeSender{}},
+		log,
+	)
+	assert.NoError(t, err)
+	notifications := &repository.NotificationsRepo{DB: db, Client: redisClient, Ctx: context.Background()}
+
+	// a fold makes a digested notification due again
+	assert.Contains(t, repository.AddNotificationQuery, "digested_at = NULL")
+
+	expectDigest := func(count int, body string) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(repository.ClaimDigestNotificationsQuery)).
+			WithArgs(model.DigestDelay.Seconds(), model.DigestKinds).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "email", "type_description", "event_count"}).
+				AddRow(5, "a@example.com", model.NotificationMessage, count))
+		mock.ExpectExec(regexp.QuoteMeta(repository.EnqueueOutboundQuery)).
+			WithArgs(5, model.OutboundEmail, "a@example.com", sqlmock.AnyArg(), body).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+
+	expectDigest(1, "New messages: 1")
+	mock.ExpectQuery(regexp.QuoteMeta(repository.AddNotificationQuery)).
+		WithArgs(5, model.NotificationMessage, "User 2 sent you a message!", sqlmock.AnyArg(), "chat:7").
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id", "event_count", "updated_at"}).
+			AddRow(11, 2, time.Now()))
+	expectDigest(2, "New messages: 2")
+
+	queued, err := uc.CreateDigests(model.DigestDelay)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, queued)
+
+	err = notifications.AddNotification(5, model.NotificationSend{
+		NotifType: model.NotificationMessage,
+		Content:   "User 2 sent you a message!",
+		Payload:   &model.NotificationPayload{Kind: model.NotificationMessage, ActorID: 2, ChatID: 7},
+	})
+	assert.NoError(t, err)
+
+	queued, err = uc.CreateDigests(model.DigestDelay)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, queued)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestQuotaRepo(t *testing.T) (*repository.QuotaRepo, *miniredis.Miniredis) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	return repository.NewQuotaRepo(redis.NewClient(&redis.Options{Addr: mr.Addr()})), mr
+}
+
+func TestQuotaRepoConsumeUpToLimit(t *testing.T) {
+	repo, mr := newTestQuotaRepo(t)
+	resetAt := usecase.QuotaResetAt(time.Now())
+
+	for i := 1; i <= 3; i++ {
+		allowed, used, err := repo.Consume(7, model.QuotaRewinds, 3, resetAt)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, i, used)
+	}
+
+	allowed, used, err := repo.Consume(7, model.QuotaRewinds, 3, resetAt)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 3, used)
+
+	key := "quota:rewinds:7:" + resetAt.Format("2006-01-02")
+	assert.True(t, mr.Exists(key))
+	assert.Greater(t, mr.TTL(key), time.Duration(0))
+
+	// the next day has its own counter
+	allowed, used, err = repo.Consume(7, model.QuotaRewinds, 3, resetAt.Add(24*time.Hour))
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, 1, used)
+}
+
+func TestQuotaRepoZeroAndUnlimited(t *testing.T) {
+	repo, _ := newTestQuotaRepo(t)
+	resetAt := usecase.QuotaResetAt(time.Now())
+
+	allowed, used, err := repo.Consume(1, model.QuotaSuperlikes, 0, resetAt)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 0, used)
+
+	for i := 0; i < 100; i++ {
+		allowed, _, err = repo.Consume(1, model.QuotaLikes, model.Unlimited, resetAt)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	}
+}
+
+func TestQuotaRepoRefundAndUsed(t *testing.T) {
+	repo, _ := newTestQuotaRepo(t)
+	resetAt := usecase.QuotaResetAt(time.Now())
+
+	_, _, err := repo.Consume(2, model.QuotaLikes, 10, resetAt)
+	assert.NoError(t, err)
+	_, _, err = repo.Consume(2, model.QuotaLikes, 10, resetAt)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.Refund(2, model.QuotaLikes, resetAt))
+	// refunding something that was never taken must not go negative
+	assert.NoError(t, repo.Refund(2, model.QuotaViews, resetAt))
+
+	used, err := repo.Used(2, model.QuotaKinds, resetAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, used[model.QuotaLikes])
+	assert.Equal(t, 0, used[model.QuotaViews])
+	assert.Equal(t, 0, used[model.QuotaRewinds])
+}
+
+func TestQuotasUseCase(t *testing.T) {
+	repo, _ := newTestQuotaRepo(t)
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewQuotasUseCase(repo, log)
+	assert.NoError(t, err)
+
+	_, err = usecase.NewQuotasUseCase(nil, log)
+	assert.ErrorIs(t, err, model.ErrQuotaUC)
+
+	st, err := uc.Consume(5, model.FreeTier, model.QuotaSuperlikes)
+	assert.True(t, errors.Is(err, model.ErrQuotaExceeded))
+	assert.Equal(t, 0, st.Limit)
+
+	limit := model.DailyQuotas[1][model.QuotaRewinds]
+	for i := 1; i <= limit; i++ {
+		st, err = uc.Consume(5, 1, model.QuotaRewinds)
+		assert.NoError(t, err)
+		assert.Equal(t, limit-i, st.Remaining)
+	}
+	_, err = uc.Consume(5, 1, model.QuotaRewinds)
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+
+	uc.Refund(5, model.QuotaRewinds)
+	resp, err := uc.Status(5, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resp.Tier)
+	assert.Len(t, resp.Quotas, len(model.QuotaKinds))
+	for _, q := range resp.Quotas {
+		if q.Kind == model.QuotaRewinds {
+			assert.Equal(t, limit-1, q.Used)
+			assert.Equal(t, 1, q.Remaining)
+		}
+	}
+
+	st, err = uc.Consume(5, 4, model.QuotaLikes)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Unlimited, st.Remaining)
+}
+
+func TestQuotaLimitAndReset(t *testing.T) {
+	assert.Equal(t, model.DailyQuotas[1][model.QuotaLikes], usecase.QuotaLimit(42, model.QuotaLikes))
+	assert.Equal(t, 0, usecase.QuotaLimit(model.FreeTier, model.QuotaRewinds))
+
+	now := time.Date(2025, 5, 1, 23, 59, 0, 0, time.FixedZone("MSK", 3*60*60))
+	assert.Equal(t, time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC), usecase.QuotaResetAt(now))
+}
@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	handlers "github.com/go-park-mail-ru/2025_1_ProVVeb/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func runTestHub(t *testing.T) (*handlers.RealtimeHub, *miniredis.Miniredis, func()) {
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	hub := handlers.NewRealtimeHub(redisClient, log)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(done)
+	}()
+
+	// publish only once the pattern subscription is in place
+	assert.Eventually(t, func() bool { return redisServer.PubSubNumPat() == 1 }, time.Second, 10*time.Millisecond)
+
+	return hub, redisServer, func() {
+		cancel()
+		<-done
+		redisClient.Close()
+		redisServer.Close()
+	}
+}
+
+func expectEvent(t *testing.T, c *handlers.RealtimeClient, want handlers.RealtimeEvent) {
+	t.Helper()
+	select {
+	case event := <-c.Events():
+		assert.Equal(t, want, event)
+	case <-time.After(time.Second):
+		t.Fatalf("no %s event", want.Kind)
+	}
+}
+
+func expectNoEvent(t *testing.T, c *handlers.RealtimeClient) {
+	t.Helper()
+	select {
+	case event := <-c.Events():
+		t.Fatalf("unexpected event %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestRealtimeHub_FansOutToUserConnections(t *testing.T) {
+	hub, redisServer, cleanup := runTestHub(t)
+	defer cleanup()
+
+	phone := handlers.NewRealtimeClient(5, nil)
+	laptop := handlers.NewRealtimeClient(5, nil)
+	otherTab := handlers.NewRealtimeClient(5, nil)
+	peer := handlers.NewRealtimeClient(6, nil)
+	phone.Subscribe(9, 6)
+	laptop.Subscribe(9, 6)
+	peer.Subscribe(9, 5)
+
+	assert.True(t, hub.Register(phone))
+	assert.False(t, hub.Register(laptop))
+	assert.False(t, hub.Register(otherTab))
+	assert.True(t, hub.Register(peer))
+
+	// chat events reach only the connections subscribed to the chat
+	redisServer.Publish("user:5 chat:9 messages", "new")
+	chatEvent := handlers.RealtimeEvent{Kind: handlers.RealtimeEventMessages, ChatID: 9, Payload: "new"}
+	expectEvent(t, phone, chatEvent)
+	expectEvent(t, laptop, chatEvent)
+	expectNoEvent(t, otherTab)
+	expectNoEvent(t, peer)
+
+	redisServer.Publish("user:5 notifications", "new")
+	notifEvent := handlers.RealtimeEvent{Kind: handlers.RealtimeEventNotifications, Payload: "new"}
+	expectEvent(t, phone, notifEvent)
+	expectEvent(t, laptop, notifEvent)
+	expectEvent(t, otherTab, notifEvent)
+	expectNoEvent(t, peer)
+
+	redisServer.Publish("user:6 events", `{"type":"unmatched","chat_id":9}`)
+	expectEvent(t, peer, handlers.RealtimeEvent{Kind: handlers.RealtimeEventDirect, Payload: `{"type":"unmatched","chat_id":9}`})
+	expectNoEvent(t, phone)
+
+	// channels the hub does not know are dropped
+	redisServer.Publish("user:5 presence", "online")
+	expectNoEvent(t, phone)
+}
+
+func TestRealtimeHub_Unregister(t *testing.T) {
+	hub, redisServer, cleanup := runTestHub(t)
+	defer cleanup()
+
+	phone := handlers.NewRealtimeClient(5, nil)
+	laptop := handlers.NewRealtimeClient(5, nil)
+	assert.True(t, hub.Register(phone))
+	assert.False(t, hub.Register(laptop))
+
+	notifEvent := handlers.RealtimeEvent{Kind: handlers.RealtimeEventNotifications, Payload: "new"}
+	assert.False(t, hub.Unregister(phone))
+	redisServer.Publish("user:5 notifications", "new")
+	expectEvent(t, laptop, notifEvent)
+	expectNoEvent(t, phone)
+
+	assert.True(t, hub.Unregister(laptop))
+	redisServer.Publish("user:5 notifications", "new")
+	expectNoEvent(t, laptop)
+
+	// the user is gone, so the next connection is the first again
+	assert.True(t, hub.Register(phone))
+}
+
+func TestRealtimeHub_OverflowClosesClient(t *testing.T) {
+	hub, redisServer, cleanup := runTestHub(t)
+	defer cleanup()
+
+	slow := handlers.NewRealtimeClient(5, nil)
+	hub.Register(slow)
+
+	select {
+	case <-slow.Overflowed():
+		t.Fatal("client overflowed before any event")
+	default:
+	}
+
+	// nobody reads the events, so the buffer fills up
+	for i := 0; i < 200; i++ {
+		redisServer.Publish("user:5 events", `{"type":"typing","chat_id":9}`)
+	}
+
+	select {
+	case <-slow.Overflowed():
+	case <-time.After(time.Second):
+		t.Fatal("a client that fell behind was not closed")
+	}
+}
+
+func TestRealtimeClient_DropsPeerThatNeverReads(t *testing.T) {
+	hub, redisServer, cleanup := runTestHub(t)
+	defer cleanup()
+
+	writeWait := model.RealtimeWriteWait
+	model.RealtimeWriteWait = 100 * time.Millisecond
+	defer func() { model.RealtimeWriteWait = writeWait }()
+
+	frame := map[string]interface{}{"type": "typing", "padding": strings.Repeat("x", 1<<20)}
+	served := make(chan struct{})
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+
+		client := handlers.NewRealtimeClient(5, conn)
+		hub.Register(client)
+		done := make(chan struct{})
+		go client.Serve(done,
+			func(handlers.RealtimeEvent) { client.Send(frame) },
+			func() {},
+		)
+
+		// the same shape as HandleRealtime: the read loop ends the connection
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				break
+			}
+		}
+		close(done)
+		hub.Unregister(client)
+		close(served)
+	}))
+	defer server.Close()
+
+	// the peer connects and then never reads a frame
+	peer, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.NoError(t, err)
+	defer peer.Close()
+
+	for i := 0; i < 32; i++ {
+		redisServer.Publish("user:5 events", `{"type":"typing","chat_id":9}`)
+	}
+
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a peer that never reads kept its connection")
+	}
+	// the hub entry is gone, so the next connection is the first again
+	assert.True(t, hub.Register(handlers.NewRealtimeClient(5, nil)))
+}
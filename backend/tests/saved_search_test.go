@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type fakeSearchClient struct {
+	profilespb.ProfilesServiceClient
+	total    int32
+	err      error
+	requests []*profilespb.SearchProfileRequest
+}
+
+func (c *fakeSearchClient) SearchProfile(
+	ctx context.Context,
+	in *profilespb.SearchProfileRequest,
+	opts ...grpc.CallOption,
+) (*profilespb.SearchProfileResponse, error) {
+	c.requests = append(c.requests, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &profilespb.SearchProfileResponse{Total: c.total}, nil
+}
+
+func TestSavedSearchRepo_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.SavedSearchRepo{DB: db}
+	params := model.SearchProfileRequest{AgeMin: 20, AgeMax: 30, InterestsAny: []string{"music"}}
+	raw, err := params.MarshalJSON()
+	assert.NoError(t, err)
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.SaveSearchQuery)).
+		WithArgs(1, "weekend", raw, model.MaxSavedSearches).
+		WillReturnRows(sqlmock.NewRows([]string{"saved_search_id", "created_at"}).AddRow(5, createdAt))
+	mock.ExpectQuery(regexp.QuoteMeta(repository.SaveSearchQuery)).
+		WithArgs(1, "another", raw, model.MaxSavedSearches).
+		WillReturnRows(sqlmock.NewRows([]string{"saved_search_id", "created_at"}))
+
+	search, err := repo.SaveSearch(1, "weekend", params)
+	assert.NoError(t, err)
+	assert.Equal(t, model.SavedSearch{ID: 5, Name: "weekend", Params: params, CreatedAt: createdAt}, search)
+
+	_, err = repo.SaveSearch(1, "another", params)
+	assert.ErrorIs(t, err, model.ErrTooManySavedSearches)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSavedSearchRepo_ClaimDueSearches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.SavedSearchRepo{DB: db}
+	since := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(repository.ClaimDueSearchesQuery)).
+		WithArgs(float64(600), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"saved_search_id", "profile_id", "name", "params", "last_checked_at"}).
+			AddRow(5, 1, "weekend", []byte(`{"ageMin":20,"sortBy":"newest"}`), since))
+
+	alerts, err := repo.ClaimDueSearches(10*time.Minute, 100)
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, 5, alerts[0].SearchID)
+	assert.Equal(t, 1, alerts[0].ProfileID)
+	assert.Equal(t, 20, alerts[0].Params.AgeMin)
+	assert.Equal(t, model.SortByNewest, alerts[0].Params.SortBy)
+	assert.Equal(t, since, alerts[0].Since)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func newTestSavedSearches(t *testing.T, client *fakeSearchClient) (*usecase.SavedSearches, sqlmock.Sqlmock, sqlmock.Sqlmock, func()) {
+	searchDB, searchMock, err := sqlmock.New()
+	assert.NoError(t, err)
+	notifDB, notifMock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	uc, err := usecase.NewSavedSearchesUseCase(
+		client,
+		&repository.SavedSearchRepo{DB: searchDB},
+		&repository.NotificationsRepo{DB: notifDB, Client: redisClient, Ctx: context.Background()},
+		log,
+	)
+	assert.NoError(t, err)
+
+	cleanup := func() {
+		searchDB.Close()
+		notifDB.Close()
+		redisClient.Close()
+		redisServer.Close()
+	}
+	return uc, searchMock, notifMock, cleanup
+}
+
+func TestSavedSearches_CheckAlertsNotifies(t *testing.T) {
+	client := &fakeSearchClient{total: 3}
+	uc, searchMock, notifMock, cleanup := newTestSavedSearches(t, client)
+	defer cleanup()
+
+	since := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	searchMock.ExpectQuery(regexp.QuoteMeta(repository.ClaimDueSearchesQuery)).
+		WithArgs(float64(600), model.SavedSearchAlertBatchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"saved_search_id", "profile_id", "name", "params", "last_checked_at"}).
+			AddRow(5, 1, "weekend", []byte(`{"ageMin":20}`), since))
+	notifMock.ExpectQuery(regexp.QuoteMeta(repository.GetNotificationSettingsQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"settings"}))
+	notifMock.ExpectQuery(regexp.QuoteMeta(repository.AddNotificationQuery)).
+		WithArgs(1, model.NotificationSavedSearch, fmt.Sprintf(model.SavedSearchAlertFormat, 3, "weekend"),
+			[]byte(`{"kind":"saved_search","searchId":5,"count":3,"link":"/profiles/searches/5"}`),
+			sql.NullString{String: "search:5", Valid: true}).
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id", "event_count", "updated_at"}).AddRow(11, 1, since))
+
+	notified, err := uc.CheckAlerts(10 * time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, notified)
+
+	assert.Len(t, client.requests, 1)
+	assert.Equal(t, int32(1), client.requests[0].GetIDUser())
+	assert.Equal(t, int32(20), client.requests[0].GetAgeMin())
+	assert.Equal(t, since, client.requests[0].GetCreatedAfter().AsTime())
+
+	assert.NoError(t, searchMock.ExpectationsWereMet())
+	assert.NoError(t, notifMock.ExpectationsWereMet())
+}
+
+func TestSavedSearches_CheckAlertsRestoresFailedSearch(t *testing.T) {
+	client := &fakeSearchClient{err: errors.New("unavailable")}
+	uc, searchMock, notifMock, cleanup := newTestSavedSearches(t, client)
+	defer cleanup()
+
+	since := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	searchMock.ExpectQuery(regexp.QuoteMeta(repository.ClaimDueSearchesQuery)).
+		WithArgs(float64(600), model.SavedSearchAlertBatchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"saved_search_id", "profile_id", "name", "params", "last_checked_at"}).
+			AddRow(5, 1, "weekend", []byte(`{}`), since))
+	searchMock.ExpectExec(regexp.QuoteMeta(repository.RestoreLastCheckedQuery)).
+		WithArgs(5, since).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	notified, err := uc.CheckAlerts(10 * time.Minute)
+	assert.NoError(t, err)
+	assert.Zero(t, notified)
+
+	assert.NoError(t, searchMock.ExpectationsWereMet())
+	assert.NoError(t, notifMock.ExpectationsWereMet())
+}
@@ -3,11 +3,13 @@ package tests
 import (
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,10 +46,16 @@ func TestHash(t *testing.T) {
 	require.NoError(t, err)
 
 	password := "securePassword"
-	hashedPassword := hasher.Hash(password)
+	hashedPassword, err := hasher.Hash(password)
+	require.NoError(t, err)
 
 	require.NotEmpty(t, hashedPassword, "hashed password should not be empty")
-	require.Len(t, hashedPassword, 64, "hashed password should be 64 characters long")
+	require.True(t, strings.HasPrefix(hashedPassword, "$argon2id$"), "hashed password should be argon2id encoded")
+	require.False(t, hasher.NeedsRehash(hashedPassword))
+
+	anotherHash, err := hasher.Hash(password)
+	require.NoError(t, err)
+	require.NotEqual(t, hashedPassword, anotherHash, "every hash should use its own salt")
 }
 
 func TestCompare(t *testing.T) {
@@ -56,13 +64,26 @@ func TestCompare(t *testing.T) {
 
 	password := "securePassword"
 
-	hashedPassword := hasher.Hash(password)
+	hashedPassword, err := hasher.Hash(password)
+	require.NoError(t, err)
 
 	salt := "randomSalt"
 	incorrectPassword := "incorrectPassword"
 	isMatch := hasher.Compare(hashedPassword, salt, incorrectPassword)
 
 	require.False(t, isMatch, "passwords should not match")
+	require.True(t, hasher.Compare(hashedPassword, salt, password), "passwords should match")
+}
+
+func TestCompareLegacyHash(t *testing.T) {
+	hasher, err := repository.NewPassHasher()
+	require.NoError(t, err)
+
+	legacy := utils.EncryptPasswordSHA256("user_securePassword")
+
+	require.True(t, hasher.Compare(legacy, "user", "securePassword"))
+	require.False(t, hasher.Compare(legacy, "user", "wrongPassword"))
+	require.True(t, hasher.NeedsRehash(legacy))
 }
 
 func TestCreateJwtToken(t *testing.T) {
@@ -1,6 +1,10 @@
 package usecase
 
 import (
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
@@ -20,7 +24,7 @@ func NewAddNotificationUseCase(notifRepo repository.NotificationsRepository, log
 
 func (uc *AddNotification) AddNotification(userID int, notif model.NotificationSend) error {
 	uc.logger.Info("AddNotification", "userId", userID, "notif", notif)
-	err := uc.notifRepo.AddNotification(userID, notif)
+	err := deliverNotification(uc.notifRepo, uc.logger, userID, notif, time.Now())
 	if err != nil {
 		uc.logger.Error("AddNotification", "userId", userID, "error", err)
 	} else {
@@ -28,3 +32,68 @@ func (uc *AddNotification) AddNotification(userID int, notif model.NotificationS
 	}
 	return err
 }
+
+// deliverNotification applies the recipient's settings: a muted notification
+// is dropped, one during quiet hours is stored without a live push. If the
+// settings cannot be read the notification is delivered as usual.
+func deliverNotification(
+	notifRepo repository.NotificationsRepository,
+	logger *logger.LogrusLogger,
+	userID int,
+	notif model.NotificationSend,
+	now time.Time,
+) error {
+	settings, err := notifRepo.GetNotificationSettings(userID)
+	if err != nil {
+		logger.Error("AddNotification", "userId", userID, "error", err)
+		return notifRepo.AddNotification(userID, notif)
+	}
+
+	if notificationMuted(settings, notif) {
+		return nil
+	}
+	if inQuietHours(settings.QuietHours, now) {
+		return notifRepo.StoreNotification(userID, notif)
+	}
+	return notifRepo.AddNotification(userID, notif)
+}
+
+func notificationMuted(settings model.NotificationSettings, notif model.NotificationSend) bool {
+	if slices.Contains(settings.MutedTypes, notif.NotifType) {
+		return true
+	}
+	return notif.Payload != nil && notif.Payload.ChatID != 0 &&
+		slices.Contains(settings.MutedChats, notif.Payload.ChatID)
+}
+
+func inQuietHours(quiet *model.QuietHours, now time.Time) bool {
+	if quiet == nil {
+		return false
+	}
+	loc, err := time.LoadLocation(quietHoursTimezone(quiet))
+	if err != nil {
+		return false
+	}
+	start, errStart := time.Parse(model.QuietHoursLayout, quiet.Start)
+	end, errEnd := time.Parse(model.QuietHoursLayout, quiet.End)
+	if errStart != nil || errEnd != nil {
+		return false
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from <= to {
+		return minute >= from && minute < to
+	}
+	return minute >= from || minute < to
+}
+
+func quietHoursTimezone(quiet *model.QuietHours) string {
+	if strings.TrimSpace(quiet.Timezone) == "" {
+		return "UTC"
+	}
+	return quiet.Timezone
+}
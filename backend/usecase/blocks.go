@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"encoding/json"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type Blocks struct {
+	blockRepo    repository.BlockRepository
+	chatRepo     repository.ChatRepository
+	presenceRepo repository.PresenceRepository
+	logger       *logger.LogrusLogger
+}
+
+func NewBlocksUseCase(
+	blockRepo repository.BlockRepository,
+	chatRepo repository.ChatRepository,
+	presenceRepo repository.PresenceRepository,
+	logger *logger.LogrusLogger,
+) (*Blocks, error) {
+	if blockRepo == nil || chatRepo == nil || presenceRepo == nil || logger == nil {
+		return nil, model.ErrBlocksUC
+	}
+	return &Blocks{blockRepo: blockRepo, chatRepo: chatRepo, presenceRepo: presenceRepo, logger: logger}, nil
+}
+
+// Block adds blockedID to the block list of blockerID and closes their chat.
+func (uc *Blocks) Block(blockerID int, blockedID int) error {
+	uc.logger.WithFields(&logrus.Fields{"blocker_id": blockerID, "blocked_id": blockedID}).Info("Block")
+
+	if _, err := uc.blockRepo.Block(blockerID, blockedID); err != nil {
+		uc.logger.Error("Block", "blocker_id", blockerID, "blocked_id", blockedID, "error", err)
+		return err
+	}
+
+	chatID, err := uc.chatRepo.CloseChat(blockerID, blockedID)
+	if err != nil {
+		uc.logger.Error("Block", "blocker_id", blockerID, "blocked_id", blockedID, "error", err)
+		return err
+	}
+	if chatID != 0 {
+		uc.publishChatEvent("chat_closed", chatID, blockerID, blockedID)
+	}
+	return nil
+}
+
+// Unblock removes a block. The chat comes back only if the pair is still
+// matched and the other side has not blocked back.
+func (uc *Blocks) Unblock(blockerID int, blockedID int) error {
+	uc.logger.WithFields(&logrus.Fields{"blocker_id": blockerID, "blocked_id": blockedID}).Info("Unblock")
+
+	removed, err := uc.blockRepo.Unblock(blockerID, blockedID)
+	if err != nil {
+		uc.logger.Error("Unblock", "blocker_id", blockerID, "blocked_id", blockedID, "error", err)
+		return err
+	}
+	if !removed {
+		return nil
+	}
+
+	chatID, err := uc.chatRepo.ReopenChat(blockerID, blockedID)
+	if err != nil {
+		uc.logger.Error("Unblock", "blocker_id", blockerID, "blocked_id", blockedID, "error", err)
+		return err
+	}
+	if chatID != 0 {
+		uc.publishChatEvent("chat_reopened", chatID, blockerID, blockedID)
+	}
+	return nil
+}
+
+func (uc *Blocks) GetBlocked(blockerID int) ([]model.BlockedProfile, error) {
+	blocked, err := uc.blockRepo.GetBlocked(blockerID)
+	if err != nil {
+		uc.logger.Error("GetBlocked", "blocker_id", blockerID, "error", err)
+	}
+	return blocked, err
+}
+
+func (uc *Blocks) IsBlocked(firstID int, secondID int) (bool, error) {
+	blocked, err := uc.blockRepo.IsBlocked(firstID, secondID)
+	if err != nil {
+		uc.logger.Error("IsBlocked", "first_id", firstID, "second_id", secondID, "error", err)
+	}
+	return blocked, err
+}
+
+// publishChatEvent tells both users' clients that the chat changed state.
+// The event does not say who blocked whom.
+func (uc *Blocks) publishChatEvent(kind string, chatID int, userIDs ...int) {
+	event, err := json.Marshal(map[string]interface{}{
+		"type":    kind,
+		"chat_id": chatID,
+	})
+	if err != nil {
+		return
+	}
+	for _, userID := range userIDs {
+		if err := uc.presenceRepo.PublishEvent(userID, event); err != nil {
+			uc.logger.Error("Blocks", "user_id", userID, "chat_id", chatID, "error", err)
+		}
+	}
+}
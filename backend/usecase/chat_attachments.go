@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type UploadAttachment struct {
+	chatRepo repository.ChatRepository
+	storage  repository.AttachmentStorage
+	logger   *logger.LogrusLogger
+}
+
+func NewUploadAttachmentUseCase(
+	chatRepo repository.ChatRepository,
+	storage repository.AttachmentStorage,
+	logger *logger.LogrusLogger,
+) (*UploadAttachment, error) {
+	return &UploadAttachment{chatRepo: chatRepo, storage: storage, logger: logger}, nil
+}
+
+// attachmentExtensions maps the sniffed content types accepted for attachments
+// to the extension of their storage key.
+var attachmentExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// UploadAttachment stores the file under a key built from ids only, so the
+// client's file name never reaches the storage path.
+func (uc *UploadAttachment) UploadAttachment(chatID int, userID int, file []byte, fileName string, contentType string) (model.Attachment, error) {
+	uc.logger.Info("UploadAttachment", "chatID", chatID, "userID", userID, "fileName", fileName)
+
+	path := fmt.Sprintf("/chat_%d/%d_%d%s", chatID, userID, time.Now().UnixNano(), attachmentExtensions[contentType])
+	if err := uc.storage.UploadAttachment(file, path, contentType); err != nil {
+		uc.logger.Error("UploadAttachment", "chatID", chatID, "error", err)
+		return model.Attachment{}, err
+	}
+
+	attachmentID, err := uc.chatRepo.CreateAttachment(chatID, userID, path, contentType)
+	if err != nil {
+		uc.logger.Error("UploadAttachment", "chatID", chatID, "error", err)
+		if delErr := uc.storage.DeleteAttachment(path); delErr != nil {
+			uc.logger.Error("UploadAttachment", "path", path, "error", delErr)
+		}
+		return model.Attachment{}, err
+	}
+
+	uc.logger.WithFields(&logrus.Fields{"chatID": chatID, "attachmentID": attachmentID})
+	return model.Attachment{
+		AttachmentID: attachmentID,
+		ContentType:  contentType,
+		URL:          fmt.Sprintf(model.AttachmentURLFormat, attachmentID),
+	}, nil
+}
+
+type GetAttachment struct {
+	chatRepo repository.ChatRepository
+	storage  repository.AttachmentStorage
+	logger   *logger.LogrusLogger
+}
+
+func NewGetAttachmentUseCase(
+	chatRepo repository.ChatRepository,
+	storage repository.AttachmentStorage,
+	logger *logger.LogrusLogger,
+) (*GetAttachment, error) {
+	return &GetAttachment{chatRepo: chatRepo, storage: storage, logger: logger}, nil
+}
+
+// GetAttachment returns the file only to participants of the chat it was sent to.
+func (uc *GetAttachment) GetAttachment(attachmentID int, userID int) ([]byte, string, error) {
+	uc.logger.Info("GetAttachment", "attachmentID", attachmentID, "userID", userID)
+
+	file, err := uc.chatRepo.GetAttachment(attachmentID)
+	if err != nil {
+		uc.logger.Error("GetAttachment", "attachmentID", attachmentID, "error", err)
+		return nil, "", err
+	}
+
+	first, second, err := uc.chatRepo.GetChatParticipants(file.ChatID)
+	if err != nil {
+		uc.logger.Error("GetAttachment", "attachmentID", attachmentID, "error", err)
+		return nil, "", err
+	}
+	if userID != first && userID != second {
+		return nil, "", model.ErrAttachmentForbidden
+	}
+
+	data, err := uc.storage.GetAttachment(file.Path)
+	if err != nil {
+		uc.logger.Error("GetAttachment", "attachmentID", attachmentID, "error", err)
+		return nil, "", err
+	}
+
+	uc.logger.WithFields(&logrus.Fields{"attachmentID": attachmentID, "size": len(data)})
+	return data, file.ContentType, nil
+}
+
+// deleteAttachmentObjects removes the stored files of attachment rows that are
+// already gone. A failure only leaves an unreachable object behind, so it is
+// logged and the rest are still removed.
+func deleteAttachmentObjects(storage repository.AttachmentStorage, logger *logger.LogrusLogger, paths []string) {
+	for _, path := range paths {
+		if err := storage.DeleteAttachment(path); err != nil {
+			logger.Error("deleteAttachmentObjects", "path", path, "error", err)
+		}
+	}
+}
+
+type SweepAttachments struct {
+	chatRepo repository.ChatRepository
+	storage  repository.AttachmentStorage
+	logger   *logger.LogrusLogger
+}
+
+func NewSweepAttachmentsUseCase(
+	chatRepo repository.ChatRepository,
+	storage repository.AttachmentStorage,
+	logger *logger.LogrusLogger,
+) (*SweepAttachments, error) {
+	return &SweepAttachments{chatRepo: chatRepo, storage: storage, logger: logger}, nil
+}
+
+// Run sweeps unsent uploads every interval until ctx is done.
+func (uc *SweepAttachments) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.Sweep(model.UnboundAttachmentTTL); err != nil {
+				uc.logger.Error("SweepAttachments", "error", err)
+			}
+		}
+	}
+}
+
+// Sweep deletes uploads that were not sent in a message within olderThan,
+// with their stored files, and returns how many it deleted.
+func (uc *SweepAttachments) Sweep(olderThan time.Duration) (int, error) {
+	paths, err := uc.chatRepo.DeleteUnboundAttachments(olderThan)
+	if err != nil {
+		return 0, err
+	}
+	deleteAttachmentObjects(uc.storage, uc.logger, paths)
+
+	uc.logger.WithFields(&logrus.Fields{"deleted": len(paths)})
+	return len(paths), nil
+}
@@ -15,9 +15,9 @@ func NewCreateMessagesUseCase(chatRepo repository.ChatRepository, logger *logger
 	return &CreateMessages{chatRepo: chatRepo, logger: logger}, nil
 }
 
-func (gp *CreateMessages) CreateMessages(chatID int, userID int, content string) (int, error) {
-	gp.logger.Info("GetMessages", "chatID", chatID, "userID", userID, "content", content)
-	messageID, err := gp.chatRepo.CreateMessage(chatID, userID, content, 1)
+func (gp *CreateMessages) CreateMessages(chatID int, userID int, content string, attachmentIDs []int) (int, error) {
+	gp.logger.Info("GetMessages", "chatID", chatID, "userID", userID, "content", content, "attachments", attachmentIDs)
+	messageID, err := gp.chatRepo.CreateMessage(chatID, userID, content, 1, attachmentIDs)
 	if err != nil {
 		gp.logger.Error("GetMessages", "chatID", chatID, "messageID", messageID, "error", err)
 	} else {
@@ -9,22 +9,28 @@ import (
 
 type DeleteChat struct {
 	chatRepo repository.ChatRepository
+	storage  repository.AttachmentStorage
 	logger   *logger.LogrusLogger
 }
 
-func NewDeleteChatUseCase(chatRepo repository.ChatRepository, logger *logger.LogrusLogger) (*DeleteChat, error) {
+func NewDeleteChatUseCase(
+	chatRepo repository.ChatRepository,
+	storage repository.AttachmentStorage,
+	logger *logger.LogrusLogger,
+) (*DeleteChat, error) {
 
-	return &DeleteChat{chatRepo: chatRepo, logger: logger}, nil
+	return &DeleteChat{chatRepo: chatRepo, storage: storage, logger: logger}, nil
 }
 
 func (uc *DeleteChat) DeleteChat(firstID int, secondID int) error {
 	uc.logger.Info("DeleteChat", "firstID", firstID, "secondID", secondID)
 
-	err := uc.chatRepo.DeleteChat(firstID, secondID)
+	paths, err := uc.chatRepo.DeleteChat(firstID, secondID)
+	deleteAttachmentObjects(uc.storage, uc.logger, paths)
 	if err != nil {
 		uc.logger.Error("DeleteChat", "firstID", firstID, "secondID", secondID, "error", err)
 	} else {
-		uc.logger.WithFields(&logrus.Fields{"firstID": firstID, "secondID": secondID})
+		uc.logger.WithFields(&logrus.Fields{"firstID": firstID, "secondID": secondID, "attachments": len(paths)})
 	}
 	return err
 }
@@ -8,16 +8,22 @@ import (
 
 type DeleteMessage struct {
 	chatRepo repository.ChatRepository
+	storage  repository.AttachmentStorage
 	logger   *logger.LogrusLogger
 }
 
-func NewDeleteMessageUseCase(chatRepo repository.ChatRepository, logger *logger.LogrusLogger) (*DeleteMessage, error) {
-	return &DeleteMessage{chatRepo: chatRepo, logger: logger}, nil
+func NewDeleteMessageUseCase(
+	chatRepo repository.ChatRepository,
+	storage repository.AttachmentStorage,
+	logger *logger.LogrusLogger,
+) (*DeleteMessage, error) {
+	return &DeleteMessage{chatRepo: chatRepo, storage: storage, logger: logger}, nil
 }
 
 func (gp *DeleteMessage) DeleteMessage(messageID int, chatID int) error {
 	gp.logger.Info("DeleteMessage", "chatID", chatID, "messageID", messageID)
-	err := gp.chatRepo.DeleteMessage(messageID, chatID)
+	paths, err := gp.chatRepo.DeleteMessage(messageID, chatID)
+	deleteAttachmentObjects(gp.storage, gp.logger, paths)
 	if err != nil {
 		gp.logger.Error("DeleteMessage", "chatID", chatID, "messageID", messageID, "error", err)
 	} else {
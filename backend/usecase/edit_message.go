@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type EditMessage struct {
+	chatRepo repository.ChatRepository
+	logger   *logger.LogrusLogger
+}
+
+func NewEditMessageUseCase(chatRepo repository.ChatRepository, logger *logger.LogrusLogger) (*EditMessage, error) {
+	return &EditMessage{chatRepo: chatRepo, logger: logger}, nil
+}
+
+func (em *EditMessage) EditMessage(messageID int, chatID int, userID int, content string) (model.Message, error) {
+	em.logger.Info("EditMessage", "chatID", chatID, "messageID", messageID, "userID", userID)
+	if strings.TrimSpace(content) == "" {
+		return model.Message{}, model.ErrEmptyMessage
+	}
+
+	message, err := em.chatRepo.EditMessage(messageID, chatID, userID, content)
+	if err != nil {
+		em.logger.Error("EditMessage", "chatID", chatID, "messageID", messageID, "error", err)
+	} else {
+		em.logger.WithFields(&logrus.Fields{"chatID": chatID, "messageID": messageID})
+	}
+	return message, err
+}
@@ -37,8 +37,47 @@ func (gp *GetRecommendations) GetRecommendations(userId int) (model.Profile, err
 		return model.Profile{}, err
 	}
 
+	profile := profileFromProto(res.Profile)
+
+	gp.logger.WithFields(&logrus.Fields{
+		"profile": profile,
+	}).Info("GetProfileUseCase")
+	return profile, err
+
+}
+
+func (gp *GetRecommendations) GetRecommendationsPage(userId int, offset int, limit int) (model.RecommendationsPageResponse, error) {
+	gp.logger.WithFields(&logrus.Fields{"profile_id": userId, "offset": offset}).Info("GetRecommendationsPage")
+	res, err := gp.ProfilesService.GetRecommendationsPage(context.Background(), &profilespb.GetRecommendationsPageRequest{
+		ProfileId: int32(userId),
+		Offset:    int32(offset),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		gp.logger.WithFields(&logrus.Fields{
+			"error": err,
+		}).Error("GetRecommendationsPageUseCase")
+		return model.RecommendationsPageResponse{}, err
+	}
+
+	page := model.RecommendationsPageResponse{
+		Items:      make([]model.Recommendation, 0, len(res.GetItems())),
+		NextOffset: int(res.GetNextOffset()),
+		HasMore:    res.GetHasMore(),
+	}
+	for _, item := range res.GetItems() {
+		page.Items = append(page.Items, model.Recommendation{
+			Profile: profileFromProto(item.GetProfile()),
+			Score:   item.GetScore(),
+			Reasons: item.GetReasons(),
+		})
+	}
+	return page, nil
+}
+
+func profileFromProto(p *profilespb.Profile) model.Profile {
 	var prefs []model.Preference
-	for _, pref := range res.Profile.Preferences {
+	for _, pref := range p.Preferences {
 		prefs = append(prefs, model.Preference{
 			Description: pref.Description,
 			Value:       pref.Value,
@@ -46,7 +85,7 @@ func (gp *GetRecommendations) GetRecommendations(userId int) (model.Profile, err
 	}
 
 	var params []model.Preference
-	for _, pref := range res.Profile.Parametres {
+	for _, pref := range p.Parametres {
 		params = append(params, model.Preference{
 			Description: pref.Description,
 			Value:       pref.Value,
@@ -54,37 +93,32 @@ func (gp *GetRecommendations) GetRecommendations(userId int) (model.Profile, err
 	}
 
 	var likedBy []int
-	for _, like := range res.Profile.LikedBy {
+	for _, like := range p.LikedBy {
 		likedBy = append(likedBy, int(like))
 	}
 
 	premium := model.Premium{}
-	if res.Profile.Premium != nil {
-		premium.Status = res.Profile.Premium.Status
-		premium.Border = int32(res.Profile.Premium.Border)
+	if p.Premium != nil {
+		premium.Status = p.Premium.Status
+		premium.Border = int32(p.Premium.Border)
 	}
 
-	var profile model.Profile = model.Profile{
-		ProfileId:   int(res.Profile.ProfileId),
-		FirstName:   res.Profile.FirstName,
-		LastName:    res.Profile.LastName,
-		IsMale:      res.Profile.IsMale,
-		Height:      int(res.Profile.Height),
-		Goal:        int(res.Profile.Goal),
-		Birthday:    res.Profile.Birthday.AsTime(),
-		Description: res.Profile.Description,
-		Location:    res.Profile.Location,
-		Interests:   res.Profile.Interests,
+	return model.Profile{
+		ProfileId:   int(p.ProfileId),
+		FirstName:   p.FirstName,
+		LastName:    p.LastName,
+		IsMale:      p.IsMale,
+		Height:      int(p.Height),
+		Goal:        int(p.Goal),
+		Birthday:    p.Birthday.AsTime(),
+		Description: p.Description,
+		Location:    p.Location,
+		Interests:   p.Interests,
 		LikedBy:     likedBy,
 		Preferences: prefs,
 		Parameters:  params,
-		Photos:      res.Profile.Photos,
+		Photos:      p.Photos,
 		Premium:     premium,
+		Distance:    int(p.DistanceKm),
 	}
-
-	gp.logger.WithFields(&logrus.Fields{
-		"profile": profile,
-	}).Info("GetProfileUseCase")
-	return profile, err
-
 }
@@ -27,3 +27,11 @@ func (uc *GetChatParticipants) GetChatParticipants(chatID int) (int, int, error)
 	}
 	return first, second, err
 }
+
+func (uc *GetChatParticipants) IsChatClosed(chatID int) (bool, error) {
+	closed, err := uc.chatRepo.IsChatClosed(chatID)
+	if err != nil {
+		uc.logger.Error("IsChatClosed", "chatID", chatID, "error", err)
+	}
+	return closed, err
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type GetMessagesPage struct {
+	chatRepo repository.ChatRepository
+	logger   *logger.LogrusLogger
+}
+
+func NewGetMessagesPageUseCase(chatRepo repository.ChatRepository, logger *logger.LogrusLogger) (*GetMessagesPage, error) {
+	return &GetMessagesPage{chatRepo: chatRepo, logger: logger}, nil
+}
+
+func (gp *GetMessagesPage) GetMessagesPage(chatID int, beforeMessageID int, limit int) (model.MessagesPage, error) {
+	gp.logger.Info("GetMessagesPage", "chatID", chatID, "beforeMessageID", beforeMessageID, "limit", limit)
+
+	if limit <= 0 {
+		limit = model.DefaultMessagesPageSize
+	}
+	if limit > model.MaxMessagesPageSize {
+		limit = model.MaxMessagesPageSize
+	}
+	if beforeMessageID < 0 {
+		beforeMessageID = 0
+	}
+
+	// one extra row tells us whether an older page exists
+	messages, err := gp.chatRepo.GetMessagesPage(chatID, beforeMessageID, limit+1)
+	if err != nil {
+		gp.logger.Error("GetMessagesPage", "chatID", chatID, "error", err)
+		return model.MessagesPage{}, err
+	}
+
+	page := model.MessagesPage{Messages: []model.Message{}}
+	if len(messages) > limit {
+		messages = messages[:limit]
+		page.HasMore = true
+	}
+
+	for i := len(messages) - 1; i >= 0; i-- {
+		page.Messages = append(page.Messages, messages[i])
+	}
+	if page.HasMore {
+		page.NextCursor = page.Messages[0].MessageID
+	}
+
+	gp.logger.WithFields(&logrus.Fields{"chatID": chatID, "count": len(page.Messages), "nextCursor": page.NextCursor})
+	return page, nil
+}
+
+// GetFirstPage returns the newest page of chatID for userID and, apart from
+// it, the messages still waiting in userID's cache. Those are left out of the
+// page so that the realtime connection hands each of them over once, as new.
+func (gp *GetMessagesPage) GetFirstPage(chatID int, userID int) (model.MessagesPage, []model.Message, error) {
+	fresh, err := gp.chatRepo.GetMessagesFromCache(chatID, userID)
+	if err != nil {
+		gp.logger.Error("GetFirstPage", "chatID", chatID, "error", err)
+		return model.MessagesPage{}, nil, err
+	}
+
+	page, err := gp.GetMessagesPage(chatID, 0, model.DefaultMessagesPageSize)
+	if err != nil {
+		return model.MessagesPage{}, nil, err
+	}
+
+	cached := make(map[int]bool, len(fresh))
+	for _, m := range fresh {
+		cached[m.MessageID] = true
+	}
+	messages := page.Messages[:0]
+	for _, m := range page.Messages {
+		if !cached[m.MessageID] {
+			messages = append(messages, m)
+		}
+	}
+	page.Messages = messages
+
+	return page, fresh, nil
+}
@@ -28,10 +28,11 @@ func NewGetProfilesForUserUseCase(
 	}, nil
 }
 
-func (gp *GetProfilesForUser) GetProfiles(forUserId int) ([]model.Profile, error) {
+func (gp *GetProfilesForUser) GetProfiles(forUserId int, maxDistanceKm int) ([]model.Profile, error) {
 	gp.logger.Info("GetProfilesForUserUseCase")
 	req := &profilespb.GetProfilesRequest{
-		ForUserId: int32(forUserId),
+		ForUserId:     int32(forUserId),
+		MaxDistanceKm: int32(maxDistanceKm),
 	}
 	resp, err := gp.ProfilesService.GetProfiles(context.Background(), req)
 
@@ -71,6 +72,7 @@ func (gp *GetProfilesForUser) GetProfiles(forUserId int) ([]model.Profile, error
 				Status: match.Premium.Status,
 				Border: int32(match.Premium.Border),
 			},
+			Distance: int(match.DistanceKm),
 		})
 	}
 	gp.logger.WithFields(&logrus.Fields{
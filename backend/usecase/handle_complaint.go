@@ -1,9 +1,14 @@
 package usecase
 
 import (
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
 )
 
 type HandleComplaint struct {
@@ -21,8 +26,39 @@ func NewHandleComplaintUseCase(
 	return &HandleComplaint{QueryService: queryService, logger: logger}, nil
 }
 
-func (uc *HandleComplaint) HandleComplaint(complaint_id int, new_status int) error {
-	uc.logger.Info("DeleteComplaint", "complaint_id", complaint_id)
-	err := uc.QueryService.HandleComplaint(complaint_id, new_status)
+// HandleComplaint resolves or reopens a complaint on behalf of admin_id.
+// Resolving needs one of model.ModerationReasons; reopening may give one.
+func (uc *HandleComplaint) HandleComplaint(complaint_id int, admin_id int, new_status int, reason string, note string) error {
+	uc.logger.WithFields(&logrus.Fields{
+		"complaint_id": complaint_id,
+		"admin_id":     admin_id,
+		"new_status":   new_status,
+		"reason":       reason,
+	}).Info("HandleComplaint")
+
+	if err := validateResolution(new_status, reason, note); err != nil {
+		return err
+	}
+
+	err := uc.QueryService.HandleComplaint(complaint_id, admin_id, new_status, reason, note)
+	if err != nil {
+		uc.logger.Error("HandleComplaint", "complaint_id", complaint_id, "admin_id", admin_id, "error", err)
+	}
 	return err
 }
+
+func validateResolution(status int, reason string, note string) error {
+	if !slices.Contains(model.ComplaintStatuses, status) {
+		return fmt.Errorf("%w: unknown status %d", model.ErrInvalidModeration, status)
+	}
+	if reason == "" && status != model.ComplaintPending {
+		return fmt.Errorf("%w: a reason is required", model.ErrInvalidModeration)
+	}
+	if reason != "" && !slices.Contains(model.ModerationReasons, reason) {
+		return fmt.Errorf("%w: unknown reason %q", model.ErrInvalidModeration, reason)
+	}
+	if utf8.RuneCountInString(note) > model.MaxComplaintNoteLength {
+		return fmt.Errorf("%w: note is longer than %d characters", model.ErrInvalidModeration, model.MaxComplaintNoteLength)
+	}
+	return nil
+}
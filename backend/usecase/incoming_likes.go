@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetIncomingLikes struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetIncomingLikesUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetIncomingLikes, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrGetIncomingLikesUC
+	}
+	return &GetIncomingLikes{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gl *GetIncomingLikes) GetIncomingLikes(profileID int, cursor int, limit int, countOnly bool) (model.IncomingLikesResponse, error) {
+	gl.logger.WithFields(&logrus.Fields{"profile_id": profileID, "cursor": cursor, "count_only": countOnly}).Info("GetIncomingLikes")
+	resp, err := gl.ProfilesService.GetIncomingLikes(context.Background(), &profilespb.GetIncomingLikesRequest{
+		ProfileId: int32(profileID),
+		Cursor:    int32(cursor),
+		Limit:     int32(limit),
+		CountOnly: countOnly,
+	})
+	if err != nil {
+		gl.logger.Error("GetIncomingLikes", "profile_id", profileID, "error", err)
+		return model.IncomingLikesResponse{}, err
+	}
+
+	page := model.IncomingLikesResponse{
+		Likes:      make([]model.IncomingLike, 0, len(resp.GetLikes())),
+		Total:      int(resp.GetTotal()),
+		NextCursor: int(resp.GetNextCursor()),
+		HasMore:    resp.GetHasMore(),
+		Blurred:    countOnly,
+	}
+	for _, like := range resp.GetLikes() {
+		p := like.GetProfile()
+		var prefs []model.Preference
+		for _, pref := range p.GetPreferences() {
+			prefs = append(prefs, model.Preference{
+				Description: pref.Description,
+				Value:       pref.Value,
+			})
+		}
+		page.Likes = append(page.Likes, model.IncomingLike{
+			Profile: model.Profile{
+				ProfileId:   int(p.GetProfileId()),
+				FirstName:   p.GetFirstName(),
+				LastName:    p.GetLastName(),
+				IsMale:      p.GetIsMale(),
+				Goal:        int(p.GetGoal()),
+				Height:      int(p.GetHeight()),
+				Birthday:    p.GetBirthday().AsTime(),
+				Description: p.GetDescription(),
+				Location:    p.GetLocation(),
+				Interests:   p.GetInterests(),
+				Preferences: prefs,
+				Photos:      p.GetPhotos(),
+			},
+			Status:    int(like.GetStatus()),
+			CreatedAt: like.GetCreatedAt().AsTime(),
+		})
+	}
+	return page, nil
+}
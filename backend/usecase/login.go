@@ -44,8 +44,10 @@ func NewUserLogInUseCase(
 }
 
 type LogInInput struct {
-	Login    string
-	Password string
+	Login     string
+	Password  string
+	UserAgent string
+	IP        string
 }
 
 func (uc *UserLogIn) CreateSession(ctx context.Context, input LogInInput) (model.Session, error) {
@@ -68,8 +70,14 @@ func (uc *UserLogIn) CreateSession(ctx context.Context, input LogInInput) (model
 		return model.Session{}, model.ErrInvalidPassword
 	}
 
+	if uc.hasher.NeedsRehash(user.Password) {
+		uc.rehashPassword(ctx, user, input.Password)
+	}
+
 	req := &sessionpb.CreateSessionRequest{
-		UserId: int32(user.UserId),
+		UserId:    int32(user.UserId),
+		UserAgent: input.UserAgent,
+		Ip:        input.IP,
 	}
 
 	sessionResp, err := uc.SessionService.CreateSession(ctx, req)
@@ -80,11 +88,54 @@ func (uc *UserLogIn) CreateSession(ctx context.Context, input LogInInput) (model
 	session := model.Session{
 		SessionId: sessionResp.SessionId,
 		UserId:    int(user.UserId),
+		Expires:   sessionResp.GetExpires().AsDuration(),
 	}
 
 	return session, err
 }
 
+// RotateSession swaps sessionId for a new identifier of the same user. It is
+// called whenever the user's privileges change so that a leaked identifier
+// does not outlive the change.
+func (uc *UserLogIn) RotateSession(ctx context.Context, sessionId string, userAgent string, ip string) (model.Session, error) {
+	sessionResp, err := uc.SessionService.RotateSession(ctx, &sessionpb.RotateSessionRequest{
+		SessionId: sessionId,
+		UserAgent: userAgent,
+		Ip:        ip,
+	})
+	if err != nil {
+		uc.logger.Error("RotateSession", "error", err)
+		return model.Session{}, err
+	}
+
+	return model.Session{
+		SessionId: sessionResp.GetSessionId(),
+		UserId:    int(sessionResp.GetUserId()),
+		Expires:   sessionResp.GetExpires().AsDuration(),
+	}, nil
+}
+
+// rehashPassword upgrades a legacy or outdated hash after a successful login.
+// Failures are only logged: the old hash keeps working until the next attempt.
+func (uc *UserLogIn) rehashPassword(ctx context.Context, user model.User, password string) {
+	newHash, err := uc.hasher.Hash(password)
+	if err != nil {
+		uc.logger.Error("rehashPassword", "user_id", user.UserId, "error", err)
+		return
+	}
+
+	_, err = uc.UsersService.UpdatePasswordHash(ctx, &userspb.UpdatePasswordHashRequest{
+		UserId:  int32(user.UserId),
+		OldHash: user.Password,
+		NewHash: newHash,
+	})
+	if err != nil {
+		uc.logger.Error("rehashPassword", "user_id", user.UserId, "error", err)
+		return
+	}
+	uc.logger.Info("rehashPassword", "user_id", user.UserId)
+}
+
 func (uc *UserLogIn) CheckAttempts(ctx context.Context, userIP string) (string, error) {
 	ipRequest := &sessionpb.IPRequest{
 		Ip: userIP,
@@ -39,16 +39,12 @@ func (ul *UserLogOut) Logout(sessionId string) error {
 		return err
 	}
 
-	userIdStr := sessionResp.Data
-	req = &sessionpb.SessionIdRequest{
-		SessionId: userIdStr,
-	}
-	ul.logger.Info("Logout", "userId", userIdStr)
+	ul.logger.Info("Logout", "userId", sessionResp.Data)
 	_, err = ul.SessionService.DeleteSession(context.Background(), req)
 	if err != nil {
 		return err
 	}
-	ul.logger.Info("Logout", "ok", userIdStr)
+	ul.logger.Info("Logout", "ok", sessionResp.Data)
 
 	return nil
 }
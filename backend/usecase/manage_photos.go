@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type ManagePhotos struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewManagePhotosUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*ManagePhotos, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrManagePhotosUC
+	}
+	return &ManagePhotos{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (mp *ManagePhotos) ReorderPhotos(userID int, paths []string) error {
+	mp.logger.WithFields(&logrus.Fields{
+		"user_id": userID,
+		"paths":   paths,
+	})
+	_, err := mp.ProfilesService.ReorderProfileImages(context.Background(), &profilespb.ReorderProfileImagesRequest{
+		UserId: int32(userID),
+		Paths:  paths,
+	})
+	if err != nil {
+		mp.logger.Error("ReorderPhotos", "user_id", userID, "error", err)
+	}
+	return err
+}
+
+func (mp *ManagePhotos) SetPrimaryPhoto(userID int, path string) error {
+	mp.logger.WithFields(&logrus.Fields{
+		"user_id": userID,
+		"path":    path,
+	})
+	_, err := mp.ProfilesService.SetPrimaryProfileImage(context.Background(), &profilespb.SetPrimaryProfileImageRequest{
+		UserId: int32(userID),
+		Path:   path,
+	})
+	if err != nil {
+		mp.logger.Error("SetPrimaryPhoto", "user_id", userID, "error", err)
+	}
+	return err
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// Moderation runs the case side of complaints: assignment, internal notes
+// and the audit trail. Callers check that the acting user is an admin.
+type Moderation struct {
+	complaintRepo repository.ComplaintRepository
+	logger        *logger.LogrusLogger
+}
+
+func NewModerationUseCase(
+	complaintRepo repository.ComplaintRepository,
+	logger *logger.LogrusLogger,
+) (*Moderation, error) {
+	if complaintRepo == nil || logger == nil {
+		return nil, model.ErrModerationUC
+	}
+	return &Moderation{complaintRepo: complaintRepo, logger: logger}, nil
+}
+
+// Assign hands the complaint to assigneeID, who must be an admin; 0 drops
+// the assignment.
+func (uc *Moderation) Assign(complaintID int, adminID int, assigneeID int) error {
+	uc.logger.WithFields(&logrus.Fields{
+		"complaint_id": complaintID,
+		"admin_id":     adminID,
+		"assignee_id":  assigneeID,
+	}).Info("AssignComplaint")
+
+	if assigneeID < 0 {
+		return fmt.Errorf("%w: invalid assignee", model.ErrInvalidModeration)
+	}
+
+	err := uc.complaintRepo.AssignComplaint(complaintID, adminID, assigneeID)
+	if err != nil {
+		uc.logger.Error("AssignComplaint", "complaint_id", complaintID, "admin_id", adminID, "error", err)
+	}
+	return err
+}
+
+func (uc *Moderation) AddNote(complaintID int, adminID int, note string) (model.ComplaintNote, error) {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return model.ComplaintNote{}, fmt.Errorf("%w: note is empty", model.ErrInvalidModeration)
+	}
+	if utf8.RuneCountInString(note) > model.MaxComplaintNoteLength {
+		return model.ComplaintNote{}, fmt.Errorf("%w: note is longer than %d characters", model.ErrInvalidModeration, model.MaxComplaintNoteLength)
+	}
+
+	added, err := uc.complaintRepo.AddComplaintNote(complaintID, adminID, note)
+	if err != nil {
+		uc.logger.Error("AddComplaintNote", "complaint_id", complaintID, "admin_id", adminID, "error", err)
+	}
+	return added, err
+}
+
+func (uc *Moderation) History(complaintID int) (model.ComplaintHistory, error) {
+	history, err := uc.complaintRepo.GetComplaintHistory(complaintID)
+	if err != nil {
+		uc.logger.Error("GetComplaintHistory", "complaint_id", complaintID, "error", err)
+	}
+	return history, err
+}
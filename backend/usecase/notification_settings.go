@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type NotificationSettings struct {
+	notifRepo repository.NotificationsRepository
+	logger    *logger.LogrusLogger
+}
+
+func NewNotificationSettingsUseCase(
+	notifRepo repository.NotificationsRepository,
+	logger *logger.LogrusLogger,
+) (*NotificationSettings, error) {
+	if notifRepo == nil || logger == nil {
+		return nil, model.ErrNotificationSettingsUC
+	}
+	return &NotificationSettings{notifRepo: notifRepo, logger: logger}, nil
+}
+
+func (uc *NotificationSettings) Get(userID int) (model.NotificationSettings, error) {
+	settings, err := uc.notifRepo.GetNotificationSettings(userID)
+	if err != nil {
+		uc.logger.Error("GetNotificationSettings", "user_id", userID, "error", err)
+		return settings, err
+	}
+	if settings.MutedTypes == nil {
+		settings.MutedTypes = []string{}
+	}
+	if settings.MutedChats == nil {
+		settings.MutedChats = []int{}
+	}
+	return settings, nil
+}
+
+// Update replaces all settings of userID. Invalid settings are reported
+// with an error wrapping model.ErrInvalidNotifSettings.
+func (uc *NotificationSettings) Update(userID int, settings model.NotificationSettings) (model.NotificationSettings, error) {
+	uc.logger.WithFields(&logrus.Fields{"user_id": userID}).Info("UpdateNotificationSettings")
+
+	settings, err := normalizeNotificationSettings(settings)
+	if err != nil {
+		return settings, err
+	}
+	if err := uc.notifRepo.UpdateNotificationSettings(userID, settings); err != nil {
+		uc.logger.Error("UpdateNotificationSettings", "user_id", userID, "error", err)
+		return settings, err
+	}
+	return settings, nil
+}
+
+func normalizeNotificationSettings(settings model.NotificationSettings) (model.NotificationSettings, error) {
+	types := []string{}
+	for _, t := range settings.MutedTypes {
+		if !slices.Contains(model.NotificationKinds, t) {
+			return settings, fmt.Errorf("%w: unknown type %q", model.ErrInvalidNotifSettings, t)
+		}
+		if !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+	settings.MutedTypes = types
+
+	chats := []int{}
+	for _, id := range settings.MutedChats {
+		if id <= 0 {
+			return settings, fmt.Errorf("%w: invalid chat id %d", model.ErrInvalidNotifSettings, id)
+		}
+		if !slices.Contains(chats, id) {
+			chats = append(chats, id)
+		}
+	}
+	if len(chats) > model.MaxMutedChats {
+		return settings, fmt.Errorf("%w: at most %d muted chats", model.ErrInvalidNotifSettings, model.MaxMutedChats)
+	}
+	settings.MutedChats = chats
+
+	if quiet := settings.QuietHours; quiet != nil {
+		if _, err := time.Parse(model.QuietHoursLayout, quiet.Start); err != nil {
+			return settings, fmt.Errorf("%w: invalid quiet hours start", model.ErrInvalidNotifSettings)
+		}
+		if _, err := time.Parse(model.QuietHoursLayout, quiet.End); err != nil {
+			return settings, fmt.Errorf("%w: invalid quiet hours end", model.ErrInvalidNotifSettings)
+		}
+		quiet.Timezone = quietHoursTimezone(quiet)
+		if _, err := time.LoadLocation(quiet.Timezone); err != nil {
+			return settings, fmt.Errorf("%w: unknown timezone %q", model.ErrInvalidNotifSettings, quiet.Timezone)
+		}
+	}
+	return settings, nil
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+)
+
+type Outbound struct {
+	outboxRepo repository.OutboxRepository
+	senders    map[string]repository.Sender
+	logger     *logger.LogrusLogger
+}
+
+// NewOutboundUseCase takes a sender per channel; channels without a sender
+// get no digests.
+func NewOutboundUseCase(
+	outboxRepo repository.OutboxRepository,
+	senders map[string]repository.Sender,
+	logger *logger.LogrusLogger,
+) (*Outbound, error) {
+	if outboxRepo == nil || len(senders) == 0 || logger == nil {
+		return nil, model.ErrOutboundUC
+	}
+	return &Outbound{outboxRepo: outboxRepo, senders: senders, logger: logger}, nil
+}
+
+// Run queues digests of notifications older than digestDelay and sends the
+// outbox until ctx is done.
+func (uc *Outbound) Run(ctx context.Context, digestDelay time.Duration) {
+	digests := time.NewTicker(model.DigestInterval)
+	defer digests.Stop()
+	dispatch := time.NewTicker(model.OutboxDispatchInterval)
+	defer dispatch.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-digests.C:
+			if _, err := uc.CreateDigests(digestDelay); err != nil {
+				uc.logger.Error("CreateDigests", "error", err)
+			}
+		case <-dispatch.C:
+			if _, err := uc.Dispatch(ctx); err != nil {
+				uc.logger.Error("DispatchOutbound", "error", err)
+			}
+		}
+	}
+}
+
+// CreateDigests queues a digest for every user with unread matches or
+// messages older than olderThan and returns how many messages were queued.
+func (uc *Outbound) CreateDigests(olderThan time.Duration) (int, error) {
+	return uc.outboxRepo.CreateDigests(olderThan, model.DigestKinds, uc.composeDigest)
+}
+
+func (uc *Outbound) composeDigest(digest model.Digest) []model.OutboundMessage {
+	lines := []string{}
+	if digest.Matches > 0 {
+		lines = append(lines, fmt.Sprintf(model.DigestMatchesFormat, digest.Matches))
+	}
+	if digest.Messages > 0 {
+		lines = append(lines, fmt.Sprintf(model.DigestMessagesFormat, digest.Messages))
+	}
+
+	messages := []model.OutboundMessage{}
+	for _, channel := range model.OutboundChannels {
+		if _, ok := uc.senders[channel]; !ok {
+			continue
+		}
+		// the webhook is one endpoint for every user, so it only gets the
+		// user id and never an address
+		recipient := ""
+		if channel == model.OutboundEmail {
+			recipient = digest.Email
+		}
+		messages = append(messages, model.OutboundMessage{
+			UserID:    digest.UserID,
+			Channel:   channel,
+			Recipient: recipient,
+			Subject:   fmt.Sprintf(model.DigestSubjectFormat, digest.Matches+digest.Messages),
+			Body:      strings.Join(lines, "\n"),
+		})
+	}
+	return messages
+}
+
+// Dispatch sends the due outbox messages and returns how many were sent.
+// A failed send is retried with a growing delay until OutboxMaxAttempts.
+func (uc *Outbound) Dispatch(ctx context.Context) (int, error) {
+	sent := 0
+	for {
+		messages, err := uc.outboxRepo.ClaimOutbound(model.OutboxBatchSize, model.OutboxLease)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, msg := range messages {
+			if err := uc.send(ctx, msg); err != nil {
+				uc.logger.Error("DispatchOutbound", "outbox_id", msg.ID, "channel", msg.Channel, "attempts", msg.Attempts, "error", err)
+				uc.fail(msg, err)
+				continue
+			}
+			if err := uc.outboxRepo.MarkSent(msg.ID); err != nil {
+				uc.logger.Error("DispatchOutbound", "outbox_id", msg.ID, "error", err)
+			}
+			sent++
+		}
+
+		// failed messages are not due again yet, so the loop ends
+		if len(messages) < model.OutboxBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+func (uc *Outbound) send(ctx context.Context, msg model.OutboundMessage) error {
+	sender, ok := uc.senders[msg.Channel]
+	if !ok {
+		return model.ErrSenderNotConfigured
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, model.OutboundSendTimeout)
+	defer cancel()
+	return sender.Send(ctx, msg)
+}
+
+func (uc *Outbound) fail(msg model.OutboundMessage, sendErr error) {
+	var err error
+	if msg.Attempts >= model.OutboxMaxAttempts {
+		err = uc.outboxRepo.MarkFailed(msg.ID, sendErr.Error())
+	} else {
+		err = uc.outboxRepo.Retry(msg.ID, sendErr.Error(), outboxBackoff(msg.Attempts))
+	}
+	if err != nil {
+		uc.logger.Error("DispatchOutbound", "outbox_id", msg.ID, "error", err)
+	}
+}
+
+// outboxBackoff doubles the delay after every attempt, up to OutboxMaxBackoff.
+func outboxBackoff(attempts int) time.Duration {
+	delay := model.OutboxBaseBackoff
+	for i := 1; i < attempts && delay < model.OutboxMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > model.OutboxMaxBackoff {
+		delay = model.OutboxMaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type UpdatePresence struct {
+	presenceRepo repository.PresenceRepository
+	chatRepo     repository.ChatRepository
+	logger       *logger.LogrusLogger
+}
+
+func NewUpdatePresenceUseCase(
+	presenceRepo repository.PresenceRepository,
+	chatRepo repository.ChatRepository,
+	logger *logger.LogrusLogger,
+) (*UpdatePresence, error) {
+	return &UpdatePresence{presenceRepo: presenceRepo, chatRepo: chatRepo, logger: logger}, nil
+}
+
+// Heartbeat keeps the connection live and tells peers again if the user had
+// no live connection in between.
+func (uc *UpdatePresence) Heartbeat(userID int, connID string) error {
+	restored, err := uc.presenceRepo.Heartbeat(userID, connID)
+	if err != nil {
+		uc.logger.Error("Heartbeat", "userID", userID, "error", err)
+		return err
+	}
+	if !restored {
+		return nil
+	}
+	return uc.notifyPeers(model.Presence{ProfileId: userID, IsOnline: true, LastSeen: time.Now()})
+}
+
+// SetOnline records a new realtime connection; peers are told only when it is
+// the user's first connection on any instance.
+func (uc *UpdatePresence) SetOnline(userID int, connID string) error {
+	uc.logger.Info("SetOnline", "userID", userID)
+	first, err := uc.presenceRepo.SetOnline(userID, connID)
+	if err != nil {
+		uc.logger.Error("SetOnline", "userID", userID, "error", err)
+		return err
+	}
+	if !first {
+		return nil
+	}
+	return uc.notifyPeers(model.Presence{ProfileId: userID, IsOnline: true, LastSeen: time.Now()})
+}
+
+// SetOffline records a closed realtime connection; peers are told only when
+// the user has no connection left on any instance.
+func (uc *UpdatePresence) SetOffline(userID int, connID string) error {
+	uc.logger.Info("SetOffline", "userID", userID)
+	last, err := uc.presenceRepo.SetOffline(userID, connID)
+	if err != nil {
+		uc.logger.Error("SetOffline", "userID", userID, "error", err)
+		return err
+	}
+	if !last {
+		return nil
+	}
+	return uc.notifyPeers(model.Presence{ProfileId: userID, IsOnline: false, LastSeen: time.Now()})
+}
+
+func (uc *UpdatePresence) notifyPeers(presence model.Presence) error {
+	peers, err := uc.chatRepo.GetChatPeers(presence.ProfileId)
+	if err != nil {
+		uc.logger.Error("notifyPeers", "userID", presence.ProfileId, "error", err)
+		return err
+	}
+
+	event, err := json.Marshal(map[string]interface{}{
+		"type":     "presence",
+		"user_id":  presence.ProfileId,
+		"isOnline": presence.IsOnline,
+		"lastSeen": presence.LastSeen,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, peerID := range peers {
+		if err := uc.presenceRepo.PublishEvent(peerID, event); err != nil {
+			uc.logger.Error("notifyPeers", "userID", presence.ProfileId, "peerID", peerID, "error", err)
+			return err
+		}
+	}
+	uc.logger.WithFields(&logrus.Fields{"userID": presence.ProfileId, "peers": len(peers)})
+	return nil
+}
+
+type GetPresence struct {
+	presenceRepo repository.PresenceRepository
+	chatRepo     repository.ChatRepository
+	logger       *logger.LogrusLogger
+}
+
+func NewGetPresenceUseCase(
+	presenceRepo repository.PresenceRepository,
+	chatRepo repository.ChatRepository,
+	logger *logger.LogrusLogger,
+) (*GetPresence, error) {
+	return &GetPresence{presenceRepo: presenceRepo, chatRepo: chatRepo, logger: logger}, nil
+}
+
+func (uc *GetPresence) GetPresence(userID int) ([]model.Presence, error) {
+	uc.logger.Info("GetPresence", "userID", userID)
+	peers, err := uc.chatRepo.GetChatPeers(userID)
+	if err != nil {
+		uc.logger.Error("GetPresence", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	presence, err := uc.presenceRepo.GetPresence(peers)
+	if err != nil {
+		uc.logger.Error("GetPresence", "userID", userID, "error", err)
+	} else {
+		uc.logger.WithFields(&logrus.Fields{"userID": userID, "count": len(presence)})
+	}
+	return presence, err
+}
+
+type SetTyping struct {
+	presenceRepo repository.PresenceRepository
+	logger       *logger.LogrusLogger
+}
+
+func NewSetTypingUseCase(presenceRepo repository.PresenceRepository, logger *logger.LogrusLogger) (*SetTyping, error) {
+	return &SetTyping{presenceRepo: presenceRepo, logger: logger}, nil
+}
+
+// SetTyping forwards a typing event to the peer unless the sender has already
+// sent one for this chat within model.TypingInterval.
+func (uc *SetTyping) SetTyping(chatID int, userID int, peerID int) (bool, error) {
+	allowed, err := uc.presenceRepo.TryTyping(chatID, userID)
+	if err != nil {
+		uc.logger.Error("SetTyping", "chatID", chatID, "userID", userID, "error", err)
+		return false, err
+	}
+	if !allowed {
+		return false, nil
+	}
+
+	event, err := json.Marshal(map[string]interface{}{
+		"type":    "typing",
+		"chat_id": chatID,
+		"user_id": userID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if err := uc.presenceRepo.PublishChatEvent(peerID, chatID, event); err != nil {
+		uc.logger.Error("SetTyping", "chatID", chatID, "userID", userID, "error", err)
+		return false, err
+	}
+	return true, nil
+}
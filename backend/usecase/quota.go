@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type Quotas struct {
+	quotaRepo repository.QuotaRepository
+	logger    *logger.LogrusLogger
+	now       func() time.Time
+}
+
+func NewQuotasUseCase(
+	quotaRepo repository.QuotaRepository,
+	logger *logger.LogrusLogger,
+) (*Quotas, error) {
+	if quotaRepo == nil || logger == nil {
+		return nil, model.ErrQuotaUC
+	}
+	return &Quotas{quotaRepo: quotaRepo, logger: logger, now: time.Now}, nil
+}
+
+// QuotaLimit returns the daily limit of kind for a tier.
+func QuotaLimit(tier int, kind string) int {
+	limits, ok := model.DailyQuotas[tier]
+	if !ok {
+		limits = model.DailyQuotas[1]
+	}
+	return limits[kind]
+}
+
+// QuotaResetAt is the next midnight UTC, when every daily quota starts over.
+func QuotaResetAt(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
+
+// Consume takes one unit of kind. It returns model.ErrQuotaExceeded, along
+// with the current status, when the tier's daily limit is used up.
+func (q *Quotas) Consume(userID int, tier int, kind string) (model.QuotaStatus, error) {
+	limit := QuotaLimit(tier, kind)
+	st := model.QuotaStatus{Kind: kind, Limit: limit, Remaining: model.Unlimited}
+
+	allowed, used, err := q.quotaRepo.Consume(userID, kind, limit, QuotaResetAt(q.now()))
+	if err != nil {
+		q.logger.WithFields(&logrus.Fields{"user_id": userID, "kind": kind, "error": err}).Error("Consume")
+		return st, err
+	}
+
+	st.Used = used
+	if limit != model.Unlimited {
+		st.Remaining = max(limit-used, 0)
+	}
+	if !allowed {
+		return st, model.ErrQuotaExceeded
+	}
+	return st, nil
+}
+
+// Refund gives back a unit taken for an action that did not go through.
+func (q *Quotas) Refund(userID int, kind string) {
+	if err := q.quotaRepo.Refund(userID, kind, QuotaResetAt(q.now())); err != nil {
+		q.logger.WithFields(&logrus.Fields{"user_id": userID, "kind": kind, "error": err}).Error("Refund")
+	}
+}
+
+func (q *Quotas) Status(userID int, tier int) (model.QuotaResponse, error) {
+	resetAt := QuotaResetAt(q.now())
+	used, err := q.quotaRepo.Used(userID, model.QuotaKinds, resetAt)
+	if err != nil {
+		q.logger.WithFields(&logrus.Fields{"user_id": userID, "error": err}).Error("Status")
+		return model.QuotaResponse{}, err
+	}
+
+	resp := model.QuotaResponse{Tier: tier, ResetAt: resetAt}
+	for _, kind := range model.QuotaKinds {
+		limit := QuotaLimit(tier, kind)
+		st := model.QuotaStatus{Kind: kind, Limit: limit, Used: used[kind], Remaining: model.Unlimited}
+		if limit != model.Unlimited {
+			st.Remaining = max(limit-used[kind], 0)
+		}
+		resp.Quotas = append(resp.Quotas, st)
+	}
+	return resp, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type RewindLike struct {
+	ProfileService profilespb.ProfilesServiceClient
+	notifRepo      repository.NotificationsRepository
+	logger         *logger.LogrusLogger
+}
+
+func NewRewindLikeUseCase(
+	ProfileService profilespb.ProfilesServiceClient,
+	notifRepo repository.NotificationsRepository,
+	logger *logger.LogrusLogger,
+) (*RewindLike, error) {
+	if ProfileService == nil || notifRepo == nil || logger == nil {
+		return nil, model.ErrRewindLikeUC
+	}
+	return &RewindLike{ProfileService: ProfileService, notifRepo: notifRepo, logger: logger}, nil
+}
+
+// Rewind undoes the latest swipe of profileID made within window. When the
+// swipe produced a match, the match notifications of both users go too.
+// It returns the profile that was swiped, its status and whether a match was
+// removed.
+func (rl *RewindLike) Rewind(profileID int, window time.Duration) (int, int, bool, error) {
+	rl.logger.WithFields(&logrus.Fields{"profile_id": profileID, "window": window}).Info("RewindLike")
+
+	resp, err := rl.ProfileService.RewindProfileLike(context.Background(), &profilespb.RewindProfileLikeRequest{
+		ProfileId:     int32(profileID),
+		WindowSeconds: int32(window.Seconds()),
+	})
+	if err != nil {
+		rl.logger.Error("RewindLike", "profile_id", profileID, "error", err)
+		return 0, 0, false, err
+	}
+
+	likedID := int(resp.GetLikedProfileId())
+	if resp.GetMatchRemoved() {
+		rl.deleteMatchNotification(likedID, profileID)
+		rl.deleteMatchNotification(profileID, likedID)
+	}
+
+	return likedID, int(resp.GetStatus()), resp.GetMatchRemoved(), nil
+}
+
+func (rl *RewindLike) deleteMatchNotification(userID int, peerID int) {
+	err := rl.notifRepo.DeleteLatestNotification(userID, model.NotificationMatch, peerID)
+	if err != nil {
+		rl.logger.Error("RewindLike", "user_id", userID, "peer_id", peerID, "error", err)
+	}
+}
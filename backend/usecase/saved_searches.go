@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type SavedSearches struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	searchRepo      repository.SavedSearchRepository
+	notifRepo       repository.NotificationsRepository
+	logger          *logger.LogrusLogger
+}
+
+func NewSavedSearchesUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	searchRepo repository.SavedSearchRepository,
+	notifRepo repository.NotificationsRepository,
+	logger *logger.LogrusLogger,
+) (*SavedSearches, error) {
+	if ProfilesService == nil || searchRepo == nil || notifRepo == nil || logger == nil {
+		return nil, model.ErrSavedSearchesUC
+	}
+	return &SavedSearches{
+		ProfilesService: ProfilesService,
+		searchRepo:      searchRepo,
+		notifRepo:       notifRepo,
+		logger:          logger,
+	}, nil
+}
+
+// Save stores params under name, replacing a search with the same name.
+// Paging fields are not part of a saved search.
+func (uc *SavedSearches) Save(profileID int, name string, params model.SearchProfileRequest) (model.SavedSearch, error) {
+	uc.logger.WithFields(&logrus.Fields{"profile_id": profileID, "name": name}).Info("SaveSearch")
+
+	params.Cursor = ""
+	params.Limit = 0
+	params.CreatedAfter = time.Time{}
+
+	search, err := uc.searchRepo.SaveSearch(profileID, name, params)
+	if err != nil {
+		uc.logger.Error("SaveSearch", "profile_id", profileID, "error", err)
+	}
+	return search, err
+}
+
+func (uc *SavedSearches) List(profileID int) ([]model.SavedSearch, error) {
+	searches, err := uc.searchRepo.GetSavedSearches(profileID)
+	if err != nil {
+		uc.logger.Error("GetSavedSearches", "profile_id", profileID, "error", err)
+	}
+	return searches, err
+}
+
+func (uc *SavedSearches) Delete(profileID int, searchID int) error {
+	deleted, err := uc.searchRepo.DeleteSavedSearch(profileID, searchID)
+	if err != nil {
+		uc.logger.Error("DeleteSavedSearch", "profile_id", profileID, "search_id", searchID, "error", err)
+		return err
+	}
+	if !deleted {
+		return model.ErrSavedSearchNotFound
+	}
+	return nil
+}
+
+// RunAlerts checks the saved searches every interval until ctx is done.
+func (uc *SavedSearches) RunAlerts(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.CheckAlerts(interval); err != nil {
+				uc.logger.Error("SavedSearchAlerts", "error", err)
+			}
+		}
+	}
+}
+
+// CheckAlerts notifies the owners of searches not checked for interval about
+// profiles created since the last check, and returns how many were notified.
+// A search whose lookup fails is checked again on the next run.
+func (uc *SavedSearches) CheckAlerts(interval time.Duration) (int, error) {
+	notified := 0
+	for {
+		alerts, err := uc.searchRepo.ClaimDueSearches(interval, model.SavedSearchAlertBatchSize)
+		if err != nil {
+			return notified, err
+		}
+
+		failed := false
+		for _, alert := range alerts {
+			sent, err := uc.alert(alert)
+			if err != nil {
+				failed = true
+				uc.logger.Error("SavedSearchAlerts", "search_id", alert.SearchID, "error", err)
+				if err := uc.searchRepo.RestoreLastChecked(alert.SearchID, alert.Since); err != nil {
+					uc.logger.Error("SavedSearchAlerts", "search_id", alert.SearchID, "error", err)
+				}
+				continue
+			}
+			if sent {
+				notified++
+			}
+		}
+
+		// restored searches are due again, so a failing batch ends the run
+		if failed || len(alerts) < model.SavedSearchAlertBatchSize {
+			return notified, nil
+		}
+	}
+}
+
+func (uc *SavedSearches) alert(alert model.SavedSearchAlert) (bool, error) {
+	params := alert.Params
+	params.CreatedAfter = alert.Since
+	// only the total is needed
+	params.Limit = 1
+
+	resp, err := uc.ProfilesService.SearchProfile(context.Background(), searchRequest(alert.ProfileID, params))
+	if err != nil {
+		return false, err
+	}
+	if resp.GetTotal() == 0 {
+		return false, nil
+	}
+
+	// the notification row may exist even if this fails, so the window is
+	// not given back
+	err = deliverNotification(uc.notifRepo, uc.logger, alert.ProfileID, model.NotificationSend{
+		NotifType: model.NotificationSavedSearch,
+		Content:   fmt.Sprintf(model.SavedSearchAlertFormat, resp.GetTotal(), alert.Name),
+		Payload: &model.NotificationPayload{
+			Kind:     model.NotificationSavedSearch,
+			SearchID: alert.SearchID,
+			Count:    int(resp.GetTotal()),
+			Link:     fmt.Sprintf(model.SavedSearchLinkFormat, alert.SearchID),
+		},
+	}, time.Now())
+	if err != nil {
+		uc.logger.Error("SavedSearchAlerts", "search_id", alert.SearchID, "profile_id", alert.ProfileID, "error", err)
+		return false, nil
+	}
+	return true, nil
+}
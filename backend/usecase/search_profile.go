@@ -6,6 +6,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SearchProfiles struct {
@@ -26,34 +27,17 @@ func NewSearchProfilesUseCase(
 		logger:          logger,
 	}, nil
 }
-func (gp *SearchProfiles) GetSearchProfiles(forUserId int, params model.SearchProfileRequest) ([]model.FoundProfile, error) {
+func (gp *SearchProfiles) GetSearchProfiles(forUserId int, params model.SearchProfileRequest) (model.FoundProfileResponse, error) {
 	gp.logger.Info("GetProfilesForUserUseCase")
 
-	req := &profilespb.SearchProfileRequest{
-		IDUser:     int32(forUserId),
-		Input:      params.Input,
-		IsMale:     params.IsMale,
-		AgeMin:     int32(params.AgeMin),
-		AgeMax:     int32(params.AgeMax),
-		HeightMin:  int32(params.HeightMin),
-		HeightMax:  int32(params.HeightMax),
-		Goal:       int32(params.Goal),
-		Parametres: make([]*profilespb.Preference, len(params.Preferences)), // нужно преобразовать
-	}
-
-	for i, p := range params.Preferences {
-		req.Parametres[i] = &profilespb.Preference{
-			Description: p.Description,
-			Value:       p.Value,
-		}
-	}
+	req := searchRequest(forUserId, params)
 
 	resp, err := gp.ProfilesService.SearchProfile(context.Background(), req)
 	if err != nil {
-		return nil, err
+		return model.FoundProfileResponse{}, err
 	}
 
-	var profs []model.FoundProfile
+	profs := []model.FoundProfile{}
 
 	for _, match := range resp.Profiles {
 		if int(match.IDUser) == forUserId {
@@ -66,8 +50,48 @@ func (gp *SearchProfiles) GetSearchProfiles(forUserId int, params model.SearchPr
 			Fullname: match.Fullname,
 			Age:      int(match.Age),
 			Goal:     int(match.Goal),
+			Distance: int(match.Distance),
 		})
 	}
 
-	return profs, nil
+	return model.FoundProfileResponse{
+		Profiles:   profs,
+		NextCursor: resp.NextCursor,
+		Total:      int(resp.Total),
+	}, nil
+}
+
+func searchRequest(forUserId int, params model.SearchProfileRequest) *profilespb.SearchProfileRequest {
+	req := &profilespb.SearchProfileRequest{
+		IDUser:        int32(forUserId),
+		Input:         params.Input,
+		IsMale:        params.IsMale,
+		AgeMin:        int32(params.AgeMin),
+		AgeMax:        int32(params.AgeMax),
+		HeightMin:     int32(params.HeightMin),
+		HeightMax:     int32(params.HeightMax),
+		Goal:          int32(params.Goal),
+		Mutual:        params.Mutual,
+		MaxDistanceKm: int32(params.MaxDistanceKm),
+		SortBy:        params.SortBy,
+		Country:       params.Country,
+		City:          params.City,
+		InterestsAll:  params.InterestsAll,
+		InterestsAny:  params.InterestsAny,
+		Cursor:        params.Cursor,
+		Limit:         int32(params.Limit),
+		Parametres:    make([]*profilespb.Preference, len(params.Preferences)), // нужно преобразовать
+	}
+
+	for i, p := range params.Preferences {
+		req.Parametres[i] = &profilespb.Preference{
+			Description: p.Description,
+			Value:       p.Value,
+		}
+	}
+	if !params.CreatedAfter.IsZero() {
+		req.CreatedAfter = timestamppb.New(params.CreatedAfter)
+	}
+
+	return req
 }
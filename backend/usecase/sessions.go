@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/sirupsen/logrus"
+
+	sessionpb "github.com/go-park-mail-ru/2025_1_ProVVeb/auth_micro/proto"
+)
+
+type ManageSessions struct {
+	SessionService sessionpb.SessionServiceClient
+	logger         *logger.LogrusLogger
+}
+
+func NewManageSessionsUseCase(
+	SessionService sessionpb.SessionServiceClient,
+	logger *logger.LogrusLogger,
+) (*ManageSessions, error) {
+	if SessionService == nil || logger == nil {
+		return nil, model.ErrManageSessionsUC
+	}
+	return &ManageSessions{
+		SessionService: SessionService,
+		logger:         logger,
+	}, nil
+}
+
+func (ms *ManageSessions) ListSessions(userID int, currentSessionID string) ([]model.SessionInfo, error) {
+	ms.logger.Info("ListSessions", "userId", userID)
+	resp, err := ms.SessionService.ListSessions(context.Background(), &sessionpb.ListSessionsRequest{
+		UserId:           int32(userID),
+		CurrentSessionId: currentSessionID,
+	})
+	if err != nil {
+		ms.logger.Error("ListSessions", "userId", userID, "error", err)
+		return nil, err
+	}
+
+	sessions := make([]model.SessionInfo, 0, len(resp.GetSessions()))
+	for _, s := range resp.GetSessions() {
+		sessions = append(sessions, model.SessionInfo{
+			ID:           int(s.GetId()),
+			Device:       s.GetDevice(),
+			UserAgent:    s.GetUserAgent(),
+			IP:           s.GetIp(),
+			CreatedAt:    s.GetCreatedAt().AsTime(),
+			LastActiveAt: s.GetLastActiveAt().AsTime(),
+			ExpiresAt:    s.GetExpiresAt().AsTime(),
+			Current:      s.GetCurrent(),
+		})
+	}
+	return sessions, nil
+}
+
+func (ms *ManageSessions) RevokeSession(userID int, sessionID int) error {
+	ms.logger.WithFields(&logrus.Fields{"userId": userID, "sessionId": sessionID})
+	_, err := ms.SessionService.RevokeSession(context.Background(), &sessionpb.RevokeSessionRequest{
+		UserId: int32(userID),
+		Id:     int32(sessionID),
+	})
+	if err != nil {
+		ms.logger.Error("RevokeSession", "userId", userID, "error", err)
+	}
+	return err
+}
+
+func (ms *ManageSessions) RevokeOtherSessions(userID int, currentSessionID string) error {
+	ms.logger.Info("RevokeOtherSessions", "userId", userID)
+	_, err := ms.SessionService.RevokeSession(context.Background(), &sessionpb.RevokeSessionRequest{
+		UserId:           int32(userID),
+		AllOthers:        true,
+		CurrentSessionId: currentSessionID,
+	})
+	if err != nil {
+		ms.logger.Error("RevokeOtherSessions", "userId", userID, "error", err)
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type Unmatch struct {
+	ProfileService profilespb.ProfilesServiceClient
+	chatRepo       repository.ChatRepository
+	presenceRepo   repository.PresenceRepository
+	notifRepo      repository.NotificationsRepository
+	logger         *logger.LogrusLogger
+}
+
+func NewUnmatchUseCase(
+	ProfileService profilespb.ProfilesServiceClient,
+	chatRepo repository.ChatRepository,
+	presenceRepo repository.PresenceRepository,
+	notifRepo repository.NotificationsRepository,
+	logger *logger.LogrusLogger,
+) (*Unmatch, error) {
+	if ProfileService == nil || chatRepo == nil || presenceRepo == nil || notifRepo == nil || logger == nil {
+		return nil, model.ErrUnmatchUC
+	}
+	return &Unmatch{
+		ProfileService: ProfileService,
+		chatRepo:       chatRepo,
+		presenceRepo:   presenceRepo,
+		notifRepo:      notifRepo,
+		logger:         logger,
+	}, nil
+}
+
+// Unmatch breaks the match between profileID and peerID, closes their chat
+// and tells both users' clients about it. It returns the id of the closed
+// chat, 0 if the pair never started one.
+func (uc *Unmatch) Unmatch(profileID int, peerID int) (int, error) {
+	uc.logger.WithFields(&logrus.Fields{"profile_id": profileID, "peer_id": peerID}).Info("Unmatch")
+
+	_, err := uc.ProfileService.UnmatchProfile(context.Background(), &profilespb.UnmatchProfileRequest{
+		ProfileId:          int32(profileID),
+		UnmatchedProfileId: int32(peerID),
+	})
+	if err != nil {
+		uc.logger.Error("Unmatch", "profile_id", profileID, "peer_id", peerID, "error", err)
+		return 0, err
+	}
+
+	chatID, err := uc.chatRepo.CloseChat(profileID, peerID)
+	if err != nil {
+		uc.logger.Error("Unmatch", "profile_id", profileID, "peer_id", peerID, "error", err)
+		return 0, err
+	}
+
+	uc.deleteMatchNotification(profileID, peerID)
+	uc.deleteMatchNotification(peerID, profileID)
+
+	uc.publishUnmatched(peerID, profileID, chatID)
+	uc.publishUnmatched(profileID, peerID, chatID)
+
+	return chatID, nil
+}
+
+func (uc *Unmatch) deleteMatchNotification(userID int, peerID int) {
+	err := uc.notifRepo.DeleteLatestNotification(userID, model.NotificationMatch, peerID)
+	if err != nil {
+		uc.logger.Error("Unmatch", "user_id", userID, "peer_id", peerID, "error", err)
+	}
+}
+
+// publishUnmatched sends userID's clients an event naming the profile that
+// is gone, so that they drop the chat without reloading the list.
+func (uc *Unmatch) publishUnmatched(userID int, peerID int, chatID int) {
+	event, err := json.Marshal(map[string]interface{}{
+		"type":       "unmatched",
+		"profile_id": peerID,
+		"chat_id":    chatID,
+	})
+	if err != nil {
+		return
+	}
+	if err := uc.presenceRepo.PublishEvent(userID, event); err != nil {
+		uc.logger.Error("Unmatch", "user_id", userID, "peer_id", peerID, "error", err)
+	}
+}
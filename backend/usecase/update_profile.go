@@ -117,3 +117,21 @@ func (pu *ProfileUpdate) UpdateProfile(value model.Profile, targ model.Profile,
 
 	return err
 }
+
+// SetCoordinates stores the position reported by the client. A nil position
+// makes the profile fall back to the coordinates of its city.
+func (pu *ProfileUpdate) SetCoordinates(profileId int, pos *model.CoordinatesRequest) error {
+	pu.logger.WithFields(&logrus.Fields{"profileId": profileId, "clear": pos == nil}).Info("SetCoordinates")
+
+	req := &profilespb.SetProfileCoordinatesRequest{ProfileId: int32(profileId), Clear: pos == nil}
+	if pos != nil {
+		req.Latitude = pos.Latitude
+		req.Longitude = pos.Longitude
+	}
+
+	_, err := pu.ProfilesService.SetProfileCoordinates(context.Background(), req)
+	if err != nil {
+		pu.logger.WithFields(&logrus.Fields{"profileId": profileId, "error": err}).Error("SetCoordinates")
+	}
+	return err
+}
@@ -29,6 +29,7 @@ type UsersServiceClient interface {
 	GetPremium(ctx context.Context, in *GetPremiumRequest, opts ...grpc.CallOption) (*GetPremiumResponse, error)
 	SetPremium(ctx context.Context, in *SetPremiumRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error)
+	UpdatePasswordHash(ctx context.Context, in *UpdatePasswordHashRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type usersServiceClient struct {
@@ -129,6 +130,15 @@ func (c *usersServiceClient) GetAdmin(ctx context.Context, in *GetAdminRequest,
 	return out, nil
 }
 
+func (c *usersServiceClient) UpdatePasswordHash(ctx context.Context, in *UpdatePasswordHashRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/users.UsersService/UpdatePasswordHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServiceServer is the server API for UsersService service.
 // All implementations must embed UnimplementedUsersServiceServer
 // for forward compatibility
@@ -143,6 +153,7 @@ type UsersServiceServer interface {
 	GetPremium(context.Context, *GetPremiumRequest) (*GetPremiumResponse, error)
 	SetPremium(context.Context, *SetPremiumRequest) (*emptypb.Empty, error)
 	GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error)
+	UpdatePasswordHash(context.Context, *UpdatePasswordHashRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUsersServiceServer()
 }
 
@@ -180,6 +191,9 @@ func (UnimplementedUsersServiceServer) SetPremium(context.Context, *SetPremiumRe
 func (UnimplementedUsersServiceServer) GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdmin not implemented")
 }
+func (UnimplementedUsersServiceServer) UpdatePasswordHash(context.Context, *UpdatePasswordHashRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePasswordHash not implemented")
+}
 func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
 
 // UnsafeUsersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -373,6 +387,24 @@ func _UsersService_GetAdmin_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_UpdatePasswordHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePasswordHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).UpdatePasswordHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.UsersService/UpdatePasswordHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).UpdatePasswordHash(ctx, req.(*UpdatePasswordHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersService_ServiceDesc is the grpc.ServiceDesc for UsersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,6 +452,10 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdmin",
 			Handler:    _UsersService_GetAdmin_Handler,
 		},
+		{
+			MethodName: "UpdatePasswordHash",
+			Handler:    _UsersService_UpdatePasswordHash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",
@@ -2,13 +2,13 @@ package repository
 
 import (
 	"context"
-	"crypto/sha256"
 	"fmt"
 	"os"
 	"regexp"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/utils"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -25,8 +25,9 @@ type UserRepository interface {
 	GetUserParams(userId int) (model.User, error)
 	ValidateLogin(login string) error
 	ValidatePassword(password string) error
-	Hash(password string) string
+	Hash(password string) (string, error)
 	Compare(hashedPassword, login, password string) bool
+	UpdatePasswordHash(userId int, oldHash, newHash string) error
 	GetAdmin(userID int) (bool, error)
 
 	GetPremium(userID int) (bool, int, *time.Time, error)
@@ -338,12 +339,22 @@ func (ur *UserRepo) ValidatePassword(password string) error {
 	return nil
 }
 
-func (ur *UserRepo) Hash(password string) string {
-	hash := sha256.New()
-	hash.Write([]byte(password))
-	return fmt.Sprintf("%x", hash.Sum(nil))
+func (ur *UserRepo) Hash(password string) (string, error) {
+	return utils.HashPassword(password)
 }
 
-func (ur *UserRepo) Compare(hashedPassword, salt, inputPassword string) bool {
-	return hashedPassword == ur.Hash(salt+"_"+inputPassword)
+func (ur *UserRepo) Compare(hashedPassword, login, inputPassword string) bool {
+	return utils.VerifyPassword(hashedPassword, login, inputPassword)
+}
+
+const UpdatePasswordHashQuery = `
+UPDATE users SET password = $3, updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1 AND password = $2;
+`
+
+// UpdatePasswordHash swaps the stored hash only if it still equals oldHash,
+// so a concurrent password change is never overwritten by a rehash.
+func (ur *UserRepo) UpdatePasswordHash(userId int, oldHash, newHash string) error {
+	_, err := ur.DB.Exec(context.Background(), UpdatePasswordHashQuery, userId, oldHash, newHash)
+	return err
 }
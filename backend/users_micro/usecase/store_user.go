@@ -13,10 +13,15 @@ func (uss *UserServiceServer) SaveUserData(
 	req *users.SaveUserDataRequest,
 ) (*users.SaveUserDataResponse, error) {
 	uss.Logger.Info("StoreUser", "userId", req.UserId)
+	hashedPassword, err := uss.UserRepo.Hash(req.User.Password)
+	if err != nil {
+		uss.Logger.Error("StoreUser", "error", err)
+		return nil, err
+	}
 	var user model.User = model.User{
 		UserId:   int(req.UserId),
 		Login:    req.User.Login,
-		Password: uss.UserRepo.Hash(req.User.Login + "_" + req.User.Password),
+		Password: hashedPassword,
 		Email:    req.User.Email,
 		Phone:    req.User.Phone,
 		Status:   int(req.User.Status),
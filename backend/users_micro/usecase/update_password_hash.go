@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	users "github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (uss *UserServiceServer) UpdatePasswordHash(ctx context.Context, req *users.UpdatePasswordHashRequest) (*emptypb.Empty, error) {
+	uss.Logger.Info("UpdatePasswordHash", "UserId", req.UserId)
+	err := uss.UserRepo.UpdatePasswordHash(int(req.UserId), req.OldHash, req.NewHash)
+	uss.Logger.WithFields(&logrus.Fields{"UserId": req.UserId, "error": err})
+	return &emptypb.Empty{}, err
+}
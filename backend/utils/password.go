@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2Memory  = 19 * 1024
+	argon2Time    = 2
+	argon2Threads = 1
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+
+	argon2Prefix = "$argon2id$"
+)
+
+// HashPassword derives an argon2id key from password with a fresh random salt
+// and returns it encoded as $argon2id$v=19$m=...,t=...,p=...$<salt>$<key>.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix, argon2.Version, argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword checks password against an encoded argon2id hash. Hashes
+// created before argon2id was introduced are sha256(login + "_" + password).
+func VerifyPassword(encoded, login, password string) bool {
+	if !strings.HasPrefix(encoded, argon2Prefix) {
+		legacy := EncryptPasswordSHA256(login + "_" + password)
+		return subtle.ConstantTimeCompare([]byte(encoded), []byte(legacy)) == 1
+	}
+
+	params, salt, key, err := decodeArgon2Hash(encoded)
+	if err != nil {
+		return false
+	}
+
+	derived := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(derived, key) == 1
+}
+
+// PasswordNeedsRehash reports whether encoded uses the legacy scheme or
+// argon2id parameters weaker than the current ones.
+func PasswordNeedsRehash(encoded string) bool {
+	params, _, _, err := decodeArgon2Hash(encoded)
+	if err != nil {
+		return true
+	}
+	return params.memory < argon2Memory || params.time < argon2Time || params.threads < argon2Threads
+}
+
+type argon2Params struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+func decodeArgon2Hash(encoded string) (argon2Params, []byte, []byte, error) {
+	var params argon2Params
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, fmt.Errorf("not an argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2 version")
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2 parameters: %w", err)
+	}
+	if params.time == 0 || params.threads == 0 {
+		return params, nil, nil, fmt.Errorf("invalid argon2 parameters")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2 salt: %w", err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, fmt.Errorf("invalid argon2 key")
+	}
+
+	return params, salt, key, nil
+}